@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// ChecksumMismatchError is returned when the checksum of a downloaded file
+// does not match the checksum reported by the Cloud Controller.
+type ChecksumMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum of %s is %s, expected %s", e.Path, e.Actual, e.Expected)
+}
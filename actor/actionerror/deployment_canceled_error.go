@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// DeploymentCanceledError is returned when a deployment is canceled before
+// all of the application's instances have been replaced.
+type DeploymentCanceledError struct {
+	DeploymentGUID string
+}
+
+func (e DeploymentCanceledError) Error() string {
+	return fmt.Sprintf("Deployment %s was canceled", e.DeploymentGUID)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// DropletCopyFailedError is returned when the Cloud Controller fails to copy
+// a droplet.
+type DropletCopyFailedError struct {
+	DropletGUID string
+}
+
+func (e DropletCopyFailedError) Error() string {
+	return fmt.Sprintf("Copying droplet %s failed", e.DropletGUID)
+}
@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// DropletCopyTimeoutError is returned when the staging timeout is reached
+// waiting for the Cloud Controller to copy a droplet.
+type DropletCopyTimeoutError struct {
+	DropletGUID string
+	Timeout     time.Duration
+}
+
+func (e DropletCopyTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for droplet %s to be copied", e.DropletGUID)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidOrganizationTemplateError is returned when an organization template
+// file cannot be parsed or is incomplete.
+type InvalidOrganizationTemplateError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidOrganizationTemplateError) Error() string {
+	return fmt.Sprintf("Invalid organization template %s: %s", e.Path, e.Reason)
+}
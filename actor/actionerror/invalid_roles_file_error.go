@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidRolesFileError is returned when a roles file cannot be parsed or
+// contains an invalid role assignment.
+type InvalidRolesFileError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidRolesFileError) Error() string {
+	return fmt.Sprintf("Invalid roles file %s: %s", e.Path, e.Reason)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidUserAliasError is returned when a user-defined alias has an invalid
+// name or a command line that cannot be parsed.
+type InvalidUserAliasError struct {
+	Name   string
+	Reason string
+}
+
+func (e InvalidUserAliasError) Error() string {
+	return fmt.Sprintf("alias %s is invalid: %s", e.Name, e.Reason)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidUserProvidedServiceInstancesFileError is returned when a file of
+// user provided service instance definitions cannot be parsed.
+type InvalidUserProvidedServiceInstancesFileError struct {
+	Path   string
+	Reason string
+}
+
+func (e InvalidUserProvidedServiceInstancesFileError) Error() string {
+	return fmt.Sprintf("Invalid user provided services file %s: %s", e.Path, e.Reason)
+}
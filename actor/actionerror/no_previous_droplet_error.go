@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// NoPreviousDropletError is returned when an application does not have enough
+// staged droplets older than its current droplet to roll back to.
+type NoPreviousDropletError struct {
+	AppName  string
+	Previous int
+}
+
+func (e NoPreviousDropletError) Error() string {
+	return fmt.Sprintf("Application '%s' does not have %d staged droplet(s) older than its current droplet", e.AppName, e.Previous)
+}
@@ -4,8 +4,12 @@ import "fmt"
 
 type OrganizationQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e OrganizationQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Organization quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Organization quota with GUID '%s' not found.", e.GUID)
 }
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PackageNotFoundError is returned when an application does not have any
+// package.
+type PackageNotFoundError struct {
+	AppName string
+}
+
+func (e PackageNotFoundError) Error() string {
+	return fmt.Sprintf("No package found for app '%s'.", e.AppName)
+}
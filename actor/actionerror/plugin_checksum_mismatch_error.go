@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// PluginChecksumMismatchError is returned when a downloaded plugin binary's
+// checksum does not match the checksum listed in its repository.
+type PluginChecksumMismatchError struct {
+	PluginName string
+	Platform   string
+}
+
+func (e PluginChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum of plugin %s for platform %s does not match repository", e.PluginName, e.Platform)
+}
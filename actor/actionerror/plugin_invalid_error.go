@@ -1,7 +1,7 @@
 package actionerror
 
 // PluginInvalidError is returned with a plugin is invalid because it is
-// missing a name or has 0 commands.
+// missing a name or has neither commands nor hooks.
 type PluginInvalidError struct {
 	Err error
 }
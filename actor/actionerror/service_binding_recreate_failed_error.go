@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// ServiceBindingRecreateFailedError is returned when rebinding an application
+// to a service instance deletes the old service binding but fails to create
+// the new one, leaving the application unbound.
+type ServiceBindingRecreateFailedError struct {
+	AppName             string
+	ServiceInstanceName string
+	BindErr             string
+}
+
+func (e ServiceBindingRecreateFailedError) Error() string {
+	return fmt.Sprintf("Application '%s' was unbound from service instance '%s' and could not be bound again (%s).", e.AppName, e.ServiceInstanceName, e.BindErr)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceBrokerNotFoundError is returned when a service broker cannot be
+// found.
+type ServiceBrokerNotFoundError struct {
+	Name string
+}
+
+func (e ServiceBrokerNotFoundError) Error() string {
+	return fmt.Sprintf("Service broker '%s' not found.", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceAlreadyExistsError is returned when creating a service
+// instance that already exists with the requested service plan.
+type ServiceInstanceAlreadyExistsError struct {
+	Name string
+}
+
+func (e ServiceInstanceAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Service instance '%s' already exists.", e.Name)
+}
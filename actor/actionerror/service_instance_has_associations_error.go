@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceHasAssociationsError is returned when deleting a service
+// instance that still has service bindings, service keys or shares.
+type ServiceInstanceHasAssociationsError struct {
+	Name string
+}
+
+func (e ServiceInstanceHasAssociationsError) Error() string {
+	return fmt.Sprintf("Service instance '%s' has service bindings, service keys or shares.", e.Name)
+}
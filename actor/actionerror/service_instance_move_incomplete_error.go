@@ -0,0 +1,17 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceMoveIncompleteError is returned when moving a service
+// instance fails and rolling back the move fails as well.
+type ServiceInstanceMoveIncompleteError struct {
+	Name                  string
+	MoveErr               string
+	RollbackErr           string
+	TargetInstanceRemains bool
+	UnboundApplications   []string
+}
+
+func (e ServiceInstanceMoveIncompleteError) Error() string {
+	return fmt.Sprintf("Moving service instance '%s' failed (%s) and could not be rolled back (%s).", e.Name, e.MoveErr, e.RollbackErr)
+}
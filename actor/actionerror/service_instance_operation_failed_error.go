@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceOperationFailedError is returned when the service broker
+// reports that an asynchronous operation on a service instance failed.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	return fmt.Sprintf("Service instance '%s' %s failed: %s", e.Name, e.Operation, e.Description)
+}
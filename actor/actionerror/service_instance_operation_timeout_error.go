@@ -0,0 +1,19 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServiceInstanceOperationTimeoutError is returned when an asynchronous
+// operation on a service instance is still in progress after the polling
+// timeout.
+type ServiceInstanceOperationTimeoutError struct {
+	Name      string
+	Operation string
+	Timeout   time.Duration
+}
+
+func (e ServiceInstanceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for service instance '%s' %s to complete", e.Name, e.Operation)
+}
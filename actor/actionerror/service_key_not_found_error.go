@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceKeyNotFoundError is returned when a service key cannot be found for
+// a service instance.
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (e ServiceKeyNotFoundError) Error() string {
+	return fmt.Sprintf("No service key %s found for service instance %s", e.Name, e.ServiceInstanceName)
+}
@@ -0,0 +1,12 @@
+package actionerror
+
+import "fmt"
+
+// ServiceNotFoundError is returned when a service offering cannot be found.
+type ServiceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	return fmt.Sprintf("Service offering '%s' not found.", e.Name)
+}
@@ -0,0 +1,27 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ServiceParameterError is a violation of a service plan's parameter schema
+// at a given path of the parameters.
+type ServiceParameterError struct {
+	Path    string
+	Message string
+}
+
+// ServiceParametersInvalidError is returned when service parameters do not
+// match the JSON schema published by the service plan.
+type ServiceParametersInvalidError struct {
+	Errors []ServiceParameterError
+}
+
+func (e ServiceParametersInvalidError) Error() string {
+	var errs []string
+	for _, paramErr := range e.Errors {
+		errs = append(errs, fmt.Sprintf("%s: %s", paramErr.Path, paramErr.Message))
+	}
+	return fmt.Sprintf("Service parameters do not match the service plan's schema: %s", strings.Join(errs, "; "))
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// ServicePlanIsPublicError is returned when hiding a public plan from a single
+// organization.
+type ServicePlanIsPublicError struct {
+	PlanName    string
+	ServiceName string
+	OrgName     string
+}
+
+func (e ServicePlanIsPublicError) Error() string {
+	return fmt.Sprintf("Plan %s of service %s is public and cannot be hidden from organization %s", e.PlanName, e.ServiceName, e.OrgName)
+}
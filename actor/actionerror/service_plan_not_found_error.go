@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServicePlanNotFoundError is returned when a service plan cannot be found
+// for a service offering.
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return fmt.Sprintf("Service plan '%s' not found.", e.PlanName)
+}
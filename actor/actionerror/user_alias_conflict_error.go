@@ -0,0 +1,17 @@
+package actionerror
+
+import "fmt"
+
+// UserAliasConflictError is returned when a user-defined alias name is
+// already used by a core command or alias, or by a plugin command or alias.
+type UserAliasConflictError struct {
+	Name       string
+	PluginName string
+}
+
+func (e UserAliasConflictError) Error() string {
+	if e.PluginName != "" {
+		return fmt.Sprintf("alias %s conflicts with a command of plugin %s", e.Name, e.PluginName)
+	}
+	return fmt.Sprintf("alias %s conflicts with a core command", e.Name)
+}
@@ -0,0 +1,16 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UserAliasCycleError is returned when user-defined aliases refer to each
+// other in a loop.
+type UserAliasCycleError struct {
+	Names []string
+}
+
+func (e UserAliasCycleError) Error() string {
+	return fmt.Sprintf("aliases refer to each other in a loop: %s", strings.Join(e.Names, " -> "))
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// UserAliasNotFoundError is returned when a user-defined alias does not
+// exist.
+type UserAliasNotFoundError struct {
+	Name string
+}
+
+func (e UserAliasNotFoundError) Error() string {
+	return fmt.Sprintf("alias %s not found", e.Name)
+}
@@ -83,7 +83,7 @@ func (actor Actor) FileExists(path string) bool {
 
 func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, commandList CommandList, path string) (configv3.Plugin, error) {
 	plugin, err := pluginMetadata.GetMetadata(path)
-	if err != nil || plugin.Name == "" || len(plugin.Commands) == 0 {
+	if err != nil || plugin.Name == "" || (len(plugin.Commands) == 0 && len(plugin.Hooks) == 0) {
 		return configv3.Plugin{}, actionerror.PluginInvalidError{Err: err}
 	}
 
@@ -211,6 +211,17 @@ var _ = Describe("install actions", func() {
 			It("returns a PluginInvalidError", func() {
 				Expect(validateErr).To(MatchError(actionerror.PluginInvalidError{}))
 			})
+
+			Context("when the plugin subscribes to hooks", func() {
+				BeforeEach(func() {
+					fakePluginMetadata.GetMetadataReturns(configv3.Plugin{Name: "some-plugin", Hooks: []string{"pre-push"}}, nil)
+				})
+
+				It("returns the plugin", func() {
+					Expect(validateErr).ToNot(HaveOccurred())
+					Expect(plugin).To(Equal(configv3.Plugin{Name: "some-plugin", Hooks: []string{"pre-push"}}))
+				})
+			})
 		})
 
 		Context("when there are command conflicts", func() {
@@ -0,0 +1,334 @@
+package pluginaction
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+)
+
+// PluginRepositoryIndexFilename is the name of the file, written by
+// MirrorPluginRepository, that records the plugins contained in a plugin
+// repository directory.
+const PluginRepositoryIndexFilename = "list.json"
+
+// platformSuffixes are the file name suffixes that are stripped from a binary
+// name when grouping binaries for different platforms into one plugin.
+var platformSuffixes = []string{
+	"linux64", "linux32", "win64", "win32", "osx",
+	"linux_amd64", "linux_386", "windows_amd64", "windows_386", "darwin_amd64", "darwin",
+	"linux-amd64", "linux-386", "windows-amd64", "windows-386", "darwin-amd64",
+}
+
+// IndexPluginRepositoryDirectory returns the plugin repository described by
+// the plugin binaries in dir. Binary URLs in the returned repository are file
+// names relative to dir.
+//
+// Binaries listed in dir's index file, as written by MirrorPluginRepository,
+// are used as is once their checksums are validated. The metadata of any
+// other binary built for the current platform is read by running the binary
+// through pluginMetadata. Binaries built for other platforms are attached to
+// the plugin whose binary shares their file name, minus any platform suffix.
+// Files that cannot be indexed are skipped and reported in the returned
+// warnings.
+func (actor Actor) IndexPluginRepositoryDirectory(pluginMetadata PluginMetadata, dir string, tempPluginDir string) (plugin.PluginRepository, []string, error) {
+	var warnings []string
+
+	repository, err := readPluginRepositoryIndex(dir)
+	if err != nil {
+		return plugin.PluginRepository{}, nil, err
+	}
+
+	indexed := map[string]bool{PluginRepositoryIndexFilename: true}
+	var plugins []plugin.Plugin
+	for _, repositoryPlugin := range repository.Plugins {
+		var binaries []plugin.PluginBinary
+		for _, binary := range repositoryPlugin.Binaries {
+			if filepath.Base(binary.URL) != binary.URL {
+				warnings = append(warnings, fmt.Sprintf("Skipping %s: binaries must be in the repository directory", binary.URL))
+				continue
+			}
+			indexed[binary.URL] = true
+			if !actor.ValidateFileChecksum(filepath.Join(dir, binary.URL), binary.Checksum) {
+				warnings = append(warnings, fmt.Sprintf("Skipping %s: checksum does not match %s", binary.URL, PluginRepositoryIndexFilename))
+				continue
+			}
+			binaries = append(binaries, binary)
+		}
+		if len(binaries) > 0 {
+			repositoryPlugin.Binaries = binaries
+			plugins = append(plugins, repositoryPlugin)
+		}
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return plugin.PluginRepository{}, nil, err
+	}
+
+	hostPlatform := generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH)
+	pluginsByStem := map[string][]int{}
+	var otherPlatformFiles []os.FileInfo
+	otherPlatforms := map[string]string{}
+
+	for _, file := range files {
+		if file.IsDir() || indexed[file.Name()] {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+		platform := executablePlatform(path)
+		switch platform {
+		case "":
+			warnings = append(warnings, fmt.Sprintf("Skipping %s: not a plugin binary for a supported platform", file.Name()))
+		case hostPlatform:
+			metadata, metadataErr := actor.getPluginMetadata(pluginMetadata, path, tempPluginDir)
+			if metadataErr != nil {
+				warnings = append(warnings, fmt.Sprintf("Skipping %s: %s", file.Name(), metadataErr))
+				continue
+			}
+
+			binary := plugin.PluginBinary{
+				Platform: platform,
+				URL:      file.Name(),
+				Checksum: configv3.Plugin{Location: path}.CalculateSHA1(),
+			}
+			index := findRepositoryPlugin(plugins, metadata.Name, metadata.Version.String())
+			if index == -1 {
+				plugins = append(plugins, plugin.Plugin{
+					Name:    metadata.Name,
+					Version: metadata.Version.String(),
+				})
+				index = len(plugins) - 1
+			}
+			plugins[index].Binaries = append(plugins[index].Binaries, binary)
+
+			stem := binaryStem(file.Name())
+			pluginsByStem[stem] = append(pluginsByStem[stem], index)
+		default:
+			otherPlatformFiles = append(otherPlatformFiles, file)
+			otherPlatforms[file.Name()] = platform
+		}
+	}
+
+	for _, file := range otherPlatformFiles {
+		indexes, ok := pluginsByStem[binaryStem(file.Name())]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("Skipping %s: no %s binary with a matching name to read plugin metadata from", file.Name(), hostPlatform))
+			continue
+		}
+
+		binary := plugin.PluginBinary{
+			Platform: otherPlatforms[file.Name()],
+			URL:      file.Name(),
+			Checksum: configv3.Plugin{Location: filepath.Join(dir, file.Name())}.CalculateSHA1(),
+		}
+		for _, index := range indexes {
+			plugins[index].Binaries = append(plugins[index].Binaries, binary)
+		}
+	}
+
+	sort.Slice(plugins, func(i int, j int) bool {
+		if plugins[i].Name == plugins[j].Name {
+			return lessThan(plugins[i].Version, plugins[j].Version)
+		}
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugin.PluginRepository{Plugins: plugins}, warnings, nil
+}
+
+// MirrorPluginRepository downloads every plugin binary in the repository at
+// repositoryURL into dir and writes an index file describing them, so that the
+// directory can be served with IndexPluginRepositoryDirectory where the
+// original repository is unreachable. When platforms is not empty, only
+// binaries for those platforms are mirrored.
+func (actor Actor) MirrorPluginRepository(repositoryURL string, dir string, platforms []string, proxyReader plugin.ProxyReader) (plugin.PluginRepository, error) {
+	normalizedURL, err := normalizeURLPath(repositoryURL)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	remoteRepository, err := actor.client.GetPluginRepository(normalizedURL)
+	if err != nil {
+		return plugin.PluginRepository{}, actionerror.GettingPluginRepositoryError{Name: repositoryURL, Message: err.Error()}
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	var plugins []plugin.Plugin
+	for _, remotePlugin := range remoteRepository.Plugins {
+		mirroredPlugin := remotePlugin
+		mirroredPlugin.Binaries = nil
+
+		for _, binary := range remotePlugin.Binaries {
+			if len(platforms) > 0 && !containsString(platforms, binary.Platform) {
+				continue
+			}
+
+			filename := mirroredBinaryFilename(remotePlugin, binary.Platform)
+			path := filepath.Join(dir, filename)
+			err = actor.client.DownloadPlugin(binary.URL, path, proxyReader)
+			if err != nil {
+				return plugin.PluginRepository{}, err
+			}
+
+			if !actor.ValidateFileChecksum(path, binary.Checksum) {
+				_ = os.Remove(path)
+				return plugin.PluginRepository{}, actionerror.PluginChecksumMismatchError{
+					PluginName: remotePlugin.Name,
+					Platform:   binary.Platform,
+				}
+			}
+
+			err = os.Chmod(path, 0755)
+			if err != nil {
+				return plugin.PluginRepository{}, err
+			}
+
+			binary.URL = filename
+			mirroredPlugin.Binaries = append(mirroredPlugin.Binaries, binary)
+		}
+
+		if len(mirroredPlugin.Binaries) > 0 {
+			plugins = append(plugins, mirroredPlugin)
+		}
+	}
+
+	mirroredRepository := plugin.PluginRepository{Plugins: plugins}
+	raw, err := json.MarshalIndent(mirroredRepository, "", "  ")
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, PluginRepositoryIndexFilename), raw, 0644)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	return mirroredRepository, nil
+}
+
+func (actor Actor) getPluginMetadata(pluginMetadata PluginMetadata, path string, tempPluginDir string) (configv3.Plugin, error) {
+	executablePath, err := actor.CreateExecutableCopy(path, tempPluginDir)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+	defer os.Remove(executablePath)
+
+	metadata, err := pluginMetadata.GetMetadata(executablePath)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+	if metadata.Name == "" {
+		return configv3.Plugin{}, fmt.Errorf("plugin metadata has no name")
+	}
+	return metadata, nil
+}
+
+func readPluginRepositoryIndex(dir string) (plugin.PluginRepository, error) {
+	var repository plugin.PluginRepository
+
+	raw, err := ioutil.ReadFile(filepath.Join(dir, PluginRepositoryIndexFilename))
+	if os.IsNotExist(err) {
+		return repository, nil
+	} else if err != nil {
+		return repository, err
+	}
+
+	err = json.Unmarshal(raw, &repository)
+	return repository, err
+}
+
+// executablePlatform returns the repository platform the binary at path was
+// built for, or an empty string if it is not a binary for a supported
+// platform.
+func executablePlatform(path string) string {
+	if file, err := elf.Open(path); err == nil {
+		defer file.Close()
+		switch file.Machine {
+		case elf.EM_X86_64:
+			return "linux64"
+		case elf.EM_386:
+			return "linux32"
+		}
+		return ""
+	}
+
+	if file, err := macho.Open(path); err == nil {
+		file.Close()
+		return "osx"
+	}
+	if file, err := macho.OpenFat(path); err == nil {
+		file.Close()
+		return "osx"
+	}
+
+	if file, err := pe.Open(path); err == nil {
+		defer file.Close()
+		switch file.Machine {
+		case pe.IMAGE_FILE_MACHINE_AMD64:
+			return "win64"
+		case pe.IMAGE_FILE_MACHINE_I386:
+			return "win32"
+		}
+	}
+
+	return ""
+}
+
+// binaryStem returns the file name of a binary without its extension and
+// platform suffix, e.g. "my-plugin" for "my-plugin_linux64" and
+// "my-plugin.win64.exe".
+func binaryStem(filename string) string {
+	stem := strings.TrimSuffix(filename, ".exe")
+	for _, suffix := range platformSuffixes {
+		for _, separator := range []string{"-", "_", "."} {
+			if strings.HasSuffix(stem, separator+suffix) {
+				return strings.TrimSuffix(stem, separator+suffix)
+			}
+		}
+	}
+	return stem
+}
+
+func mirroredBinaryFilename(repositoryPlugin plugin.Plugin, platform string) string {
+	filename := fmt.Sprintf("%s-%s-%s", repositoryPlugin.Name, repositoryPlugin.Version, platform)
+	if strings.HasPrefix(platform, "win") {
+		filename += ".exe"
+	}
+	return filepath.Base(filename)
+}
+
+func findRepositoryPlugin(plugins []plugin.Plugin, name string, version string) int {
+	for i, repositoryPlugin := range plugins {
+		if repositoryPlugin.Name == name && repositoryPlugin.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
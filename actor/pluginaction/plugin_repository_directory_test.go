@@ -0,0 +1,320 @@
+package pluginaction_test
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func writeLinux32Binary(path string) {
+	header := elf.Header32{
+		Type:    uint16(elf.ET_EXEC),
+		Machine: uint16(elf.EM_386),
+		Version: uint32(elf.EV_CURRENT),
+		Ehsize:  52,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS32)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+	buffer := new(bytes.Buffer)
+	Expect(binary.Write(buffer, binary.LittleEndian, header)).To(Succeed())
+	Expect(ioutil.WriteFile(path, buffer.Bytes(), 0600)).To(Succeed())
+}
+
+func copyTestBinary(path string) {
+	raw, err := ioutil.ReadFile(os.Args[0])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ioutil.WriteFile(path, raw, 0600)).To(Succeed())
+}
+
+var _ = Describe("plugin repository directory actions", func() {
+	var (
+		actor      *Actor
+		fakeClient *pluginactionfakes.FakePluginClient
+		dir        string
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(nil, fakeClient)
+
+		var err error
+		dir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("IndexPluginRepositoryDirectory", func() {
+		var (
+			fakePluginMetadata *pluginactionfakes.FakePluginMetadata
+			tempPluginDir      string
+			hostPlatform       string
+
+			repository plugin.PluginRepository
+			warnings   []string
+			indexErr   error
+		)
+
+		BeforeEach(func() {
+			fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
+			hostPlatform = generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH)
+
+			var err error
+			tempPluginDir, err = ioutil.TempDir("", "plugin-temp")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempPluginDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			repository, warnings, indexErr = actor.IndexPluginRepositoryDirectory(fakePluginMetadata, dir, tempPluginDir)
+		})
+
+		Context("when the directory contains binaries for this platform", func() {
+			BeforeEach(func() {
+				if hostPlatform == "" || hostPlatform == "linux32" {
+					Skip("requires a platform other than linux32 to be the host platform")
+				}
+
+				copyTestBinary(filepath.Join(dir, "some-plugin_"+hostPlatform))
+				writeLinux32Binary(filepath.Join(dir, "some-plugin_linux32"))
+				writeLinux32Binary(filepath.Join(dir, "unmatched-plugin_linux32"))
+				Expect(ioutil.WriteFile(filepath.Join(dir, "README"), []byte("not a plugin"), 0600)).To(Succeed())
+
+				fakePluginMetadata.GetMetadataReturns(configv3.Plugin{
+					Name:    "some-plugin",
+					Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+				}, nil)
+			})
+
+			It("reads the metadata of the binary through an executable copy", func() {
+				Expect(indexErr).ToNot(HaveOccurred())
+				Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(1))
+				Expect(filepath.Dir(fakePluginMetadata.GetMetadataArgsForCall(0))).To(Equal(tempPluginDir))
+			})
+
+			It("groups binaries for other platforms by file name and skips everything else", func() {
+				Expect(repository.Plugins).To(HaveLen(1))
+				Expect(repository.Plugins[0].Name).To(Equal("some-plugin"))
+				Expect(repository.Plugins[0].Version).To(Equal("1.2.3"))
+				Expect(repository.Plugins[0].Binaries).To(ConsistOf(
+					plugin.PluginBinary{
+						Platform: hostPlatform,
+						URL:      "some-plugin_" + hostPlatform,
+						Checksum: configv3.Plugin{Location: filepath.Join(dir, "some-plugin_"+hostPlatform)}.CalculateSHA1(),
+					},
+					plugin.PluginBinary{
+						Platform: "linux32",
+						URL:      "some-plugin_linux32",
+						Checksum: configv3.Plugin{Location: filepath.Join(dir, "some-plugin_linux32")}.CalculateSHA1(),
+					},
+				))
+
+				Expect(warnings).To(ConsistOf(
+					"Skipping README: not a plugin binary for a supported platform",
+					"Skipping unmatched-plugin_linux32: no "+hostPlatform+" binary with a matching name to read plugin metadata from",
+				))
+			})
+
+			Context("when the metadata cannot be read", func() {
+				BeforeEach(func() {
+					fakePluginMetadata.GetMetadataReturns(configv3.Plugin{}, errors.New("some-error"))
+				})
+
+				It("skips the binary with a warning", func() {
+					Expect(indexErr).ToNot(HaveOccurred())
+					Expect(repository.Plugins).To(BeEmpty())
+					Expect(warnings).To(ContainElement("Skipping some-plugin_" + hostPlatform + ": some-error"))
+				})
+			})
+		})
+
+		Context("when the directory contains an index file", func() {
+			BeforeEach(func() {
+				writeLinux32Binary(filepath.Join(dir, "some-plugin-1.0.0-linux32"))
+				Expect(ioutil.WriteFile(filepath.Join(dir, "some-plugin-1.0.0-win64.exe"), []byte("tampered"), 0600)).To(Succeed())
+
+				index := plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:        "some-plugin",
+							Description: "some-description",
+							Version:     "1.0.0",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux32", URL: "some-plugin-1.0.0-linux32", Checksum: configv3.Plugin{Location: filepath.Join(dir, "some-plugin-1.0.0-linux32")}.CalculateSHA1()},
+								{Platform: "win64", URL: "some-plugin-1.0.0-win64.exe", Checksum: "some-checksum"},
+								{Platform: "osx", URL: "../outside", Checksum: "some-checksum"},
+							},
+						},
+					},
+				}
+				raw, err := json.Marshal(index)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(filepath.Join(dir, PluginRepositoryIndexFilename), raw, 0600)).To(Succeed())
+			})
+
+			It("uses the indexed binaries whose checksums match without running them", func() {
+				Expect(indexErr).ToNot(HaveOccurred())
+				Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(0))
+
+				Expect(repository.Plugins).To(HaveLen(1))
+				Expect(repository.Plugins[0].Description).To(Equal("some-description"))
+				Expect(repository.Plugins[0].Binaries).To(HaveLen(1))
+				Expect(repository.Plugins[0].Binaries[0].URL).To(Equal("some-plugin-1.0.0-linux32"))
+
+				Expect(warnings).To(ConsistOf(
+					"Skipping some-plugin-1.0.0-win64.exe: checksum does not match list.json",
+					"Skipping ../outside: binaries must be in the repository directory",
+				))
+			})
+		})
+
+		Context("when the directory does not exist", func() {
+			BeforeEach(func() {
+				Expect(os.RemoveAll(dir)).To(Succeed())
+			})
+
+			It("returns the error", func() {
+				Expect(indexErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("MirrorPluginRepository", func() {
+		var (
+			mirrorDir  string
+			platforms  []string
+			mirrored   plugin.PluginRepository
+			mirrorErr  error
+			binaryData map[string]string
+		)
+
+		BeforeEach(func() {
+			mirrorDir = filepath.Join(dir, "mirror")
+			platforms = nil
+			binaryData = map[string]string{
+				"https://example.com/some-plugin-linux64": "linux-binary",
+				"https://example.com/some-plugin-win64":   "windows-binary",
+			}
+
+			fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{
+					{
+						Name:        "some-plugin",
+						Description: "some-description",
+						Version:     "1.0.0",
+						Binaries: []plugin.PluginBinary{
+							{Platform: "linux64", URL: "https://example.com/some-plugin-linux64", Checksum: "50e5fa5b5a9e1fc4e4a3a1ab2d75ac5ae0bbbf94"},
+							{Platform: "win64", URL: "https://example.com/some-plugin-win64", Checksum: "a8d0b7c76cdc1e8d6e4b0c4a0bf0e3b9acaf3a4c"},
+						},
+					},
+				},
+			}, nil)
+			fakeClient.DownloadPluginStub = func(pluginURL string, path string, _ plugin.ProxyReader) error {
+				return ioutil.WriteFile(path, []byte(binaryData[pluginURL]), 0600)
+			}
+		})
+
+		JustBeforeEach(func() {
+			mirrored, mirrorErr = actor.MirrorPluginRepository("example.com/", mirrorDir, platforms, nil)
+		})
+
+		Context("when the checksums match", func() {
+			BeforeEach(func() {
+				platforms = []string{"linux64"}
+				binaryData["https://example.com/some-plugin-linux64"] = "foo"
+				fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:    "some-plugin",
+							Version: "1.0.0",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "https://example.com/some-plugin-linux64", Checksum: "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"},
+								{Platform: "win64", URL: "https://example.com/some-plugin-win64", Checksum: "some-checksum"},
+							},
+						},
+					},
+				}, nil)
+			})
+
+			It("downloads the binaries for the requested platforms and writes an index", func() {
+				Expect(mirrorErr).ToNot(HaveOccurred())
+
+				Expect(fakeClient.GetPluginRepositoryCallCount()).To(Equal(1))
+				Expect(fakeClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://example.com"))
+				Expect(fakeClient.DownloadPluginCallCount()).To(Equal(1))
+
+				expected := plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:    "some-plugin",
+							Version: "1.0.0",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "linux64", URL: "some-plugin-1.0.0-linux64", Checksum: "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"},
+							},
+						},
+					},
+				}
+				Expect(mirrored).To(Equal(expected))
+
+				raw, err := ioutil.ReadFile(filepath.Join(mirrorDir, PluginRepositoryIndexFilename))
+				Expect(err).ToNot(HaveOccurred())
+				var index plugin.PluginRepository
+				Expect(json.Unmarshal(raw, &index)).To(Succeed())
+				Expect(index).To(Equal(expected))
+
+				Expect(filepath.Join(mirrorDir, "some-plugin-1.0.0-linux64")).To(BeAnExistingFile())
+			})
+		})
+
+		Context("when a checksum does not match", func() {
+			It("removes the binary and returns a PluginChecksumMismatchError", func() {
+				Expect(mirrorErr).To(MatchError(actionerror.PluginChecksumMismatchError{PluginName: "some-plugin", Platform: "linux64"}))
+				Expect(filepath.Join(mirrorDir, "some-plugin-1.0.0-linux64")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		Context("when getting the repository fails", func() {
+			BeforeEach(func() {
+				fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("some-error"))
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				Expect(mirrorErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "example.com/", Message: "some-error"}))
+			})
+		})
+
+		Context("when downloading a binary fails", func() {
+			BeforeEach(func() {
+				fakeClient.DownloadPluginReturns(errors.New("some-error"))
+				fakeClient.DownloadPluginStub = nil
+			})
+
+			It("returns the error", func() {
+				Expect(mirrorErr).To(MatchError("some-error"))
+			})
+		})
+	})
+})
@@ -157,63 +157,81 @@ func (Actor) validateCommandLineSettingsAndManifestCombinations(cmdLineSettings
 func (actor Actor) validateMergedSettings(apps []manifest.Application) error {
 	for i, app := range apps {
 		log.WithField("index", i).Info("validating app")
-		if app.Name == "" {
-			log.WithField("index", i).Error("does not contain an app name")
-			return actionerror.MissingNameError{}
+		if problems := actor.applicationProblems(app); len(problems) > 0 {
+			return problems[0].err
 		}
+	}
+	return nil
+}
 
-		for _, route := range app.Routes {
-			err := actor.validateRoute(route)
-			if err != nil {
-				return err
-			}
-		}
+// applicationProblem is an invalid setting of an application and the
+// manifest field it comes from.
+type applicationProblem struct {
+	field string
+	err   error
+}
 
-		if app.DockerImage != "" {
-			if app.DockerUsername != "" && app.DockerPassword == "" {
-				log.WithField("app", app.Name).Error("no docker password found")
-				return actionerror.DockerPasswordNotSetError{}
-			}
-			if app.Buildpack.IsSet {
-				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "buildpack"}}
-			}
-			if app.Path != "" {
-				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "path"}}
-			}
-			if app.DropletPath != "" {
-				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "droplet"}}
-			}
-		}
+// applicationProblems returns every invalid setting of the application, in
+// the order in which push reports them.
+func (actor Actor) applicationProblems(app manifest.Application) []applicationProblem {
+	var problems []applicationProblem
+	if app.Name == "" {
+		log.Error("does not contain an app name")
+		problems = append(problems, applicationProblem{field: "name", err: actionerror.MissingNameError{}})
+	}
 
-		if app.DropletPath != "" && app.Path != "" {
-			return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"droplet", "path"}}
+	for _, route := range app.Routes {
+		err := actor.validateRoute(route)
+		if err != nil {
+			problems = append(problems, applicationProblem{field: "routes", err: err})
 		}
+	}
 
-		if app.DockerImage == "" && app.DropletPath == "" {
-			_, err := os.Stat(app.Path)
-			if os.IsNotExist(err) {
-				log.WithField("path", app.Path).Error("app path does not exist")
-				return actionerror.NonexistentAppPathError{Path: app.Path}
-			}
+	if app.DockerImage != "" {
+		if app.DockerUsername != "" && app.DockerPassword == "" {
+			log.WithField("app", app.Name).Error("no docker password found")
+			problems = append(problems, applicationProblem{field: "docker", err: actionerror.DockerPasswordNotSetError{}})
+		}
+		if app.Buildpack.IsSet {
+			problems = append(problems, applicationProblem{field: "buildpack", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "buildpack"}}})
 		}
+		if app.Path != "" {
+			problems = append(problems, applicationProblem{field: "path", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "path"}}})
+		}
+		if app.DropletPath != "" {
+			problems = append(problems, applicationProblem{field: "droplet-path", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"docker", "droplet"}}})
+		}
+	}
 
-		if app.NoRoute {
-			if app.Hostname != "" {
-				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"hostname", "no-route"}}
-			}
-			if app.NoHostname {
-				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"no-hostname", "no-route"}}
-			}
-			if app.RoutePath != "" {
-				return actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"route-path", "no-route"}}
-			}
+	if app.DropletPath != "" && app.Path != "" {
+		problems = append(problems, applicationProblem{field: "droplet-path", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"droplet", "path"}}})
+	}
+
+	if app.DockerImage == "" && app.DropletPath == "" {
+		_, err := os.Stat(app.Path)
+		if os.IsNotExist(err) {
+			log.WithField("path", app.Path).Error("app path does not exist")
+			problems = append(problems, applicationProblem{field: "path", err: actionerror.NonexistentAppPathError{Path: app.Path}})
 		}
+	}
 
-		if app.HealthCheckHTTPEndpoint != "" && app.HealthCheckType != "http" {
-			return actionerror.HTTPHealthCheckInvalidError{}
+	if app.NoRoute {
+		if app.Hostname != "" {
+			problems = append(problems, applicationProblem{field: "no-route", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"hostname", "no-route"}}})
+		}
+		if app.NoHostname {
+			problems = append(problems, applicationProblem{field: "no-route", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"no-hostname", "no-route"}}})
+		}
+		if app.RoutePath != "" {
+			problems = append(problems, applicationProblem{field: "no-route", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"route-path", "no-route"}}})
 		}
 	}
-	return nil
+
+	if app.HealthCheckHTTPEndpoint != "" && app.HealthCheckType != "http" {
+		problems = append(problems, applicationProblem{field: "health-check-http-endpoint", err: actionerror.HTTPHealthCheckInvalidError{}})
+	}
+
+	return problems
 }
 
 func (actor Actor) validateRoute(route string) error {
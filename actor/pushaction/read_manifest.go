@@ -2,7 +2,7 @@ package pushaction
 
 import "code.cloudfoundry.org/cli/util/manifest"
 
-func (*Actor) ReadManifest(pathToManifest string, pathToVarsFile string) ([]manifest.Application, error) {
+func (*Actor) ReadManifest(pathToManifest string, sources manifest.VariableSources) ([]manifest.Application, error) {
 	// Cover method to make testing easier
-	return manifest.ReadAndInterpolateManifest(pathToManifest, pathToVarsFile)
+	return manifest.ReadAndInterpolateManifest(pathToManifest, sources)
 }
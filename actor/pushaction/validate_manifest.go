@@ -0,0 +1,51 @@
+package pushaction
+
+import (
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// ValidateManifest lints the manifest at the provided path and checks its
+// applications with the same rules push applies, and their processes with the
+// same rules v3-push applies, returning every problem found instead of
+// stopping at the first one.
+func (actor Actor) ValidateManifest(pathToManifest string, sources manifest.VariableSources) ([]manifest.Problem, error) {
+	result, err := manifest.LintManifest(pathToManifest, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range result.Applications {
+		var problems []applicationProblem
+		if app.NoRoute && len(app.Routes) > 0 {
+			problems = append(problems, applicationProblem{field: "no-route", err: actionerror.PropertyCombinationError{AppName: app.Name, Properties: []string{"no-route", "routes"}}})
+		}
+
+		// Push defaults the path of apps that are not pushed from a docker
+		// image or a droplet to the current directory.
+		if app.Path == "" && app.DockerImage == "" && app.DropletPath == "" {
+			app.Path = filepath.Dir(pathToManifest)
+		}
+		problems = append(problems, actor.applicationProblems(app.Application)...)
+
+		processErrors := manifestparser.ProcessErrors(manifestparser.Application{Name: app.Name, Processes: app.Processes})
+		for _, err := range processErrors {
+			problems = append(problems, applicationProblem{field: "processes", err: err})
+		}
+
+		for _, problem := range problems {
+			// The docker password is read from the environment during push.
+			if _, ok := problem.err.(actionerror.DockerPasswordNotSetError); ok {
+				continue
+			}
+			result.AddProblem(app.Index, problem.field, strings.TrimSpace(problem.err.Error()))
+		}
+	}
+
+	result.SortProblems()
+	return result.Problems, nil
+}
@@ -0,0 +1,138 @@
+package pushaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		actor *Actor
+
+		manifestDir    string
+		pathToManifest string
+		rawManifest    string
+
+		problems   []manifest.Problem
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil)
+
+		var err error
+		manifestDir, err = ioutil.TempDir("", "validate-manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		pathToManifest = filepath.Join(manifestDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(manifestDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		err := ioutil.WriteFile(pathToManifest, []byte(rawManifest), 0600)
+		Expect(err).ToNot(HaveOccurred())
+
+		problems, executeErr = actor.ValidateManifest(pathToManifest, manifest.VariableSources{})
+	})
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- name: some-app
+  routes:
+  - route: some-host.some-domain.com
+- name: some-docker-app
+  docker:
+    image: some-image
+    username: some-user
+`
+		})
+
+		It("returns no problems", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(problems).To(BeEmpty())
+		})
+	})
+
+	Context("when the applications have invalid settings", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- memory: 1G
+  health-check-type: port
+  health-check-http-endpoint: /health
+- name: some-docker-app
+  buildpack: some-buildpack
+  docker:
+    image: some-image
+  no-route: true
+  routes:
+  - route: "%%%"
+- name: some-app
+  path: does-not-exist
+`
+		})
+
+		It("returns every problem with its position", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(problems).To(Equal([]manifest.Problem{
+				{Line: 3, Column: 3, Message: "name not specified for app"},
+				{Line: 5, Column: 3, Message: "Health check type must be 'http' to set a health check HTTP endpoint"},
+				{Line: 7, Column: 3, Message: "Cannot use the following properties together: docker, buildpack"},
+				{Line: 10, Column: 3, Message: "Cannot use the following properties together: no-route, routes"},
+				{Line: 11, Column: 3, Message: "Route '%%%' is not parsable by URI"},
+				{Line: 14, Column: 3, Message: "app path not found:" + filepath.Join(manifestDir, "does-not-exist")},
+			}))
+		})
+	})
+
+	Context("when the applications have processes", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- name: some-app
+  processes:
+  - type: web
+    memory: 256M
+  - type: worker
+    health-check-type: none
+    instances: -1
+- name: other-app
+  processes: not-a-list
+`
+		})
+
+		It("checks the processes like v3-push", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(problems).To(HaveLen(3))
+			Expect(problems[0]).To(Equal(manifest.Problem{Line: 4, Column: 3, Message: "Invalid health-check-type none for process worker of application some-app: must be http, port or process"}))
+			Expect(problems[1]).To(Equal(manifest.Problem{Line: 4, Column: 3, Message: "Instances for process worker of application some-app cannot be negative"}))
+			Expect(problems[2].Line).To(Equal(11))
+			Expect(problems[2].Message).To(HavePrefix("processes: "))
+		})
+	})
+
+	Context("when the manifest cannot be read", func() {
+		JustBeforeEach(func() {
+			problems, executeErr = actor.ValidateManifest(filepath.Join(manifestDir, "does-not-exist.yml"), manifest.VariableSources{})
+		})
+
+		BeforeEach(func() {
+			rawManifest = "---\n"
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,155 @@
+package sharedaction
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CompletionResource is a kind of resource whose names can be completed from
+// the currently targeted API.
+type CompletionResource string
+
+const (
+	NoCompletion              CompletionResource = ""
+	AppCompletion             CompletionResource = "app"
+	ServiceInstanceCompletion CompletionResource = "service"
+	OrgCompletion             CompletionResource = "org"
+	SpaceCompletion           CompletionResource = "space"
+)
+
+// positionalArgResources maps positional argument names to the resource they
+// name.
+var positionalArgResources = map[string]CompletionResource{
+	"APP_NAME":         AppCompletion,
+	"SOURCE_APP":       AppCompletion,
+	"SOURCE-APP":       AppCompletion,
+	"SERVICE_INSTANCE": ServiceInstanceCompletion,
+	"ORG":              OrgCompletion,
+	"ORG_NAME":         OrgCompletion,
+	"SPACE":            SpaceCompletion,
+	"SPACE_NAME":       SpaceCompletion,
+}
+
+// CompletionCommand contains the details of a command needed to complete its
+// flags and arguments in a shell.
+type CompletionCommand struct {
+	// Name is the command name
+	Name string
+
+	// Alias is the command alias
+	Alias string
+
+	// Description is the command description
+	Description string
+
+	// Flags are the visible flags of the command
+	Flags []CompletionFlag
+
+	// Arguments are the resources named by the command's positional
+	// arguments, in order. Arguments that do not name a resource are
+	// NoCompletion.
+	Arguments []CompletionResource
+}
+
+// CompletionFlag contains the details of a flag needed to complete it and its
+// value in a shell.
+type CompletionFlag struct {
+	// Short is the short form of the flag
+	Short string
+
+	// Long is the long form of the flag
+	Long string
+
+	// Description is the description of the flag
+	Description string
+
+	// TakesValue is true if the flag is followed by a value
+	TakesValue bool
+
+	// Resource is the resource named by the flag's value
+	Resource CompletionResource
+}
+
+// CompletionCommands returns the completion details of all visible commands in
+// commandList, sorted by name.
+func (Actor) CompletionCommands(commandList interface{}) []CompletionCommand {
+	handler := reflect.TypeOf(commandList)
+
+	var commands []CompletionCommand
+	for i := 0; i < handler.NumField(); i++ {
+		field := handler.Field(i)
+		name := field.Tag.Get("command")
+		if name == "" || field.Tag.Get("hidden") != "" {
+			continue
+		}
+
+		command := CompletionCommand{
+			Name:        name,
+			Alias:       field.Tag.Get("alias"),
+			Description: field.Tag.Get("description"),
+		}
+
+		for j := 0; j < field.Type.NumField(); j++ {
+			commandField := field.Type.Field(j)
+			tag := commandField.Tag
+
+			if tag.Get("positional-args") != "" {
+				command.Arguments = positionalArgs(commandField.Type)
+				continue
+			}
+
+			if tag.Get("hidden") != "" || (tag.Get("short") == "" && tag.Get("long") == "") {
+				continue
+			}
+
+			command.Flags = append(command.Flags, CompletionFlag{
+				Short:       tag.Get("short"),
+				Long:        tag.Get("long"),
+				Description: tag.Get("description"),
+				TakesValue:  commandField.Type.Kind() != reflect.Bool,
+				Resource:    flagResource(commandField),
+			})
+		}
+
+		commands = append(commands, command)
+	}
+
+	sort.Slice(commands, func(i int, j int) bool {
+		return commands[i].Name < commands[j].Name
+	})
+
+	return commands
+}
+
+func positionalArgs(argsType reflect.Type) []CompletionResource {
+	if argsType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var resources []CompletionResource
+	for i := 0; i < argsType.NumField(); i++ {
+		tag := argsType.Field(i).Tag
+		name := tag.Get("positional-arg-name")
+		if name == "" {
+			name = argsType.Field(i).Name
+		}
+		resources = append(resources, positionalArgResources[name])
+	}
+	return resources
+}
+
+func flagResource(field reflect.StructField) CompletionResource {
+	if field.Type.Kind() != reflect.String {
+		return NoCompletion
+	}
+
+	switch {
+	case field.Name == "Organization" || strings.HasSuffix(field.Name, "Org") || strings.HasSuffix(field.Name, "OrgName"):
+		return OrgCompletion
+	case strings.HasSuffix(field.Name, "Space") || strings.HasSuffix(field.Name, "SpaceName"):
+		return SpaceCompletion
+	default:
+		return NoCompletion
+	}
+}
@@ -0,0 +1,79 @@
+package sharedaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type completionCommandList struct {
+	VerboseOrVersion bool               `short:"v" long:"version" description:"verbose and version flag"`
+	Target           targetCommand      `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	BindService      bindServiceCommand `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
+	Secret           targetCommand      `command:"secret" hidden:"true" description:"Hidden command"`
+}
+
+type targetCommand struct {
+	Organization string      `short:"o" description:"Organization"`
+	Space        string      `short:"s" description:"Space"`
+	usage        interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+}
+
+type bindServiceArgs struct {
+	AppName         string `positional-arg-name:"APP_NAME" required:"true"`
+	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true"`
+	Position        string `positional-arg-name:"POSITION"`
+}
+
+type bindServiceCommand struct {
+	RequiredArgs      bindServiceArgs `positional-args:"yes"`
+	ParametersAsJSON  string          `short:"c" description:"Valid JSON object"`
+	BindingName       string          `long:"binding-name" description:"Name to expose service instance to app process with"`
+	Force             bool            `short:"f" long:"force" description:"Force"`
+	SkipSSLValidation bool            `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	relatedCommands   interface{}     `related_commands:"services"`
+}
+
+var _ = Describe("Completion Actions", func() {
+	var actor *Actor
+
+	BeforeEach(func() {
+		actor = NewActor(&sharedactionfakes.FakeConfig{})
+	})
+
+	Describe("CompletionCommands", func() {
+		It("returns the visible commands sorted by name", func() {
+			commands := actor.CompletionCommands(completionCommandList{})
+			Expect(commands).To(HaveLen(2))
+			Expect(commands[0].Name).To(Equal("bind-service"))
+			Expect(commands[0].Alias).To(Equal("bs"))
+			Expect(commands[0].Description).To(Equal("Bind a service instance to an app"))
+			Expect(commands[1].Name).To(Equal("target"))
+		})
+
+		It("returns the resources named by positional arguments", func() {
+			commands := actor.CompletionCommands(completionCommandList{})
+			Expect(commands[0].Arguments).To(Equal([]CompletionResource{AppCompletion, ServiceInstanceCompletion, NoCompletion}))
+			Expect(commands[1].Arguments).To(BeEmpty())
+		})
+
+		It("returns the visible flags and whether they take values", func() {
+			commands := actor.CompletionCommands(completionCommandList{})
+			Expect(commands[0].Flags).To(Equal([]CompletionFlag{
+				{Short: "c", Description: "Valid JSON object", TakesValue: true},
+				{Long: "binding-name", Description: "Name to expose service instance to app process with", TakesValue: true},
+				{Short: "f", Long: "force", Description: "Force"},
+			}))
+		})
+
+		It("returns the resources named by org and space flags", func() {
+			commands := actor.CompletionCommands(completionCommandList{})
+			Expect(commands[1].Flags).To(Equal([]CompletionFlag{
+				{Short: "o", Description: "Organization", TakesValue: true, Resource: OrgCompletion},
+				{Short: "s", Description: "Space", TakesValue: true, Resource: SpaceCompletion},
+			}))
+		})
+	})
+})
@@ -1,5 +1,7 @@
 package sharedaction
 
+import "code.cloudfoundry.org/cli/util/configv3"
+
 //go:generate counterfeiter . Config
 
 // Config a way of getting basic CF configuration
@@ -8,6 +10,10 @@ type Config interface {
 	BinaryName() string
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	Plugins() []configv3.Plugin
 	RefreshToken() string
+	RemoveUserAlias(name string)
+	SetUserAlias(name string, steps []string)
+	UserAliases() []configv3.UserAlias
 	Verbose() (bool, []string)
 }
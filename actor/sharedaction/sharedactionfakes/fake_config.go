@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type FakeConfig struct {
@@ -44,6 +45,15 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PluginsStub        func() []configv3.Plugin
+	pluginsMutex       sync.RWMutex
+	pluginsArgsForCall []struct{}
+	pluginsReturns     struct {
+		result1 []configv3.Plugin
+	}
+	pluginsReturnsOnCall map[int]struct {
+		result1 []configv3.Plugin
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -53,6 +63,26 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RemoveUserAliasStub        func(name string)
+	removeUserAliasMutex       sync.RWMutex
+	removeUserAliasArgsForCall []struct {
+		name string
+	}
+	SetUserAliasStub        func(name string, steps []string)
+	setUserAliasMutex       sync.RWMutex
+	setUserAliasArgsForCall []struct {
+		name  string
+		steps []string
+	}
+	UserAliasesStub        func() []configv3.UserAlias
+	userAliasesMutex       sync.RWMutex
+	userAliasesArgsForCall []struct{}
+	userAliasesReturns     struct {
+		result1 []configv3.UserAlias
+	}
+	userAliasesReturnsOnCall map[int]struct {
+		result1 []configv3.UserAlias
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct{}
@@ -228,6 +258,46 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) Plugins() []configv3.Plugin {
+	fake.pluginsMutex.Lock()
+	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
+	fake.pluginsArgsForCall = append(fake.pluginsArgsForCall, struct{}{})
+	fake.recordInvocation("Plugins", []interface{}{})
+	fake.pluginsMutex.Unlock()
+	if fake.PluginsStub != nil {
+		return fake.PluginsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginsReturns.result1
+}
+
+func (fake *FakeConfig) PluginsCallCount() int {
+	fake.pluginsMutex.RLock()
+	defer fake.pluginsMutex.RUnlock()
+	return len(fake.pluginsArgsForCall)
+}
+
+func (fake *FakeConfig) PluginsReturns(result1 []configv3.Plugin) {
+	fake.PluginsStub = nil
+	fake.pluginsReturns = struct {
+		result1 []configv3.Plugin
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginsReturnsOnCall(i int, result1 []configv3.Plugin) {
+	fake.PluginsStub = nil
+	if fake.pluginsReturnsOnCall == nil {
+		fake.pluginsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Plugin
+		})
+	}
+	fake.pluginsReturnsOnCall[i] = struct {
+		result1 []configv3.Plugin
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -268,6 +338,100 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RemoveUserAlias(name string) {
+	fake.removeUserAliasMutex.Lock()
+	fake.removeUserAliasArgsForCall = append(fake.removeUserAliasArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveUserAlias", []interface{}{name})
+	fake.removeUserAliasMutex.Unlock()
+	if fake.RemoveUserAliasStub != nil {
+		fake.RemoveUserAliasStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveUserAliasCallCount() int {
+	fake.removeUserAliasMutex.RLock()
+	defer fake.removeUserAliasMutex.RUnlock()
+	return len(fake.removeUserAliasArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveUserAliasArgsForCall(i int) string {
+	fake.removeUserAliasMutex.RLock()
+	defer fake.removeUserAliasMutex.RUnlock()
+	return fake.removeUserAliasArgsForCall[i].name
+}
+
+func (fake *FakeConfig) SetUserAlias(name string, steps []string) {
+	var stepsCopy []string
+	if steps != nil {
+		stepsCopy = make([]string, len(steps))
+		copy(stepsCopy, steps)
+	}
+	fake.setUserAliasMutex.Lock()
+	fake.setUserAliasArgsForCall = append(fake.setUserAliasArgsForCall, struct {
+		name  string
+		steps []string
+	}{name, stepsCopy})
+	fake.recordInvocation("SetUserAlias", []interface{}{name, stepsCopy})
+	fake.setUserAliasMutex.Unlock()
+	if fake.SetUserAliasStub != nil {
+		fake.SetUserAliasStub(name, steps)
+	}
+}
+
+func (fake *FakeConfig) SetUserAliasCallCount() int {
+	fake.setUserAliasMutex.RLock()
+	defer fake.setUserAliasMutex.RUnlock()
+	return len(fake.setUserAliasArgsForCall)
+}
+
+func (fake *FakeConfig) SetUserAliasArgsForCall(i int) (string, []string) {
+	fake.setUserAliasMutex.RLock()
+	defer fake.setUserAliasMutex.RUnlock()
+	return fake.setUserAliasArgsForCall[i].name, fake.setUserAliasArgsForCall[i].steps
+}
+
+func (fake *FakeConfig) UserAliases() []configv3.UserAlias {
+	fake.userAliasesMutex.Lock()
+	ret, specificReturn := fake.userAliasesReturnsOnCall[len(fake.userAliasesArgsForCall)]
+	fake.userAliasesArgsForCall = append(fake.userAliasesArgsForCall, struct{}{})
+	fake.recordInvocation("UserAliases", []interface{}{})
+	fake.userAliasesMutex.Unlock()
+	if fake.UserAliasesStub != nil {
+		return fake.UserAliasesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.userAliasesReturns.result1
+}
+
+func (fake *FakeConfig) UserAliasesCallCount() int {
+	fake.userAliasesMutex.RLock()
+	defer fake.userAliasesMutex.RUnlock()
+	return len(fake.userAliasesArgsForCall)
+}
+
+func (fake *FakeConfig) UserAliasesReturns(result1 []configv3.UserAlias) {
+	fake.UserAliasesStub = nil
+	fake.userAliasesReturns = struct {
+		result1 []configv3.UserAlias
+	}{result1}
+}
+
+func (fake *FakeConfig) UserAliasesReturnsOnCall(i int, result1 []configv3.UserAlias) {
+	fake.UserAliasesStub = nil
+	if fake.userAliasesReturnsOnCall == nil {
+		fake.userAliasesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.UserAlias
+		})
+	}
+	fake.userAliasesReturnsOnCall[i] = struct {
+		result1 []configv3.UserAlias
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -322,8 +486,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.pluginsMutex.RLock()
+	defer fake.pluginsMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.removeUserAliasMutex.RLock()
+	defer fake.removeUserAliasMutex.RUnlock()
+	fake.setUserAliasMutex.RLock()
+	defer fake.setUserAliasMutex.RUnlock()
+	fake.userAliasesMutex.RLock()
+	defer fake.userAliasesMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,202 @@
+package sharedaction
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+//go:generate counterfeiter . CommandList
+
+// CommandList lists the core commands of the CLI.
+type CommandList interface {
+	HasCommand(string) bool
+	HasAlias(string) bool
+}
+
+// SetUserAlias saves a user-defined alias that runs each of steps, which are
+// CLI command lines without the binary name, in order. The name must not be
+// used by a core or plugin command or alias, and the steps must not refer back
+// to the alias.
+func (actor Actor) SetUserAlias(commandList CommandList, name string, steps []string) error {
+	if name == "" || strings.HasPrefix(name, "-") || strings.IndexFunc(name, unicode.IsSpace) != -1 {
+		return actionerror.InvalidUserAliasError{Name: name, Reason: "alias names cannot be empty, start with '-' or contain spaces"}
+	}
+
+	if isCommand, pluginName := actor.commandOwner(commandList, name); isCommand {
+		return actionerror.UserAliasConflictError{Name: name, PluginName: pluginName}
+	}
+
+	if len(steps) == 0 {
+		return actionerror.InvalidUserAliasError{Name: name, Reason: "at least one command is required"}
+	}
+
+	aliases := actor.userAliasSteps()
+	aliases[name] = steps
+	_, err := actor.expandUserAlias(commandList, aliases, name, []string{name})
+	if err != nil {
+		return err
+	}
+
+	actor.Config.SetUserAlias(name, steps)
+	return nil
+}
+
+// DeleteUserAlias removes a user-defined alias.
+func (actor Actor) DeleteUserAlias(name string) error {
+	if _, ok := actor.userAliasSteps()[name]; !ok {
+		return actionerror.UserAliasNotFoundError{Name: name}
+	}
+
+	actor.Config.RemoveUserAlias(name)
+	return nil
+}
+
+// ExpandUserAlias returns the command lines to run when args starts with the
+// name of a user-defined alias, and false when it does not. Aliases used in an
+// alias's steps are expanded, and the rest of args is appended to the last
+// command line. Core and plugin commands take precedence over aliases with
+// the same name.
+func (actor Actor) ExpandUserAlias(commandList CommandList, args []string) ([][]string, bool, error) {
+	if len(args) == 0 {
+		return nil, false, nil
+	}
+
+	aliases := actor.userAliasSteps()
+	if _, ok := aliases[args[0]]; !ok {
+		return nil, false, nil
+	}
+	if isCommand, _ := actor.commandOwner(commandList, args[0]); isCommand {
+		return nil, false, nil
+	}
+
+	commandLines, err := actor.expandUserAlias(commandList, aliases, args[0], []string{args[0]})
+	if err != nil {
+		return nil, true, err
+	}
+
+	last := len(commandLines) - 1
+	commandLines[last] = append(commandLines[last], args[1:]...)
+	return commandLines, true, nil
+}
+
+func (actor Actor) expandUserAlias(commandList CommandList, aliases map[string][]string, name string, path []string) ([][]string, error) {
+	var commandLines [][]string
+	for _, step := range aliases[name] {
+		words, err := splitCommandLine(step)
+		if err != nil {
+			return nil, actionerror.InvalidUserAliasError{Name: name, Reason: err.Error()}
+		}
+		if len(words) == 0 {
+			return nil, actionerror.InvalidUserAliasError{Name: name, Reason: "commands cannot be empty"}
+		}
+
+		_, isAlias := aliases[words[0]]
+		if isCommand, _ := actor.commandOwner(commandList, words[0]); !isAlias || isCommand {
+			commandLines = append(commandLines, words)
+			continue
+		}
+
+		for _, visited := range path {
+			if visited == words[0] {
+				return nil, actionerror.UserAliasCycleError{Names: append(path, words[0])}
+			}
+		}
+
+		nested, err := actor.expandUserAlias(commandList, aliases, words[0], append(path, words[0]))
+		if err != nil {
+			return nil, err
+		}
+		last := len(nested) - 1
+		nested[last] = append(nested[last], words[1:]...)
+		commandLines = append(commandLines, nested...)
+	}
+
+	return commandLines, nil
+}
+
+// commandOwner returns true if name is a core or plugin command name or
+// alias, along with the name of the plugin providing it.
+func (actor Actor) commandOwner(commandList CommandList, name string) (bool, string) {
+	if commandList.HasCommand(name) || commandList.HasAlias(name) {
+		return true, ""
+	}
+
+	for _, plugin := range actor.Config.Plugins() {
+		for _, command := range plugin.Commands {
+			if command.Name == name || command.Alias == name {
+				return true, plugin.Name
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func (actor Actor) userAliasSteps() map[string][]string {
+	aliases := map[string][]string{}
+	for _, alias := range actor.Config.UserAliases() {
+		aliases[alias.Name] = alias.Steps
+	}
+	return aliases
+}
+
+// splitCommandLine splits a command line into words the way a POSIX shell
+// does, honoring single quotes, double quotes and backslash escapes.
+func splitCommandLine(line string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, char := range line {
+		switch {
+		case escaped:
+			word.WriteRune(char)
+			escaped = false
+		case quote == '\'':
+			if char == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(char)
+			}
+		case char == '\\':
+			escaped = true
+			inWord = true
+		case quote == '"':
+			if char == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inWord = true
+		case unicode.IsSpace(char):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(char)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+	if escaped {
+		return nil, errors.New("trailing backslash")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
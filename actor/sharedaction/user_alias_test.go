@@ -0,0 +1,166 @@
+package sharedaction_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Alias Actions", func() {
+	var (
+		actor           *Actor
+		fakeConfig      *sharedactionfakes.FakeConfig
+		fakeCommandList *sharedactionfakes.FakeCommandList
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeCommandList = new(sharedactionfakes.FakeCommandList)
+		actor = NewActor(fakeConfig)
+
+		fakeCommandList.HasCommandStub = func(name string) bool {
+			return name == "push" || name == "start" || name == "stop"
+		}
+		fakeCommandList.HasAliasStub = func(name string) bool {
+			return name == "p"
+		}
+		fakeConfig.PluginsReturns([]configv3.Plugin{
+			{
+				Name:     "some-plugin",
+				Commands: []configv3.PluginCommand{{Name: "some-plugin-command", Alias: "spc"}},
+			},
+		})
+		fakeConfig.UserAliasesReturns([]configv3.UserAlias{
+			{Name: "deploy", Steps: []string{`push -f "manifest prod.yml"`}},
+			{Name: "bounce", Steps: []string{"stop my-app", "start my-app"}},
+			{Name: "redeploy", Steps: []string{"bounce", "deploy --no-start"}},
+			{Name: "push", Steps: []string{"stop my-app"}},
+		})
+	})
+
+	Describe("SetUserAlias", func() {
+		It("saves the alias", func() {
+			err := actor.SetUserAlias(fakeCommandList, "logs-prod", []string{"logs my-app --recent"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetUserAliasCallCount()).To(Equal(1))
+			name, steps := fakeConfig.SetUserAliasArgsForCall(0)
+			Expect(name).To(Equal("logs-prod"))
+			Expect(steps).To(Equal([]string{"logs my-app --recent"}))
+		})
+
+		It("allows aliases that use other aliases", func() {
+			err := actor.SetUserAlias(fakeCommandList, "release", []string{"redeploy", "bounce"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("rejects invalid names",
+			func(name string) {
+				err := actor.SetUserAlias(fakeCommandList, name, []string{"push"})
+				Expect(err).To(MatchError(actionerror.InvalidUserAliasError{Name: name, Reason: "alias names cannot be empty, start with '-' or contain spaces"}))
+				Expect(fakeConfig.SetUserAliasCallCount()).To(Equal(0))
+			},
+			Entry("empty", ""),
+			Entry("flag", "-f"),
+			Entry("space", "my alias"),
+		)
+
+		DescribeTable("rejects names used by commands",
+			func(name string, expectedErr error) {
+				err := actor.SetUserAlias(fakeCommandList, name, []string{"start my-app"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(fakeConfig.SetUserAliasCallCount()).To(Equal(0))
+			},
+			Entry("core command", "push", actionerror.UserAliasConflictError{Name: "push"}),
+			Entry("core alias", "p", actionerror.UserAliasConflictError{Name: "p"}),
+			Entry("plugin command", "some-plugin-command", actionerror.UserAliasConflictError{Name: "some-plugin-command", PluginName: "some-plugin"}),
+			Entry("plugin alias", "spc", actionerror.UserAliasConflictError{Name: "spc", PluginName: "some-plugin"}),
+		)
+
+		It("requires at least one command", func() {
+			err := actor.SetUserAlias(fakeCommandList, "empty", nil)
+			Expect(err).To(MatchError(actionerror.InvalidUserAliasError{Name: "empty", Reason: "at least one command is required"}))
+		})
+
+		It("rejects command lines that cannot be parsed", func() {
+			err := actor.SetUserAlias(fakeCommandList, "broken", []string{`push "my-app`})
+			Expect(err).To(MatchError(actionerror.InvalidUserAliasError{Name: "broken", Reason: "unterminated quote"}))
+		})
+
+		It("rejects empty command lines", func() {
+			err := actor.SetUserAlias(fakeCommandList, "blank", []string{"  "})
+			Expect(err).To(MatchError(actionerror.InvalidUserAliasError{Name: "blank", Reason: "commands cannot be empty"}))
+		})
+
+		It("rejects aliases that refer back to themselves", func() {
+			err := actor.SetUserAlias(fakeCommandList, "bounce", []string{"redeploy"})
+			Expect(err).To(MatchError(actionerror.UserAliasCycleError{Names: []string{"bounce", "redeploy", "bounce"}}))
+			Expect(fakeConfig.SetUserAliasCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("DeleteUserAlias", func() {
+		It("removes the alias", func() {
+			Expect(actor.DeleteUserAlias("deploy")).To(Succeed())
+			Expect(fakeConfig.RemoveUserAliasCallCount()).To(Equal(1))
+			Expect(fakeConfig.RemoveUserAliasArgsForCall(0)).To(Equal("deploy"))
+		})
+
+		It("returns a UserAliasNotFoundError when the alias does not exist", func() {
+			err := actor.DeleteUserAlias("missing")
+			Expect(err).To(MatchError(actionerror.UserAliasNotFoundError{Name: "missing"}))
+			Expect(fakeConfig.RemoveUserAliasCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("ExpandUserAlias", func() {
+		It("splits the alias's command line and appends the arguments", func() {
+			commandLines, isAlias, err := actor.ExpandUserAlias(fakeCommandList, []string{"deploy", "--no-start"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isAlias).To(BeTrue())
+			Expect(commandLines).To(Equal([][]string{{"push", "-f", "manifest prod.yml", "--no-start"}}))
+		})
+
+		It("expands aliases used by the alias", func() {
+			commandLines, isAlias, err := actor.ExpandUserAlias(fakeCommandList, []string{"redeploy", "-s", "cflinuxfs2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isAlias).To(BeTrue())
+			Expect(commandLines).To(Equal([][]string{
+				{"stop", "my-app"},
+				{"start", "my-app"},
+				{"push", "-f", "manifest prod.yml", "--no-start", "-s", "cflinuxfs2"},
+			}))
+		})
+
+		It("does not expand core commands, even when an alias has the same name", func() {
+			_, isAlias, err := actor.ExpandUserAlias(fakeCommandList, []string{"push"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isAlias).To(BeFalse())
+		})
+
+		It("does not expand unknown names", func() {
+			_, isAlias, err := actor.ExpandUserAlias(fakeCommandList, []string{"some-plugin-command"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isAlias).To(BeFalse())
+		})
+
+		Context("when the aliases refer to each other in a loop", func() {
+			BeforeEach(func() {
+				fakeConfig.UserAliasesReturns([]configv3.UserAlias{
+					{Name: "a", Steps: []string{"b"}},
+					{Name: "b", Steps: []string{"a"}},
+				})
+			})
+
+			It("returns a UserAliasCycleError", func() {
+				_, isAlias, err := actor.ExpandUserAlias(fakeCommandList, []string{"a"})
+				Expect(isAlias).To(BeTrue())
+				Expect(err).To(MatchError(actionerror.UserAliasCycleError{Names: []string{"a", "b", "a"}}))
+			})
+		})
+	})
+})
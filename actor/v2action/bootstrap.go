@@ -0,0 +1,412 @@
+package v2action
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// OrganizationTemplate describes an organization, its users and its spaces.
+type OrganizationTemplate struct {
+	Name                    string          `yaml:"name"`
+	Quota                   string          `yaml:"quota"`
+	DefaultIsolationSegment string          `yaml:"default_isolation_segment"`
+	Users                   []string        `yaml:"users"`
+	Managers                []string        `yaml:"managers"`
+	BillingManagers         []string        `yaml:"billing_managers"`
+	Auditors                []string        `yaml:"auditors"`
+	Spaces                  []SpaceTemplate `yaml:"spaces"`
+}
+
+// SpaceTemplate describes a space of an OrganizationTemplate.
+type SpaceTemplate struct {
+	Name                  string   `yaml:"name"`
+	Quota                 string   `yaml:"quota"`
+	Managers              []string `yaml:"managers"`
+	Developers            []string `yaml:"developers"`
+	Auditors              []string `yaml:"auditors"`
+	SecurityGroups        []string `yaml:"security_groups"`
+	StagingSecurityGroups []string `yaml:"staging_security_groups"`
+}
+
+// BootstrapResourceType is the type of resource converged by
+// BootstrapOrganization.
+type BootstrapResourceType string
+
+const (
+	BootstrapResourceOrganization         BootstrapResourceType = "org"
+	BootstrapResourceOrganizationQuota    BootstrapResourceType = "org quota"
+	BootstrapResourceOrganizationRole     BootstrapResourceType = "org role"
+	BootstrapResourceIsolationSegment     BootstrapResourceType = "isolation segment"
+	BootstrapResourceSpace                BootstrapResourceType = "space"
+	BootstrapResourceSpaceQuota           BootstrapResourceType = "space quota"
+	BootstrapResourceSpaceRole            BootstrapResourceType = "space role"
+	BootstrapResourceSecurityGroup        BootstrapResourceType = "security group"
+	BootstrapResourceStagingSecurityGroup BootstrapResourceType = "staging security group"
+)
+
+// BootstrapAction is what was done to converge a resource.
+type BootstrapAction string
+
+const (
+	// BootstrapCreated means the resource or assignment did not exist.
+	BootstrapCreated BootstrapAction = "created"
+	// BootstrapUpdated means an assignment was changed, e.g. the quota of an
+	// existing organization.
+	BootstrapUpdated BootstrapAction = "updated"
+	// BootstrapUnchanged means the resource already matched the template.
+	BootstrapUnchanged BootstrapAction = "unchanged"
+)
+
+// BootstrapResult is the outcome of converging a single resource.
+type BootstrapResult struct {
+	Type   BootstrapResourceType
+	Name   string
+	Action BootstrapAction
+}
+
+// ReadOrganizationTemplate reads the organization template from the YAML file
+// at the provided path.
+func (Actor) ReadOrganizationTemplate(path string) (OrganizationTemplate, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return OrganizationTemplate{}, err
+	}
+
+	var template OrganizationTemplate
+	err = yaml.UnmarshalStrict(raw, &template)
+	if err != nil {
+		return OrganizationTemplate{}, actionerror.InvalidOrganizationTemplateError{Path: path, Reason: err.Error()}
+	}
+
+	if template.Name == "" {
+		return OrganizationTemplate{}, actionerror.InvalidOrganizationTemplateError{Path: path, Reason: "the org must have a name"}
+	}
+
+	spaceNames := map[string]bool{}
+	for _, space := range template.Spaces {
+		if space.Name == "" {
+			return OrganizationTemplate{}, actionerror.InvalidOrganizationTemplateError{Path: path, Reason: "every space must have a name"}
+		}
+		if spaceNames[space.Name] {
+			return OrganizationTemplate{}, actionerror.InvalidOrganizationTemplateError{Path: path, Reason: fmt.Sprintf("space %s is listed more than once", space.Name)}
+		}
+		spaceNames[space.Name] = true
+	}
+
+	return template, nil
+}
+
+// BootstrapOrganization creates the organization and spaces of the template
+// and assigns them the quotas, roles and security groups of the template,
+// leaving the resources that already match untouched. Resources that are not
+// in the template are never removed, so running it again is safe. The
+// default isolation segment of the template is not handled, since it requires
+// the V3 API.
+//
+// The results of the resources converged before an error are returned along
+// with the error.
+func (actor Actor) BootstrapOrganization(template OrganizationTemplate) (Organization, []BootstrapResult, Warnings, error) {
+	var (
+		quota       OrganizationQuota
+		orgCreated  bool
+		allWarnings Warnings
+		results     []BootstrapResult
+	)
+
+	if template.Quota != "" {
+		var warnings Warnings
+		var err error
+		quota, warnings, err = actor.GetOrganizationQuotaByName(template.Quota)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Organization{}, nil, allWarnings, err
+		}
+	}
+
+	org, warnings, err := actor.GetOrganizationByName(template.Name)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		results = append(results, BootstrapResult{Type: BootstrapResourceOrganization, Name: org.Name, Action: BootstrapUnchanged})
+	case actionerror.OrganizationNotFoundError:
+		ccOrg, ccWarnings, createErr := actor.CloudControllerClient.CreateOrganization(template.Name, quota.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if createErr != nil {
+			return Organization{}, results, allWarnings, createErr
+		}
+		org = Organization(ccOrg)
+		orgCreated = true
+		results = append(results, BootstrapResult{Type: BootstrapResourceOrganization, Name: org.Name, Action: BootstrapCreated})
+	default:
+		return Organization{}, results, allWarnings, err
+	}
+
+	if template.Quota != "" {
+		action := assignmentAction(org.QuotaDefinitionGUID, quota.GUID)
+		if orgCreated {
+			// The quota was assigned when creating the organization.
+			action = BootstrapCreated
+		} else if action != BootstrapUnchanged {
+			ccWarnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(org.GUID, quota.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return org, results, allWarnings, err
+			}
+			org.QuotaDefinitionGUID = quota.GUID
+		}
+		results = append(results, BootstrapResult{Type: BootstrapResourceOrganizationQuota, Name: quota.Name, Action: action})
+	}
+
+	orgRoles := []struct {
+		role      constant.OrganizationRole
+		usernames []string
+	}{
+		{constant.OrgUser, template.Users},
+		{constant.OrgManager, template.Managers},
+		{constant.OrgBillingManager, template.BillingManagers},
+		{constant.OrgAuditor, template.Auditors},
+	}
+	for _, orgRole := range orgRoles {
+		roleResults, warnings, err := actor.bootstrapOrganizationRole(org.GUID, orgRole.role, orgRole.usernames)
+		allWarnings = append(allWarnings, warnings...)
+		results = append(results, roleResults...)
+		if err != nil {
+			return org, results, allWarnings, err
+		}
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return org, results, allWarnings, err
+	}
+
+	existingSpaces := map[string]Space{}
+	for _, space := range spaces {
+		existingSpaces[space.Name] = space
+	}
+
+	for _, spaceTemplate := range template.Spaces {
+		spaceResults, warnings, err := actor.bootstrapSpace(org.GUID, existingSpaces, spaceTemplate)
+		allWarnings = append(allWarnings, warnings...)
+		results = append(results, spaceResults...)
+		if err != nil {
+			return org, results, allWarnings, err
+		}
+	}
+
+	return org, results, allWarnings, nil
+}
+
+func (actor Actor) bootstrapOrganizationRole(orgGUID string, role constant.OrganizationRole, usernames []string) ([]BootstrapResult, Warnings, error) {
+	if len(usernames) == 0 {
+		return nil, nil, nil
+	}
+
+	users, allWarnings, err := actor.GetOrganizationUsersByRole(role, orgGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var results []BootstrapResult
+	for _, username := range usernames {
+		action := BootstrapUnchanged
+		if !hasUsername(users, username) {
+			warnings, err := actor.SetOrganizationRole(role, orgGUID, username)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return results, allWarnings, err
+			}
+			action = BootstrapCreated
+		}
+		results = append(results, BootstrapResult{
+			Type:   BootstrapResourceOrganizationRole,
+			Name:   fmt.Sprintf("%s (%s)", username, OrganizationRoleName(role)),
+			Action: action,
+		})
+	}
+
+	return results, allWarnings, nil
+}
+
+func (actor Actor) bootstrapSpace(orgGUID string, existingSpaces map[string]Space, template SpaceTemplate) ([]BootstrapResult, Warnings, error) {
+	var (
+		allWarnings Warnings
+		results     []BootstrapResult
+	)
+
+	space, exists := existingSpaces[template.Name]
+	if exists {
+		results = append(results, BootstrapResult{Type: BootstrapResourceSpace, Name: space.Name, Action: BootstrapUnchanged})
+	} else {
+		ccSpace, ccWarnings, err := actor.CloudControllerClient.CreateSpace(template.Name, orgGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return results, allWarnings, err
+		}
+		space = Space(ccSpace)
+		results = append(results, BootstrapResult{Type: BootstrapResourceSpace, Name: space.Name, Action: BootstrapCreated})
+	}
+
+	if template.Quota != "" {
+		quota, warnings, err := actor.GetSpaceQuotaByName(template.Quota, orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return results, allWarnings, err
+		}
+
+		action := assignmentAction(space.SpaceQuotaDefinitionGUID, quota.GUID)
+		if action != BootstrapUnchanged {
+			ccWarnings, err := actor.CloudControllerClient.SetSpaceQuota(space.GUID, quota.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return results, allWarnings, err
+			}
+		}
+		results = append(results, BootstrapResult{
+			Type:   BootstrapResourceSpaceQuota,
+			Name:   fmt.Sprintf("%s (%s)", quota.Name, space.Name),
+			Action: action,
+		})
+	}
+
+	spaceRoles := []struct {
+		role      constant.SpaceRole
+		usernames []string
+	}{
+		{constant.SpaceManager, template.Managers},
+		{constant.SpaceDeveloper, template.Developers},
+		{constant.SpaceAuditor, template.Auditors},
+	}
+	for _, spaceRole := range spaceRoles {
+		roleResults, warnings, err := actor.bootstrapSpaceRole(orgGUID, space, spaceRole.role, spaceRole.usernames)
+		allWarnings = append(allWarnings, warnings...)
+		results = append(results, roleResults...)
+		if err != nil {
+			return results, allWarnings, err
+		}
+	}
+
+	securityGroupResults, warnings, err := actor.bootstrapSecurityGroups(space, constant.SecurityGroupLifecycleRunning, template.SecurityGroups)
+	allWarnings = append(allWarnings, warnings...)
+	results = append(results, securityGroupResults...)
+	if err != nil {
+		return results, allWarnings, err
+	}
+
+	securityGroupResults, warnings, err = actor.bootstrapSecurityGroups(space, constant.SecurityGroupLifecycleStaging, template.StagingSecurityGroups)
+	allWarnings = append(allWarnings, warnings...)
+	results = append(results, securityGroupResults...)
+	return results, allWarnings, err
+}
+
+func (actor Actor) bootstrapSpaceRole(orgGUID string, space Space, role constant.SpaceRole, usernames []string) ([]BootstrapResult, Warnings, error) {
+	if len(usernames) == 0 {
+		return nil, nil, nil
+	}
+
+	users, allWarnings, err := actor.GetSpaceUsersByRole(role, space.GUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var results []BootstrapResult
+	for _, username := range usernames {
+		action := BootstrapUnchanged
+		if !hasUsername(users, username) {
+			warnings, err := actor.SetSpaceRole(role, space.GUID, orgGUID, username)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return results, allWarnings, err
+			}
+			action = BootstrapCreated
+		}
+		results = append(results, BootstrapResult{
+			Type:   BootstrapResourceSpaceRole,
+			Name:   fmt.Sprintf("%s (%s in %s)", username, SpaceRoleName(role), space.Name),
+			Action: action,
+		})
+	}
+
+	return results, allWarnings, nil
+}
+
+func (actor Actor) bootstrapSecurityGroups(space Space, lifecycle constant.SecurityGroupLifecycle, securityGroupNames []string) ([]BootstrapResult, Warnings, error) {
+	if len(securityGroupNames) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		bound       []SecurityGroup
+		allWarnings Warnings
+		err         error
+		resultType  BootstrapResourceType
+	)
+	if lifecycle == constant.SecurityGroupLifecycleStaging {
+		bound, allWarnings, err = actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
+		resultType = BootstrapResourceStagingSecurityGroup
+	} else {
+		bound, allWarnings, err = actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+		resultType = BootstrapResourceSecurityGroup
+	}
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	boundNames := map[string]bool{}
+	for _, securityGroup := range bound {
+		boundNames[securityGroup.Name] = true
+	}
+
+	var results []BootstrapResult
+	for _, securityGroupName := range securityGroupNames {
+		action := BootstrapUnchanged
+		if !boundNames[securityGroupName] {
+			securityGroup, warnings, err := actor.GetSecurityGroupByName(securityGroupName)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return results, allWarnings, err
+			}
+
+			warnings, err = actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID, lifecycle)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return results, allWarnings, err
+			}
+			action = BootstrapCreated
+		}
+		results = append(results, BootstrapResult{
+			Type:   resultType,
+			Name:   fmt.Sprintf("%s (%s)", securityGroupName, space.Name),
+			Action: action,
+		})
+	}
+
+	return results, allWarnings, nil
+}
+
+// assignmentAction returns what has to be done to change an assignment from
+// current to desired.
+func assignmentAction(current string, desired string) BootstrapAction {
+	switch current {
+	case desired:
+		return BootstrapUnchanged
+	case "":
+		return BootstrapCreated
+	default:
+		return BootstrapUpdated
+	}
+}
+
+func hasUsername(users []User, username string) bool {
+	for _, user := range users {
+		if user.Username == username {
+			return true
+		}
+	}
+	return false
+}
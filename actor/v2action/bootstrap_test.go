@@ -0,0 +1,286 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bootstrap Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ReadOrganizationTemplate", func() {
+		var (
+			tmpDir string
+			path   string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "org-template")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(tmpDir, "org.yml")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("reads the template", func() {
+			Expect(ioutil.WriteFile(path, []byte(`---
+name: some-org
+quota: some-quota
+default_isolation_segment: some-iso
+managers: [alice]
+spaces:
+- name: dev
+  quota: small
+  developers: [bob]
+  security_groups: [public_networks]
+`), 0600)).To(Succeed())
+
+			template, err := actor.ReadOrganizationTemplate(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template).To(Equal(OrganizationTemplate{
+				Name:                    "some-org",
+				Quota:                   "some-quota",
+				DefaultIsolationSegment: "some-iso",
+				Managers:                []string{"alice"},
+				Spaces: []SpaceTemplate{
+					{
+						Name:           "dev",
+						Quota:          "small",
+						Developers:     []string{"bob"},
+						SecurityGroups: []string{"public_networks"},
+					},
+				},
+			}))
+		})
+
+		DescribeTable("invalid templates",
+			func(content string, reason string) {
+				Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+
+				_, err := actor.ReadOrganizationTemplate(path)
+				Expect(err).To(MatchError(actionerror.InvalidOrganizationTemplateError{Path: path, Reason: reason}))
+			},
+
+			Entry("no org name", "quota: some-quota\n", "the org must have a name"),
+			Entry("no space name", "name: some-org\nspaces:\n- quota: small\n", "every space must have a name"),
+			Entry("duplicate space", "name: some-org\nspaces:\n- name: dev\n- name: dev\n", "space dev is listed more than once"),
+		)
+
+		It("rejects unknown keys", func() {
+			Expect(ioutil.WriteFile(path, []byte("name: some-org\nmanagerz: [alice]\n"), 0600)).To(Succeed())
+
+			_, err := actor.ReadOrganizationTemplate(path)
+			Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidOrganizationTemplateError{}))
+		})
+	})
+
+	Describe("BootstrapOrganization", func() {
+		var (
+			template   OrganizationTemplate
+			org        Organization
+			results    []BootstrapResult
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			template = OrganizationTemplate{
+				Name:     "some-org",
+				Quota:    "some-quota",
+				Managers: []string{"alice", "carol"},
+				Spaces: []SpaceTemplate{
+					{
+						Name:                  "dev",
+						Quota:                 "small",
+						Developers:            []string{"bob"},
+						SecurityGroups:        []string{"public_networks"},
+						StagingSecurityGroups: []string{"dns"},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv2.Warnings{"quota-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]ccv2.SpaceQuota{{GUID: "big-guid", Name: "big"}, {GUID: "small-guid", Name: "small"}},
+				ccv2.Warnings{"space-quota-warning"}, nil)
+			fakeCloudControllerClient.GetSecurityGroupsStub = func(filters ...ccv2.Filter) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+				name := filters[0].Values[0]
+				return []ccv2.SecurityGroup{{GUID: name + "-guid", Name: name}}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			org, results, warnings, executeErr = actor.BootstrapOrganization(template)
+		})
+
+		Context("when nothing exists yet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+				fakeCloudControllerClient.CreateOrganizationReturns(
+					ccv2.Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"},
+					ccv2.Warnings{"create-org-warning"}, nil)
+				fakeCloudControllerClient.CreateSpaceReturns(
+					ccv2.Space{GUID: "dev-guid", Name: "dev"},
+					ccv2.Warnings{"create-space-warning"}, nil)
+			})
+
+			It("creates everything and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}))
+				Expect(warnings).To(ConsistOf("quota-warning", "get-org-warning", "create-org-warning", "create-space-warning", "space-quota-warning"))
+
+				Expect(results).To(Equal([]BootstrapResult{
+					{Type: BootstrapResourceOrganization, Name: "some-org", Action: BootstrapCreated},
+					{Type: BootstrapResourceOrganizationQuota, Name: "some-quota", Action: BootstrapCreated},
+					{Type: BootstrapResourceOrganizationRole, Name: "alice (OrgManager)", Action: BootstrapCreated},
+					{Type: BootstrapResourceOrganizationRole, Name: "carol (OrgManager)", Action: BootstrapCreated},
+					{Type: BootstrapResourceSpace, Name: "dev", Action: BootstrapCreated},
+					{Type: BootstrapResourceSpaceQuota, Name: "small (dev)", Action: BootstrapCreated},
+					{Type: BootstrapResourceSpaceRole, Name: "bob (SpaceDeveloper in dev)", Action: BootstrapCreated},
+					{Type: BootstrapResourceSecurityGroup, Name: "public_networks (dev)", Action: BootstrapCreated},
+					{Type: BootstrapResourceStagingSecurityGroup, Name: "dns (dev)", Action: BootstrapCreated},
+				}))
+
+				orgName, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+				Expect(orgName).To(Equal("some-org"))
+				Expect(quotaGUID).To(Equal("some-quota-guid"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(0))
+
+				spaceName, orgGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+				Expect(spaceName).To(Equal("dev"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				spaceGUID, spaceQuotaGUID := fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+				Expect(spaceGUID).To(Equal("dev-guid"))
+				Expect(spaceQuotaGUID).To(Equal("small-guid"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(3))
+				role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.OrgManager))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("alice"))
+				role, _, username = fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(2)
+				Expect(role).To(Equal(constant.OrgUser))
+				Expect(username).To(Equal("bob"))
+
+				spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+				Expect(spaceRole).To(Equal(constant.SpaceDeveloper))
+				Expect(spaceGUID).To(Equal("dev-guid"))
+				Expect(username).To(Equal("bob"))
+
+				securityGroupGUID, spaceGUID := fakeCloudControllerClient.UpdateSecurityGroupSpaceArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("public_networks-guid"))
+				Expect(spaceGUID).To(Equal("dev-guid"))
+				securityGroupGUID, _ = fakeCloudControllerClient.UpdateSecurityGroupStagingSpaceArgsForCall(0)
+				Expect(securityGroupGUID).To(Equal("dns-guid"))
+			})
+		})
+
+		Context("when the foundation partially matches the template", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "default-quota-guid"}},
+					nil, nil)
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(
+					[]ccv2.User{{GUID: "alice-guid", Username: "alice"}}, nil, nil)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "dev-guid", Name: "dev", SpaceQuotaDefinitionGUID: "small-guid"}},
+					nil, nil)
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(
+					[]ccv2.User{{GUID: "bob-guid", Username: "bob"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{{GUID: "public_networks-guid", Name: "public_networks"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceStagingSecurityGroupsReturns(nil, nil, nil)
+			})
+
+			It("only changes what differs from the template", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(results).To(Equal([]BootstrapResult{
+					{Type: BootstrapResourceOrganization, Name: "some-org", Action: BootstrapUnchanged},
+					{Type: BootstrapResourceOrganizationQuota, Name: "some-quota", Action: BootstrapUpdated},
+					{Type: BootstrapResourceOrganizationRole, Name: "alice (OrgManager)", Action: BootstrapUnchanged},
+					{Type: BootstrapResourceOrganizationRole, Name: "carol (OrgManager)", Action: BootstrapCreated},
+					{Type: BootstrapResourceSpace, Name: "dev", Action: BootstrapUnchanged},
+					{Type: BootstrapResourceSpaceQuota, Name: "small (dev)", Action: BootstrapUnchanged},
+					{Type: BootstrapResourceSpaceRole, Name: "bob (SpaceDeveloper in dev)", Action: BootstrapUnchanged},
+					{Type: BootstrapResourceSecurityGroup, Name: "public_networks (dev)", Action: BootstrapUnchanged},
+					{Type: BootstrapResourceStagingSecurityGroup, Name: "dns (dev)", Action: BootstrapCreated},
+				}))
+
+				Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(0))
+				orgGUID, quotaGUID := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(quotaGUID).To(Equal("some-quota-guid"))
+
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.SetSpaceQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupSpaceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupStagingSpaceCallCount()).To(Equal(1))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(1))
+				_, _, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+				Expect(username).To(Equal("carol"))
+			})
+		})
+
+		Context("when the org quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"quota-warning"}, nil)
+			})
+
+			It("returns an OrganizationQuotaNotFoundError before changing anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationQuotaNotFoundError{Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("quota-warning"))
+				Expect(results).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when converging a space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create-space-failed")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}},
+					nil, nil)
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(
+					[]ccv2.User{{Username: "alice"}, {Username: "carol"}}, nil, nil)
+				fakeCloudControllerClient.CreateSpaceReturns(ccv2.Space{}, ccv2.Warnings{"create-space-warning"}, expectedErr)
+			})
+
+			It("returns the error along with the results so far", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("create-space-warning"))
+				Expect(results).To(HaveLen(4))
+				Expect(results[1]).To(Equal(BootstrapResult{Type: BootstrapResourceOrganizationQuota, Name: "some-quota", Action: BootstrapUnchanged}))
+			})
+		})
+	})
+})
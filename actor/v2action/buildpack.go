@@ -0,0 +1,37 @@
+package v2action
+
+import (
+	"regexp"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+var buildpackFilenameVersion = regexp.MustCompile(`(?:^|[-_])v?(\d+(?:\.\d+)+)(?:\.zip)?$`)
+
+type Buildpack ccv2.Buildpack
+
+// Version returns the version in the buildpack's filename, such as 1.7.18
+// for ruby_buildpack-cached-cflinuxfs2-v1.7.18.zip. It returns an empty
+// string when the filename does not contain a version.
+func (buildpack Buildpack) Version() string {
+	matches := buildpackFilenameVersion.FindStringSubmatch(buildpack.Filename)
+	if matches == nil {
+		return ""
+	}
+	return matches[1]
+}
+
+// GetBuildpacks returns all of the admin buildpacks.
+func (actor Actor) GetBuildpacks() ([]Buildpack, Warnings, error) {
+	ccBuildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var buildpacks []Buildpack
+	for _, buildpack := range ccBuildpacks {
+		buildpacks = append(buildpacks, Buildpack(buildpack))
+	}
+
+	return buildpacks, Warnings(warnings), nil
+}
@@ -0,0 +1,68 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	DescribeTable("Buildpack.Version",
+		func(filename string, expectedVersion string) {
+			Expect(Buildpack{Filename: filename}.Version()).To(Equal(expectedVersion))
+		},
+		Entry("cached buildpack with a stack", "ruby_buildpack-cached-cflinuxfs2-v1.7.18.zip", "1.7.18"),
+		Entry("buildpack without a v prefix", "go_buildpack-1.8.20.zip", "1.8.20"),
+		Entry("buildpack without an extension", "java-buildpack-v4.9", "4.9"),
+		Entry("buildpack without a version", "custom_buildpack.zip", ""),
+		Entry("no filename", "", ""),
+	)
+
+	Describe("GetBuildpacks", func() {
+		Context("when getting the buildpacks succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{
+					{Name: "ruby_buildpack", Filename: "ruby_buildpack-v1.7.18.zip"},
+					{Name: "go_buildpack", Filename: "go_buildpack-v1.8.20.zip"},
+				}, ccv2.Warnings{"buildpacks-warning"}, nil)
+			})
+
+			It("returns the buildpacks and all warnings", func() {
+				buildpacks, warnings, err := actor.GetBuildpacks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("buildpacks-warning"))
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{Name: "ruby_buildpack", Filename: "ruby_buildpack-v1.7.18.zip"},
+					{Name: "go_buildpack", Filename: "go_buildpack-v1.8.20.zip"},
+				}))
+			})
+		})
+
+		Context("when getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"buildpacks-warning"}, errors.New("buildpacks-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetBuildpacks()
+				Expect(err).To(MatchError("buildpacks-error"))
+				Expect(warnings).To(ConsistOf("buildpacks-warning"))
+			})
+		})
+	})
+})
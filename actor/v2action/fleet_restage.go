@@ -0,0 +1,183 @@
+package v2action
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// FleetRestageCriteria selects the applications that are restaged after a
+// buildpack or stack update.
+type FleetRestageCriteria struct {
+	// Buildpack is the name of the buildpack the applications were staged
+	// with.
+	Buildpack string `json:"buildpack,omitempty"`
+	// Stack is the name of the stack the applications run on.
+	Stack string `json:"stack,omitempty"`
+	// OrganizationGUID limits the applications to a single organization.
+	OrganizationGUID string `json:"organization_guid,omitempty"`
+}
+
+// FleetRestageProgress records the applications that have been restaged so
+// that an interrupted fleet restage can be resumed.
+type FleetRestageProgress struct {
+	Criteria         FleetRestageCriteria `json:"criteria"`
+	RestagedAppGUIDs []string             `json:"restaged_app_guids"`
+}
+
+// GetApplicationsForFleetRestage returns the started applications that were
+// staged with the criteria's buildpack, either as their buildpack or as their
+// detected buildpack, or that run on the criteria's stack.
+func (actor Actor) GetApplicationsForFleetRestage(criteria FleetRestageCriteria) ([]Application, Warnings, error) {
+	var (
+		allWarnings Warnings
+		filters     []ccv2.Filter
+	)
+
+	if criteria.OrganizationGUID != "" {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.OrganizationGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{criteria.OrganizationGUID},
+		})
+	}
+
+	if criteria.Stack != "" {
+		stack, warnings, err := actor.GetStackByName(criteria.Stack)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.StackGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{stack.GUID},
+		})
+	}
+
+	buildpackGUIDs := map[string]bool{}
+	if criteria.Buildpack != "" {
+		buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(ccv2.Filter{
+			Type:     constant.NameFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{criteria.Buildpack},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, buildpack := range buildpacks {
+			buildpackGUIDs[buildpack.GUID] = true
+		}
+	}
+
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(filters...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var apps []Application
+	for _, ccApp := range ccApps {
+		app := Application(ccApp)
+		if !app.Started() {
+			continue
+		}
+
+		if criteria.Buildpack != "" &&
+			app.Buildpack.Value != criteria.Buildpack &&
+			app.DetectedBuildpack.Value != criteria.Buildpack &&
+			!buildpackGUIDs[app.DetectedBuildpackGUID] {
+			continue
+		}
+
+		apps = append(apps, app)
+	}
+
+	return apps, allWarnings, nil
+}
+
+// LoadFleetRestageProgress reads the fleet restage progress from the given
+// file. Progress for different criteria, or a missing file, is treated as no
+// progress.
+func LoadFleetRestageProgress(path string, criteria FleetRestageCriteria) (FleetRestageProgress, error) {
+	progress := FleetRestageProgress{Criteria: criteria}
+
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return progress, nil
+	}
+	if err != nil {
+		return FleetRestageProgress{}, err
+	}
+
+	var saved FleetRestageProgress
+	err = json.Unmarshal(raw, &saved)
+	if err != nil {
+		return FleetRestageProgress{}, err
+	}
+
+	if saved.Criteria != criteria {
+		return progress, nil
+	}
+
+	return saved, nil
+}
+
+// Restaged returns true if the application has already been restaged.
+func (progress FleetRestageProgress) Restaged(appGUID string) bool {
+	for _, guid := range progress.RestagedAppGUIDs {
+		if guid == appGUID {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkRestaged records that the application has been restaged.
+func (progress *FleetRestageProgress) MarkRestaged(appGUID string) {
+	if !progress.Restaged(appGUID) {
+		progress.RestagedAppGUIDs = append(progress.RestagedAppGUIDs, appGUID)
+	}
+}
+
+// Save writes the progress to the given file. The file is replaced
+// atomically so an interruption never leaves it half written.
+func (progress FleetRestageProgress) Save(path string) error {
+	raw, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
+
+// DeleteFleetRestageProgress removes the progress file once the fleet restage
+// has completed. A missing file is not an error.
+func DeleteFleetRestageProgress(path string) error {
+	err := os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
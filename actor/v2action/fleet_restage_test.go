@@ -0,0 +1,205 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fleet Restage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetApplicationsForFleetRestage", func() {
+		var (
+			criteria   FleetRestageCriteria
+			apps       []Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{
+				{Name: "set-buildpack", GUID: "app-1", State: constant.ApplicationStarted, Buildpack: types.FilteredString{IsSet: true, Value: "ruby_buildpack"}},
+				{Name: "detected-guid", GUID: "app-2", State: constant.ApplicationStarted, DetectedBuildpack: types.FilteredString{IsSet: true, Value: "ruby"}, DetectedBuildpackGUID: "ruby-guid"},
+				{Name: "other-buildpack", GUID: "app-3", State: constant.ApplicationStarted, DetectedBuildpackGUID: "go-guid"},
+				{Name: "stopped", GUID: "app-4", State: constant.ApplicationStopped, DetectedBuildpackGUID: "ruby-guid"},
+			}, ccv2.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{{GUID: "ruby-guid", Name: "ruby_buildpack"}}, ccv2.Warnings{"get-buildpacks-warning"}, nil)
+			fakeCloudControllerClient.GetStacksReturns([]ccv2.Stack{{GUID: "some-stack-guid", Name: "cflinuxfs2"}}, ccv2.Warnings{"get-stacks-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetApplicationsForFleetRestage(criteria)
+		})
+
+		Context("when selecting apps by buildpack", func() {
+			BeforeEach(func() {
+				criteria = FleetRestageCriteria{Buildpack: "ruby_buildpack", OrganizationGUID: "some-org-guid"}
+			})
+
+			It("returns the started apps staged with the buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning", "get-apps-warning"))
+
+				var names []string
+				for _, app := range apps {
+					names = append(names, app.Name)
+				}
+				Expect(names).To(Equal([]string{"set-buildpack", "detected-guid"}))
+
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.NameFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"ruby_buildpack"},
+				}))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.OrganizationGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-org-guid"},
+				}))
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when selecting apps by stack", func() {
+			BeforeEach(func() {
+				criteria = FleetRestageCriteria{Stack: "cflinuxfs2"}
+			})
+
+			It("returns the started apps on the stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning", "get-apps-warning"))
+				Expect(apps).To(HaveLen(3))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.StackGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-stack-guid"},
+				}))
+				Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(0))
+			})
+
+			Context("when the stack does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, nil)
+				})
+
+				It("returns a StackNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.StackNotFoundError{Name: "cflinuxfs2"}))
+					Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				})
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				criteria = FleetRestageCriteria{Stack: "cflinuxfs2"}
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("apps-error"))
+				Expect(warnings).To(ConsistOf("get-stacks-warning", "get-apps-warning"))
+			})
+		})
+	})
+
+	Describe("FleetRestageProgress", func() {
+		var (
+			tempDir      string
+			progressPath string
+			criteria     FleetRestageCriteria
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "fleet-restage-progress")
+			Expect(err).ToNot(HaveOccurred())
+			progressPath = filepath.Join(tempDir, "progress.json")
+			criteria = FleetRestageCriteria{Buildpack: "ruby_buildpack"}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		Context("when the progress file does not exist", func() {
+			It("returns empty progress for the criteria", func() {
+				progress, err := LoadFleetRestageProgress(progressPath, criteria)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(progress).To(Equal(FleetRestageProgress{Criteria: criteria}))
+			})
+		})
+
+		Context("when the progress has been saved", func() {
+			BeforeEach(func() {
+				progress := FleetRestageProgress{Criteria: criteria}
+				progress.MarkRestaged("app-1")
+				progress.MarkRestaged("app-2")
+				progress.MarkRestaged("app-1")
+				Expect(progress.Save(progressPath)).To(Succeed())
+			})
+
+			It("loads the restaged apps", func() {
+				progress, err := LoadFleetRestageProgress(progressPath, criteria)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(progress.RestagedAppGUIDs).To(Equal([]string{"app-1", "app-2"}))
+				Expect(progress.Restaged("app-2")).To(BeTrue())
+				Expect(progress.Restaged("app-3")).To(BeFalse())
+
+				files, err := ioutil.ReadDir(tempDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+			})
+
+			It("ignores progress saved for different criteria", func() {
+				otherCriteria := FleetRestageCriteria{Stack: "cflinuxfs2"}
+				progress, err := LoadFleetRestageProgress(progressPath, otherCriteria)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(progress).To(Equal(FleetRestageProgress{Criteria: otherCriteria}))
+			})
+		})
+
+		Describe("DeleteFleetRestageProgress", func() {
+			It("removes the progress file", func() {
+				Expect(FleetRestageProgress{Criteria: criteria}.Save(progressPath)).To(Succeed())
+				Expect(DeleteFleetRestageProgress(progressPath)).To(Succeed())
+				_, err := os.Stat(progressPath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			It("does not fail when the progress file does not exist", func() {
+				Expect(DeleteFleetRestageProgress(progressPath)).To(Succeed())
+			})
+		})
+
+		Context("when the progress file is invalid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(progressPath, []byte("not json"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := LoadFleetRestageProgress(progressPath, criteria)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
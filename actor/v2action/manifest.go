@@ -1,10 +1,37 @@
 package v2action
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+var (
+	// secretEnvironmentVariableNameRegexp matches the names of environment
+	// variables that usually hold secrets.
+	secretEnvironmentVariableNameRegexp = regexp.MustCompile(`(?i)(passw|secret|token|credential|private|key|auth|salt)`)
+
+	// credentialsInURIRegexp matches URIs that contain a password, such as
+	// database connection strings.
+	credentialsInURIRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.\-]*://[^/:@\s]+:[^/@\s]+@`)
+
+	// invalidVariableNameCharsRegexp matches the characters that cannot be
+	// used in the name of a manifest ((variable)).
+	invalidVariableNameCharsRegexp = regexp.MustCompile(`[^-\w.]`)
 )
 
+// SpaceManifest is a manifest of all the applications in a space. Vars are
+// the values of the environment variables that look like secrets, which are
+// replaced by ((variables)) in the applications.
+type SpaceManifest struct {
+	Applications []manifest.Application
+	Vars         map[string]string
+}
+
 func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, pathToFile string) (Warnings, error) {
 
 	var allWarnings Warnings
@@ -14,14 +41,84 @@ func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, space
 		return allWarnings, err
 	}
 
-	serviceInstances, serviceWarnings, err := actor.GetServiceInstancesByApplication(applicationSummary.GUID)
-	allWarnings = append(allWarnings, serviceWarnings...)
+	manifestApp, warnings, err := actor.getManifestApplication(applicationSummary.Application, applicationSummary.Routes, applicationSummary.Stack.Name)
+	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return allWarnings, err
 	}
 
+	err = manifest.WriteApplicationManifest(manifestApp, pathToFile)
+	return allWarnings, err
+}
+
+// CreateSpaceManifest writes a manifest of all the applications in the space
+// to pathToManifest, including the processes found in processesByApp for
+// each application GUID. Environment variables that look like secrets are
+// replaced by ((variables)) whose values are written to pathToVarsFile, which
+// is only created when there are such variables.
+func (actor Actor) CreateSpaceManifest(spaceGUID string, processesByApp map[string][]manifestparser.Process, pathToManifest string, pathToVarsFile string) (SpaceManifest, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return SpaceManifest{}, allWarnings, err
+	}
+
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+
+	spaceManifest := SpaceManifest{Vars: map[string]string{}}
+	stackNames := map[string]string{}
+	for _, app := range apps {
+		routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceManifest{}, allWarnings, err
+		}
+
+		stackName, cached := stackNames[app.StackGUID]
+		if !cached {
+			stack, warnings, err := actor.GetStack(app.StackGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return SpaceManifest{}, allWarnings, err
+			}
+			stackName = stack.Name
+			stackNames[app.StackGUID] = stackName
+		}
+
+		manifestApp, warnings, err := actor.getManifestApplication(app, routes, stackName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceManifest{}, allWarnings, err
+		}
+
+		manifestApp.EnvironmentVariables = replaceSecrets(manifestApp.Name, manifestApp.EnvironmentVariables, spaceManifest.Vars)
+		manifestApp.Processes = processesByApp[app.GUID]
+		spaceManifest.Applications = append(spaceManifest.Applications, manifestApp)
+	}
+
+	err = manifest.WriteApplicationsManifest(spaceManifest.Applications, pathToManifest)
+	if err != nil {
+		return SpaceManifest{}, allWarnings, err
+	}
+
+	if len(spaceManifest.Vars) > 0 {
+		err = manifest.WriteVarsFile(spaceManifest.Vars, pathToVarsFile)
+		if err != nil {
+			return SpaceManifest{}, allWarnings, err
+		}
+	}
+
+	return spaceManifest, allWarnings, nil
+}
+
+// getManifestApplication returns the manifest settings of the application.
+func (actor Actor) getManifestApplication(app Application, appRoutes []Route, stackName string) (manifest.Application, Warnings, error) {
+	serviceInstances, warnings, err := actor.GetServiceInstancesByApplication(app.GUID)
+	if err != nil {
+		return manifest.Application{}, warnings, err
+	}
+
 	var routes []string
-	for _, route := range applicationSummary.Routes {
+	for _, route := range appRoutes {
 		routes = append(routes, route.String())
 	}
 
@@ -31,33 +128,59 @@ func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, space
 	}
 
 	manifestApp := manifest.Application{
-		Buildpack:            applicationSummary.Buildpack,
-		Command:              applicationSummary.Command,
-		DiskQuota:            applicationSummary.DiskQuota,
-		DockerImage:          applicationSummary.DockerImage,
-		DockerUsername:       applicationSummary.DockerCredentials.Username,
-		EnvironmentVariables: applicationSummary.EnvironmentVariables,
-		HealthCheckTimeout:   applicationSummary.HealthCheckTimeout,
-		Instances:            applicationSummary.Instances,
-		Memory:               applicationSummary.Memory,
-		Name:                 applicationSummary.Name,
+		Buildpack:            app.Buildpack,
+		Command:              app.Command,
+		DiskQuota:            app.DiskQuota,
+		DockerImage:          app.DockerImage,
+		DockerUsername:       app.DockerCredentials.Username,
+		EnvironmentVariables: app.EnvironmentVariables,
+		HealthCheckTimeout:   app.HealthCheckTimeout,
+		Instances:            app.Instances,
+		Memory:               app.Memory,
+		Name:                 app.Name,
 		Routes:               routes,
 		Services:             services,
-		StackName:            applicationSummary.Stack.Name,
+		StackName:            stackName,
 	}
 	if len(routes) < 1 {
 		manifestApp.NoRoute = true
 	}
 
-	if applicationSummary.HealthCheckType != constant.ApplicationHealthCheckPort {
-		manifestApp.HealthCheckType = string(applicationSummary.HealthCheckType)
+	if app.HealthCheckType != constant.ApplicationHealthCheckPort {
+		manifestApp.HealthCheckType = string(app.HealthCheckType)
 
-		if applicationSummary.HealthCheckType == constant.ApplicationHealthCheckHTTP &&
-			applicationSummary.HealthCheckHTTPEndpoint != "/" {
-			manifestApp.HealthCheckHTTPEndpoint = applicationSummary.HealthCheckHTTPEndpoint
+		if app.HealthCheckType == constant.ApplicationHealthCheckHTTP &&
+			app.HealthCheckHTTPEndpoint != "/" {
+			manifestApp.HealthCheckHTTPEndpoint = app.HealthCheckHTTPEndpoint
 		}
 	}
 
-	err = manifest.WriteApplicationManifest(manifestApp, pathToFile)
-	return allWarnings, err
+	return manifestApp, warnings, nil
+}
+
+// replaceSecrets returns the environment variables of the application with
+// the values that look like secrets replaced by ((variables)), and adds the
+// replaced values to vars.
+func replaceSecrets(appName string, envVars map[string]string, vars map[string]string) map[string]string {
+	if len(envVars) == 0 {
+		return envVars
+	}
+
+	replaced := map[string]string{}
+	for name, value := range envVars {
+		if !looksLikeSecret(name, value) {
+			replaced[name] = value
+			continue
+		}
+
+		varName := invalidVariableNameCharsRegexp.ReplaceAllString(fmt.Sprintf("%s_%s", appName, name), "_")
+		vars[varName] = value
+		replaced[name] = fmt.Sprintf("((%s))", varName)
+	}
+	return replaced
+}
+
+func looksLikeSecret(name string, value string) bool {
+	return value != "" &&
+		(secretEnvironmentVariableNameRegexp.MatchString(name) || credentialsInURIRegexp.MatchString(value))
 }
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type OrganizationQuota ccv2.OrganizationQuota
@@ -17,3 +18,22 @@ func (actor Actor) GetOrganizationQuota(guid string) (OrganizationQuota, Warning
 
 	return OrganizationQuota(orgQuota), Warnings(warnings), err
 }
+
+// GetOrganizationQuotaByName returns the organization quota with the provided
+// name.
+func (actor Actor) GetOrganizationQuotaByName(name string) (OrganizationQuota, Warnings, error) {
+	orgQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas(ccv2.Filter{
+		Type:     constant.NameFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{name},
+	})
+	if err != nil {
+		return OrganizationQuota{}, Warnings(warnings), err
+	}
+
+	if len(orgQuotas) == 0 {
+		return OrganizationQuota{}, Warnings(warnings), actionerror.OrganizationQuotaNotFoundError{Name: name}
+	}
+
+	return OrganizationQuota(orgQuotas[0]), Warnings(warnings), nil
+}
@@ -0,0 +1,122 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+
+var (
+	allOrganizationRoles = []constant.OrganizationRole{constant.OrgUser, constant.OrgManager, constant.OrgBillingManager, constant.OrgAuditor}
+	allSpaceRoles        = []constant.SpaceRole{constant.SpaceManager, constant.SpaceDeveloper, constant.SpaceAuditor}
+)
+
+// OrganizationRoleName returns the name of an organization role as displayed
+// to users.
+func OrganizationRoleName(role constant.OrganizationRole) string {
+	switch role {
+	case constant.OrgUser:
+		return "OrgUser"
+	case constant.OrgManager:
+		return "OrgManager"
+	case constant.OrgBillingManager:
+		return "BillingManager"
+	case constant.OrgAuditor:
+		return "OrgAuditor"
+	}
+	return string(role)
+}
+
+// SpaceRoleName returns the name of a space role as displayed to users.
+func SpaceRoleName(role constant.SpaceRole) string {
+	switch role {
+	case constant.SpaceManager:
+		return "SpaceManager"
+	case constant.SpaceDeveloper:
+		return "SpaceDeveloper"
+	case constant.SpaceAuditor:
+		return "SpaceAuditor"
+	}
+	return string(role)
+}
+
+// organizationRoleByName returns the organization role with the provided
+// display name.
+func organizationRoleByName(name string) (constant.OrganizationRole, bool) {
+	for _, role := range allOrganizationRoles {
+		if OrganizationRoleName(role) == name {
+			return role, true
+		}
+	}
+	return "", false
+}
+
+// spaceRoleByName returns the space role with the provided display name.
+func spaceRoleByName(name string) (constant.SpaceRole, bool) {
+	for _, role := range allSpaceRoles {
+		if SpaceRoleName(role) == name {
+			return role, true
+		}
+	}
+	return "", false
+}
+
+// GetOrganizationUsersByRole returns the users that have the provided role in
+// the organization.
+func (actor Actor) GetOrganizationUsersByRole(role constant.OrganizationRole, orgGUID string) ([]User, Warnings, error) {
+	ccUsers, warnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role, orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var users []User
+	for _, ccUser := range ccUsers {
+		users = append(users, User(ccUser))
+	}
+	return users, Warnings(warnings), nil
+}
+
+// GetSpaceUsersByRole returns the users that have the provided role in the
+// space.
+func (actor Actor) GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	ccUsers, warnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role, spaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var users []User
+	for _, ccUser := range ccUsers {
+		users = append(users, User(ccUser))
+	}
+	return users, Warnings(warnings), nil
+}
+
+// SetOrganizationRole gives the provided role in the organization to the user
+// with the provided username.
+func (actor Actor) SetOrganizationRole(role constant.OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(role, orgGUID, username)
+	return Warnings(warnings), err
+}
+
+// SetSpaceRole gives the provided role in the space to the user with the
+// provided username. The user is first made a user of the space's
+// organization, which is required to have a role in one of its spaces.
+func (actor Actor) SetSpaceRole(role constant.SpaceRole, spaceGUID string, orgGUID string, username string) (Warnings, error) {
+	allWarnings, err := actor.SetOrganizationRole(constant.OrgUser, orgGUID, username)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateSpaceUserByRole(role, spaceGUID, username)
+	return append(allWarnings, warnings...), err
+}
+
+// RemoveOrganizationRole removes the provided role in the organization from
+// the user with the provided username.
+func (actor Actor) RemoveOrganizationRole(role constant.OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteOrganizationUserByRole(role, orgGUID, username)
+	return Warnings(warnings), err
+}
+
+// RemoveSpaceRole removes the provided role in the space from the user with
+// the provided username.
+func (actor Actor) RemoveSpaceRole(role constant.SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteSpaceUserByRole(role, spaceGUID, username)
+	return Warnings(warnings), err
+}
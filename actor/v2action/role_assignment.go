@@ -0,0 +1,451 @@
+package v2action
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// RoleAssignment is a role held by a user in an organization, or in one of
+// its spaces when SpaceName is set. Role is the display name of the role,
+// e.g. OrgManager or SpaceDeveloper.
+type RoleAssignment struct {
+	Username         string `yaml:"user"`
+	OrganizationName string `yaml:"org"`
+	SpaceName        string `yaml:"space,omitempty"`
+	Role             string `yaml:"role"`
+}
+
+// RolesFile is a list of role assignments to grant and to revoke.
+type RolesFile struct {
+	Grant  []RoleAssignment `yaml:"grant"`
+	Revoke []RoleAssignment `yaml:"revoke"`
+}
+
+// RoleChangeAction is what has to be done to a role assignment.
+type RoleChangeAction string
+
+const (
+	RoleGrant  RoleChangeAction = "grant"
+	RoleRevoke RoleChangeAction = "revoke"
+)
+
+// RoleChange is a role assignment that differs from what is currently
+// assigned.
+type RoleChange struct {
+	RoleAssignment
+	Action           RoleChangeAction
+	OrganizationGUID string
+	SpaceGUID        string
+}
+
+// GetUserRoleAssignments returns every role the user with the provided
+// username holds in the organizations and spaces visible to the current user.
+// The user is looked up in the users of each organization until found, after
+// which its roles are fetched from the user's own relationships.
+func (actor Actor) GetUserRoleAssignments(username string) ([]RoleAssignment, Warnings, error) {
+	orgs, allWarnings, err := actor.GetOrganizations()
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	userGUID, warnings, err := actor.findOrganizationUserGUID(orgs, username)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil || userGUID == "" {
+		return nil, allWarnings, err
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range orgs {
+		orgNames[org.GUID] = org.Name
+	}
+
+	orgAssignments := map[string][]RoleAssignment{}
+	for _, role := range allOrganizationRoles {
+		ccOrgs, ccWarnings, err := actor.CloudControllerClient.GetUserOrganizationsByRole(role, userGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, org := range ccOrgs {
+			orgAssignments[org.GUID] = append(orgAssignments[org.GUID], RoleAssignment{
+				Username:         username,
+				OrganizationName: org.Name,
+				Role:             OrganizationRoleName(role),
+			})
+		}
+	}
+
+	spaceAssignments := map[string][]RoleAssignment{}
+	for _, role := range allSpaceRoles {
+		ccSpaces, ccWarnings, err := actor.CloudControllerClient.GetUserSpacesByRole(role, userGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, space := range ccSpaces {
+			orgName, visible := orgNames[space.OrganizationGUID]
+			if !visible {
+				continue
+			}
+			spaceAssignments[space.OrganizationGUID] = append(spaceAssignments[space.OrganizationGUID], RoleAssignment{
+				Username:         username,
+				OrganizationName: orgName,
+				SpaceName:        space.Name,
+				Role:             SpaceRoleName(role),
+			})
+		}
+	}
+
+	var assignments []RoleAssignment
+	for _, org := range orgs {
+		assignments = append(assignments, orgAssignments[org.GUID]...)
+
+		orgSpaceAssignments := spaceAssignments[org.GUID]
+		sort.SliceStable(orgSpaceAssignments, func(i int, j int) bool {
+			return orgSpaceAssignments[i].SpaceName < orgSpaceAssignments[j].SpaceName
+		})
+		assignments = append(assignments, orgSpaceAssignments...)
+	}
+
+	return assignments, allWarnings, nil
+}
+
+// findOrganizationUserGUID returns the GUID of the user with the provided
+// username, or an empty GUID if it is not a user of any of the provided
+// organizations.
+func (actor Actor) findOrganizationUserGUID(orgs []Organization, username string) (string, Warnings, error) {
+	var allWarnings Warnings
+	for _, org := range orgs {
+		users, warnings, err := actor.GetOrganizationUsersByRole(constant.OrgUser, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+
+		for _, user := range users {
+			if user.Username == username {
+				return user.GUID, allWarnings, nil
+			}
+		}
+	}
+
+	return "", allWarnings, nil
+}
+
+// GetOrganizationRoleAssignments returns every role held by users in the
+// organization and its spaces.
+func (actor Actor) GetOrganizationRoleAssignments(orgName string) ([]RoleAssignment, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	assignments, warnings, err := actor.getOrganizationRoleAssignments(org, "")
+	allWarnings = append(allWarnings, warnings...)
+	return assignments, allWarnings, err
+}
+
+// getOrganizationRoleAssignments returns the roles held in the organization
+// and its spaces, limited to the user with the provided username unless it is
+// empty.
+func (actor Actor) getOrganizationRoleAssignments(org Organization, username string) ([]RoleAssignment, Warnings, error) {
+	var (
+		allWarnings Warnings
+		assignments []RoleAssignment
+		isOrgUser   bool
+	)
+
+	for _, role := range allOrganizationRoles {
+		users, warnings, err := actor.GetOrganizationUsersByRole(role, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, user := range users {
+			if username != "" && user.Username != username {
+				continue
+			}
+			if role == constant.OrgUser {
+				isOrgUser = true
+			}
+			assignments = append(assignments, RoleAssignment{
+				Username:         displayUsername(user),
+				OrganizationName: org.Name,
+				Role:             OrganizationRoleName(role),
+			})
+		}
+	}
+
+	// Only users of an organization can have roles in its spaces.
+	if username != "" && !isOrgUser {
+		return assignments, allWarnings, nil
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		for _, role := range allSpaceRoles {
+			users, warnings, err := actor.GetSpaceUsersByRole(role, space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			for _, user := range users {
+				if username != "" && user.Username != username {
+					continue
+				}
+				assignments = append(assignments, RoleAssignment{
+					Username:         displayUsername(user),
+					OrganizationName: org.Name,
+					SpaceName:        space.Name,
+					Role:             SpaceRoleName(role),
+				})
+			}
+		}
+	}
+
+	return assignments, allWarnings, nil
+}
+
+// ReadRolesFile reads the role assignments to grant and revoke from the YAML
+// file at the provided path.
+func (Actor) ReadRolesFile(path string) (RolesFile, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return RolesFile{}, err
+	}
+
+	var rolesFile RolesFile
+	err = yaml.UnmarshalStrict(raw, &rolesFile)
+	if err != nil {
+		return RolesFile{}, actionerror.InvalidRolesFileError{Path: path, Reason: err.Error()}
+	}
+
+	granted := map[RoleAssignment]bool{}
+	for _, assignment := range rolesFile.Grant {
+		if reason := validateRoleAssignment(assignment); reason != "" {
+			return RolesFile{}, actionerror.InvalidRolesFileError{Path: path, Reason: reason}
+		}
+		granted[assignment] = true
+	}
+
+	for _, assignment := range rolesFile.Revoke {
+		if reason := validateRoleAssignment(assignment); reason != "" {
+			return RolesFile{}, actionerror.InvalidRolesFileError{Path: path, Reason: reason}
+		}
+		if granted[assignment] {
+			return RolesFile{}, actionerror.InvalidRolesFileError{
+				Path:   path,
+				Reason: fmt.Sprintf("%s is both granted and revoked", describeRoleAssignment(assignment)),
+			}
+		}
+	}
+
+	return rolesFile, nil
+}
+
+// GetRoleChanges compares the roles file with the roles currently assigned
+// and returns the grants and revocations needed to apply it. Grants are
+// returned first, followed by the space revocations and then the
+// organization revocations, which is the order in which they can be applied.
+func (actor Actor) GetRoleChanges(rolesFile RolesFile) ([]RoleChange, Warnings, error) {
+	resolver := roleAssignmentResolver{
+		actor:       actor,
+		orgs:        map[string]Organization{},
+		spaces:      map[string]Space{},
+		roleHolders: map[string][]User{},
+	}
+
+	var grants, spaceRevokes, orgRevokes []RoleChange
+	for _, assignment := range rolesFile.Grant {
+		change, assigned, err := resolver.resolve(assignment)
+		if err != nil {
+			return nil, resolver.warnings, err
+		}
+		if !assigned {
+			change.Action = RoleGrant
+			grants = append(grants, change)
+		}
+	}
+
+	for _, assignment := range rolesFile.Revoke {
+		change, assigned, err := resolver.resolve(assignment)
+		if err != nil {
+			return nil, resolver.warnings, err
+		}
+		if !assigned {
+			continue
+		}
+		change.Action = RoleRevoke
+		if change.SpaceName == "" {
+			orgRevokes = append(orgRevokes, change)
+		} else {
+			spaceRevokes = append(spaceRevokes, change)
+		}
+	}
+
+	changes := append(grants, spaceRevokes...)
+	changes = append(changes, orgRevokes...)
+	return changes, resolver.warnings, nil
+}
+
+// ApplyRoleChanges grants and revokes the provided role changes in order. It
+// stops at the first change that fails and returns the number of changes
+// applied before it.
+func (actor Actor) ApplyRoleChanges(changes []RoleChange) (int, Warnings, error) {
+	var allWarnings Warnings
+	for i, change := range changes {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		if change.SpaceName == "" {
+			role, _ := organizationRoleByName(change.Role)
+			if change.Action == RoleGrant {
+				warnings, err = actor.SetOrganizationRole(role, change.OrganizationGUID, change.Username)
+			} else {
+				warnings, err = actor.RemoveOrganizationRole(role, change.OrganizationGUID, change.Username)
+			}
+		} else {
+			role, _ := spaceRoleByName(change.Role)
+			if change.Action == RoleGrant {
+				warnings, err = actor.SetSpaceRole(role, change.SpaceGUID, change.OrganizationGUID, change.Username)
+			} else {
+				warnings, err = actor.RemoveSpaceRole(role, change.SpaceGUID, change.Username)
+			}
+		}
+
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return i, allWarnings, err
+		}
+	}
+
+	return len(changes), allWarnings, nil
+}
+
+// roleAssignmentResolver looks up the organizations, spaces and role holders
+// of role assignments, fetching each of them only once.
+type roleAssignmentResolver struct {
+	actor       Actor
+	orgs        map[string]Organization
+	spaces      map[string]Space
+	roleHolders map[string][]User
+	warnings    Warnings
+}
+
+// resolve returns the change for the role assignment and whether the role is
+// currently assigned.
+func (resolver *roleAssignmentResolver) resolve(assignment RoleAssignment) (RoleChange, bool, error) {
+	change := RoleChange{RoleAssignment: assignment}
+
+	org, exists := resolver.orgs[assignment.OrganizationName]
+	if !exists {
+		var (
+			warnings Warnings
+			err      error
+		)
+		org, warnings, err = resolver.actor.GetOrganizationByName(assignment.OrganizationName)
+		resolver.warnings = append(resolver.warnings, warnings...)
+		if err != nil {
+			return RoleChange{}, false, err
+		}
+		resolver.orgs[assignment.OrganizationName] = org
+	}
+	change.OrganizationGUID = org.GUID
+
+	if assignment.SpaceName == "" {
+		role, _ := organizationRoleByName(assignment.Role)
+		users, err := resolver.getRoleHolders(org.GUID+"/"+string(role), func() ([]User, Warnings, error) {
+			return resolver.actor.GetOrganizationUsersByRole(role, org.GUID)
+		})
+		return change, hasUsername(users, assignment.Username), err
+	}
+
+	spaceKey := org.GUID + "/" + assignment.SpaceName
+	space, exists := resolver.spaces[spaceKey]
+	if !exists {
+		var (
+			warnings Warnings
+			err      error
+		)
+		space, warnings, err = resolver.actor.GetSpaceByOrganizationAndName(org.GUID, assignment.SpaceName)
+		resolver.warnings = append(resolver.warnings, warnings...)
+		if err != nil {
+			return RoleChange{}, false, err
+		}
+		resolver.spaces[spaceKey] = space
+	}
+	change.SpaceGUID = space.GUID
+
+	role, _ := spaceRoleByName(assignment.Role)
+	users, err := resolver.getRoleHolders(space.GUID+"/"+string(role), func() ([]User, Warnings, error) {
+		return resolver.actor.GetSpaceUsersByRole(role, space.GUID)
+	})
+	return change, hasUsername(users, assignment.Username), err
+}
+
+func (resolver *roleAssignmentResolver) getRoleHolders(key string, get func() ([]User, Warnings, error)) ([]User, error) {
+	if users, exists := resolver.roleHolders[key]; exists {
+		return users, nil
+	}
+
+	users, warnings, err := get()
+	resolver.warnings = append(resolver.warnings, warnings...)
+	if err != nil {
+		return nil, err
+	}
+	resolver.roleHolders[key] = users
+	return users, nil
+}
+
+// validateRoleAssignment returns why the role assignment is invalid, or an
+// empty string if it is valid.
+func validateRoleAssignment(assignment RoleAssignment) string {
+	switch {
+	case assignment.Username == "":
+		return "every role must have a user"
+	case assignment.OrganizationName == "":
+		return fmt.Sprintf("the %s role of %s must have an org", assignment.Role, assignment.Username)
+	}
+
+	if assignment.SpaceName == "" {
+		if _, ok := organizationRoleByName(assignment.Role); !ok {
+			return fmt.Sprintf(`org role of %s must be "OrgUser", "OrgManager", "BillingManager" or "OrgAuditor", got "%s"`, assignment.Username, assignment.Role)
+		}
+	} else if _, ok := spaceRoleByName(assignment.Role); !ok {
+		return fmt.Sprintf(`space role of %s must be "SpaceManager", "SpaceDeveloper" or "SpaceAuditor", got "%s"`, assignment.Username, assignment.Role)
+	}
+
+	return ""
+}
+
+func describeRoleAssignment(assignment RoleAssignment) string {
+	if assignment.SpaceName == "" {
+		return fmt.Sprintf("%s (%s in %s)", assignment.Username, assignment.Role, assignment.OrganizationName)
+	}
+	return fmt.Sprintf("%s (%s in %s / %s)", assignment.Username, assignment.Role, assignment.OrganizationName, assignment.SpaceName)
+}
+
+// displayUsername returns the username of the user, or its GUID for users
+// without a username such as clients.
+func displayUsername(user User) string {
+	if user.Username == "" {
+		return user.GUID
+	}
+	return user.Username
+}
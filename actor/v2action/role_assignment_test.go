@@ -0,0 +1,457 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Assignment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetOrganizationUsersByRoleStub = func(role constant.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+			switch {
+			case orgGUID == "org-guid-1" && role == constant.OrgUser:
+				return []ccv2.User{{GUID: "alice-guid", Username: "alice"}, {GUID: "bob-guid", Username: "bob"}}, ccv2.Warnings{"org-users-warning"}, nil
+			case orgGUID == "org-guid-1" && role == constant.OrgManager:
+				return []ccv2.User{{GUID: "alice-guid", Username: "alice"}, {GUID: "client-guid"}}, nil, nil
+			case orgGUID == "org-guid-2" && role == constant.OrgAuditor:
+				return []ccv2.User{{GUID: "alice-guid", Username: "alice"}}, nil, nil
+			}
+			return nil, nil, nil
+		}
+		fakeCloudControllerClient.GetSpaceUsersByRoleStub = func(role constant.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+			switch {
+			case spaceGUID == "space-guid-1" && role == constant.SpaceDeveloper:
+				return []ccv2.User{{GUID: "alice-guid", Username: "alice"}, {GUID: "bob-guid", Username: "bob"}}, ccv2.Warnings{"space-users-warning"}, nil
+			case spaceGUID == "space-guid-2" && role == constant.SpaceManager:
+				return []ccv2.User{{GUID: "bob-guid", Username: "bob"}}, nil, nil
+			}
+			return nil, nil, nil
+		}
+		fakeCloudControllerClient.GetSpacesReturns(
+			[]ccv2.Space{{GUID: "space-guid-1", Name: "dev"}, {GUID: "space-guid-2", Name: "prod"}},
+			ccv2.Warnings{"spaces-warning"}, nil)
+	})
+
+	Describe("GetUserRoleAssignments", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}, {GUID: "org-guid-2", Name: "org-2"}},
+				ccv2.Warnings{"orgs-warning"}, nil)
+		})
+
+		Context("when the user is a user of one of the orgs", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserOrganizationsByRoleStub = func(role constant.OrganizationRole, userGUID string) ([]ccv2.Organization, ccv2.Warnings, error) {
+					switch role {
+					case constant.OrgUser:
+						return []ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}}, ccv2.Warnings{"user-orgs-warning"}, nil
+					case constant.OrgManager:
+						return []ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}}, nil, nil
+					case constant.OrgAuditor:
+						return []ccv2.Organization{{GUID: "org-guid-2", Name: "org-2"}}, nil, nil
+					}
+					return nil, nil, nil
+				}
+				fakeCloudControllerClient.GetUserSpacesByRoleStub = func(role constant.SpaceRole, userGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+					switch role {
+					case constant.SpaceManager:
+						return []ccv2.Space{{GUID: "space-guid-2", Name: "prod", OrganizationGUID: "org-guid-1"}}, ccv2.Warnings{"user-spaces-warning"}, nil
+					case constant.SpaceDeveloper:
+						return []ccv2.Space{
+							{GUID: "space-guid-2", Name: "prod", OrganizationGUID: "org-guid-1"},
+							{GUID: "space-guid-1", Name: "dev", OrganizationGUID: "org-guid-1"},
+							{GUID: "invisible-space-guid", Name: "hidden", OrganizationGUID: "invisible-org-guid"},
+						}, nil, nil
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("returns the org and space roles of the user in every visible org", func() {
+				assignments, warnings, err := actor.GetUserRoleAssignments("alice")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("orgs-warning", "org-users-warning", "user-orgs-warning", "user-spaces-warning"))
+				Expect(assignments).To(Equal([]RoleAssignment{
+					{Username: "alice", OrganizationName: "org-1", Role: "OrgUser"},
+					{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"},
+					{Username: "alice", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+					{Username: "alice", OrganizationName: "org-1", SpaceName: "prod", Role: "SpaceManager"},
+					{Username: "alice", OrganizationName: "org-1", SpaceName: "prod", Role: "SpaceDeveloper"},
+					{Username: "alice", OrganizationName: "org-2", Role: "OrgAuditor"},
+				}))
+			})
+
+			It("looks up the user's roles through the user's relationships", func() {
+				_, _, err := actor.GetUserRoleAssignments("alice")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.GetOrganizationUsersByRoleCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceUsersByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+
+				Expect(fakeCloudControllerClient.GetUserOrganizationsByRoleCallCount()).To(Equal(4))
+				_, userGUID := fakeCloudControllerClient.GetUserOrganizationsByRoleArgsForCall(0)
+				Expect(userGUID).To(Equal("alice-guid"))
+				Expect(fakeCloudControllerClient.GetUserSpacesByRoleCallCount()).To(Equal(3))
+			})
+
+			Context("when getting the spaces of the user fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetUserSpacesByRoleStub = nil
+					fakeCloudControllerClient.GetUserSpacesByRoleReturns(nil, ccv2.Warnings{"user-spaces-warning"}, errors.New("user-spaces-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					_, warnings, err := actor.GetUserRoleAssignments("alice")
+					Expect(err).To(MatchError("user-spaces-error"))
+					Expect(warnings).To(ContainElement("user-spaces-warning"))
+				})
+			})
+		})
+
+		Context("when the user is not a user of any org", func() {
+			It("returns no roles without looking up the user's relationships", func() {
+				assignments, _, err := actor.GetUserRoleAssignments("carol")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(assignments).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetOrganizationUsersByRoleCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetUserOrganizationsByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetUserSpacesByRoleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the orgs fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"orgs-warning"}, errors.New("orgs-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetUserRoleAssignments("alice")
+				Expect(err).To(MatchError("orgs-error"))
+				Expect(warnings).To(ConsistOf("orgs-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationRoleAssignments", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}},
+				ccv2.Warnings{"orgs-warning"}, nil)
+		})
+
+		It("returns every role in the org and its spaces", func() {
+			assignments, warnings, err := actor.GetOrganizationRoleAssignments("org-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("orgs-warning", "org-users-warning", "spaces-warning", "space-users-warning"))
+			Expect(assignments).To(Equal([]RoleAssignment{
+				{Username: "alice", OrganizationName: "org-1", Role: "OrgUser"},
+				{Username: "bob", OrganizationName: "org-1", Role: "OrgUser"},
+				{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"},
+				{Username: "client-guid", OrganizationName: "org-1", Role: "OrgManager"},
+				{Username: "alice", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+				{Username: "bob", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+				{Username: "bob", OrganizationName: "org-1", SpaceName: "prod", Role: "SpaceManager"},
+			}))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"orgs-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				_, warnings, err := actor.GetOrganizationRoleAssignments("org-1")
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org-1"}))
+				Expect(warnings).To(ConsistOf("orgs-warning"))
+			})
+		})
+
+		Context("when getting the users of a space fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsersByRoleStub = nil
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(nil, ccv2.Warnings{"space-users-warning"}, errors.New("space-users-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationRoleAssignments("org-1")
+				Expect(err).To(MatchError("space-users-error"))
+				Expect(warnings).To(ContainElement("space-users-warning"))
+			})
+		})
+	})
+
+	Describe("ReadRolesFile", func() {
+		var (
+			tmpDir string
+			path   string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "roles-file")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(tmpDir, "roles.yml")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		writeFile := func(contents string) {
+			Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		}
+
+		It("reads the grants and revocations", func() {
+			writeFile(`---
+grant:
+- user: alice
+  org: org-1
+  role: OrgManager
+- user: bob
+  org: org-1
+  space: dev
+  role: SpaceDeveloper
+revoke:
+- user: carol
+  org: org-1
+  space: dev
+  role: SpaceAuditor
+`)
+			rolesFile, err := actor.ReadRolesFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolesFile).To(Equal(RolesFile{
+				Grant: []RoleAssignment{
+					{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"},
+					{Username: "bob", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+				},
+				Revoke: []RoleAssignment{
+					{Username: "carol", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceAuditor"},
+				},
+			}))
+		})
+
+		Context("when an org role is given for a space", func() {
+			BeforeEach(func() {
+				writeFile("grant:\n- {user: alice, org: org-1, space: dev, role: OrgManager}\n")
+			})
+
+			It("returns an InvalidRolesFileError", func() {
+				_, err := actor.ReadRolesFile(path)
+				Expect(err).To(MatchError(actionerror.InvalidRolesFileError{
+					Path:   path,
+					Reason: `space role of alice must be "SpaceManager", "SpaceDeveloper" or "SpaceAuditor", got "OrgManager"`,
+				}))
+			})
+		})
+
+		Context("when a role has no org", func() {
+			BeforeEach(func() {
+				writeFile("revoke:\n- {user: alice, role: OrgManager}\n")
+			})
+
+			It("returns an InvalidRolesFileError", func() {
+				_, err := actor.ReadRolesFile(path)
+				Expect(err).To(MatchError(actionerror.InvalidRolesFileError{Path: path, Reason: "the OrgManager role of alice must have an org"}))
+			})
+		})
+
+		Context("when a role is both granted and revoked", func() {
+			BeforeEach(func() {
+				writeFile("grant:\n- {user: alice, org: org-1, role: OrgAuditor}\nrevoke:\n- {user: alice, org: org-1, role: OrgAuditor}\n")
+			})
+
+			It("returns an InvalidRolesFileError", func() {
+				_, err := actor.ReadRolesFile(path)
+				Expect(err).To(MatchError(actionerror.InvalidRolesFileError{Path: path, Reason: "alice (OrgAuditor in org-1) is both granted and revoked"}))
+			})
+		})
+
+		Context("when the file has unknown keys", func() {
+			BeforeEach(func() {
+				writeFile("grants: []\n")
+			})
+
+			It("returns an InvalidRolesFileError", func() {
+				_, err := actor.ReadRolesFile(path)
+				Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidRolesFileError{}))
+			})
+		})
+	})
+
+	Describe("GetRoleChanges", func() {
+		var rolesFile RolesFile
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}},
+				ccv2.Warnings{"orgs-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesStub = func(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
+				if filters[0].Values[0] == "prod" {
+					return []ccv2.Space{{GUID: "space-guid-2", Name: "prod"}}, ccv2.Warnings{"spaces-warning"}, nil
+				}
+				return []ccv2.Space{{GUID: "space-guid-1", Name: "dev"}}, ccv2.Warnings{"spaces-warning"}, nil
+			}
+
+			rolesFile = RolesFile{
+				Grant: []RoleAssignment{
+					{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"},
+					{Username: "carol", OrganizationName: "org-1", Role: "OrgManager"},
+					{Username: "carol", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+				},
+				Revoke: []RoleAssignment{
+					{Username: "bob", OrganizationName: "org-1", Role: "OrgUser"},
+					{Username: "bob", OrganizationName: "org-1", SpaceName: "prod", Role: "SpaceManager"},
+					{Username: "carol", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceAuditor"},
+				},
+			}
+		})
+
+		It("returns the grants and revocations that differ from the current roles", func() {
+			changes, warnings, err := actor.GetRoleChanges(rolesFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("orgs-warning", "spaces-warning", "space-users-warning", "spaces-warning", "org-users-warning"))
+			Expect(changes).To(Equal([]RoleChange{
+				{
+					RoleAssignment:   RoleAssignment{Username: "carol", OrganizationName: "org-1", Role: "OrgManager"},
+					Action:           RoleGrant,
+					OrganizationGUID: "org-guid-1",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "carol", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+					Action:           RoleGrant,
+					OrganizationGUID: "org-guid-1",
+					SpaceGUID:        "space-guid-1",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob", OrganizationName: "org-1", SpaceName: "prod", Role: "SpaceManager"},
+					Action:           RoleRevoke,
+					OrganizationGUID: "org-guid-1",
+					SpaceGUID:        "space-guid-2",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob", OrganizationName: "org-1", Role: "OrgUser"},
+					Action:           RoleRevoke,
+					OrganizationGUID: "org-guid-1",
+				},
+			}))
+		})
+
+		It("looks up each org and list of role holders once", func() {
+			_, _, err := actor.GetRoleChanges(rolesFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetOrganizationUsersByRoleCallCount()).To(Equal(2))
+		})
+
+		Context("when a space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesStub = nil
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				_, warnings, err := actor.GetRoleChanges(rolesFile)
+				Expect(err).To(MatchError(actionerror.SpaceNotFoundError{Name: "dev"}))
+				Expect(warnings).To(ContainElement("spaces-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyRoleChanges", func() {
+		var changes []RoleChange
+
+		BeforeEach(func() {
+			changes = []RoleChange{
+				{
+					RoleAssignment:   RoleAssignment{Username: "carol", OrganizationName: "org-1", Role: "BillingManager"},
+					Action:           RoleGrant,
+					OrganizationGUID: "org-guid-1",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "carol", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceAuditor"},
+					Action:           RoleGrant,
+					OrganizationGUID: "org-guid-1",
+					SpaceGUID:        "space-guid-1",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob", OrganizationName: "org-1", SpaceName: "prod", Role: "SpaceManager"},
+					Action:           RoleRevoke,
+					OrganizationGUID: "org-guid-1",
+					SpaceGUID:        "space-guid-2",
+				},
+				{
+					RoleAssignment:   RoleAssignment{Username: "bob", OrganizationName: "org-1", Role: "OrgUser"},
+					Action:           RoleRevoke,
+					OrganizationGUID: "org-guid-1",
+				},
+			}
+
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"update-org-warning"}, nil)
+			fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"update-space-warning"}, nil)
+			fakeCloudControllerClient.DeleteSpaceUserByRoleReturns(ccv2.Warnings{"delete-space-warning"}, nil)
+			fakeCloudControllerClient.DeleteOrganizationUserByRoleReturns(ccv2.Warnings{"delete-org-warning"}, nil)
+		})
+
+		It("grants and revokes each role", func() {
+			applied, warnings, err := actor.ApplyRoleChanges(changes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(4))
+			Expect(warnings).To(ConsistOf("update-org-warning", "update-org-warning", "update-space-warning", "delete-space-warning", "delete-org-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(2))
+			role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgBillingManager))
+			Expect(orgGUID).To(Equal("org-guid-1"))
+			Expect(username).To(Equal("carol"))
+
+			spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(constant.SpaceAuditor))
+			Expect(spaceGUID).To(Equal("space-guid-1"))
+			Expect(username).To(Equal("carol"))
+
+			spaceRole, spaceGUID, username = fakeCloudControllerClient.DeleteSpaceUserByRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(constant.SpaceManager))
+			Expect(spaceGUID).To(Equal("space-guid-2"))
+			Expect(username).To(Equal("bob"))
+
+			role, orgGUID, username = fakeCloudControllerClient.DeleteOrganizationUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgUser))
+			Expect(orgGUID).To(Equal("org-guid-1"))
+			Expect(username).To(Equal("bob"))
+		})
+
+		Context("when a change fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteSpaceUserByRoleReturns(ccv2.Warnings{"delete-space-warning"}, errors.New("delete-space-error"))
+			})
+
+			It("stops and returns the number of changes applied", func() {
+				applied, warnings, err := actor.ApplyRoleChanges(changes)
+				Expect(err).To(MatchError("delete-space-error"))
+				Expect(applied).To(Equal(2))
+				Expect(warnings).To(ContainElement("delete-space-warning"))
+				Expect(fakeCloudControllerClient.DeleteOrganizationUserByRoleCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
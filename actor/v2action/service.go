@@ -1,6 +1,10 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
 
 type Service ccv2.Service
 
@@ -8,3 +12,22 @@ func (actor Actor) GetService(serviceGUID string) (Service, Warnings, error) {
 	service, warnings, err := actor.CloudControllerClient.GetService(serviceGUID)
 	return Service(service), Warnings(warnings), err
 }
+
+// GetServiceByNameAndSpace returns the service offering with the provided
+// label that is available in the provided space.
+func (actor Actor) GetServiceByNameAndSpace(serviceName string, spaceGUID string) (Service, Warnings, error) {
+	services, warnings, err := actor.CloudControllerClient.GetSpaceServices(spaceGUID, ccv2.Filter{
+		Type:     constant.LabelFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{serviceName},
+	})
+	if err != nil {
+		return Service{}, Warnings(warnings), err
+	}
+
+	if len(services) == 0 {
+		return Service{}, Warnings(warnings), actionerror.ServiceNotFoundError{Name: serviceName}
+	}
+
+	return Service(services[0]), Warnings(warnings), nil
+}
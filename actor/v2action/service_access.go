@@ -0,0 +1,452 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// ServiceBroker represents a CLI Service Broker.
+type ServiceBroker ccv2.ServiceBroker
+
+// ServicePlanAccessLevel describes which organizations can see a service plan.
+type ServicePlanAccessLevel string
+
+const (
+	// ServicePlanAccessAll means the plan is public and visible to every
+	// organization.
+	ServicePlanAccessAll ServicePlanAccessLevel = "all"
+	// ServicePlanAccessLimited means the plan is visible to some
+	// organizations.
+	ServicePlanAccessLimited ServicePlanAccessLevel = "limited"
+	// ServicePlanAccessNone means the plan is not visible to any organization.
+	ServicePlanAccessNone ServicePlanAccessLevel = "none"
+)
+
+// ServiceAccessFilter narrows down the service access returned to a service
+// broker, a service offering and an organization. Empty fields do not filter.
+type ServiceAccessFilter struct {
+	BrokerName       string
+	ServiceName      string
+	OrganizationName string
+}
+
+// ServiceBrokerAccess is the access to the service offerings of a service
+// broker.
+type ServiceBrokerAccess struct {
+	ServiceBroker
+	Services []ServiceAccess
+}
+
+// ServiceAccess is the access to the plans of a service offering.
+type ServiceAccess struct {
+	Service
+	Plans []ServicePlanAccess
+}
+
+// ServicePlanAccess is the access to a service plan.
+type ServicePlanAccess struct {
+	ServicePlan
+
+	// OrganizationNames are the names of the organizations a non-public plan
+	// is visible to, sorted by name.
+	OrganizationNames []string
+
+	// Usage lists the spaces that have instances of the plan. It is only
+	// populated by AuditServiceAccess.
+	Usage []ServicePlanUsage
+}
+
+// ServicePlanUsage is the number of instances of a service plan in a space.
+type ServicePlanUsage struct {
+	OrganizationName string
+	SpaceName        string
+	Instances        int
+}
+
+// AccessLevel returns which organizations can see the service plan.
+func (planAccess ServicePlanAccess) AccessLevel() ServicePlanAccessLevel {
+	switch {
+	case planAccess.Public:
+		return ServicePlanAccessAll
+	case len(planAccess.OrganizationNames) > 0:
+		return ServicePlanAccessLimited
+	default:
+		return ServicePlanAccessNone
+	}
+}
+
+// GetServiceBrokers returns all the service brokers, sorted by name.
+func (actor Actor) GetServiceBrokers() ([]ServiceBroker, Warnings, error) {
+	ccBrokers, warnings, err := actor.CloudControllerClient.GetServiceBrokers()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var brokers []ServiceBroker
+	for _, broker := range ccBrokers {
+		brokers = append(brokers, ServiceBroker(broker))
+	}
+	sort.Slice(brokers, func(i int, j int) bool {
+		return brokers[i].Name < brokers[j].Name
+	})
+
+	return brokers, Warnings(warnings), nil
+}
+
+// GetServiceAccess returns the access to the plans of every service offering,
+// grouped by service broker. Brokers are sorted by name, services by label.
+// When an organization is provided, only the plans visible to it are
+// returned.
+func (actor Actor) GetServiceAccess(filter ServiceAccessFilter) ([]ServiceBrokerAccess, Warnings, error) {
+	brokerAccess, _, warnings, err := actor.getServiceAccess(filter)
+	return brokerAccess, warnings, err
+}
+
+// AuditServiceAccess returns the same access as GetServiceAccess, along with
+// the spaces that actually have instances of each plan. When an organization
+// is provided, only the spaces of that organization are reported.
+func (actor Actor) AuditServiceAccess(filter ServiceAccessFilter) ([]ServiceBrokerAccess, Warnings, error) {
+	brokerAccess, orgNames, allWarnings, err := actor.getServiceAccess(filter)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	spacesByGUID := map[string]ccv2.Space{}
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = space
+	}
+
+	instances, warnings, err := actor.CloudControllerClient.GetServiceInstances()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	usageByPlan := map[string]map[string]int{}
+	for _, instance := range instances {
+		if instance.ServicePlanGUID == "" {
+			continue
+		}
+		if usageByPlan[instance.ServicePlanGUID] == nil {
+			usageByPlan[instance.ServicePlanGUID] = map[string]int{}
+		}
+		usageByPlan[instance.ServicePlanGUID][instance.SpaceGUID]++
+	}
+
+	for i := range brokerAccess {
+		for j := range brokerAccess[i].Services {
+			for k := range brokerAccess[i].Services[j].Plans {
+				planAccess := &brokerAccess[i].Services[j].Plans[k]
+				for spaceGUID, count := range usageByPlan[planAccess.GUID] {
+					space := spacesByGUID[spaceGUID]
+					orgName := orgNames[space.OrganizationGUID]
+					if filter.OrganizationName != "" && orgName != filter.OrganizationName {
+						continue
+					}
+					planAccess.Usage = append(planAccess.Usage, ServicePlanUsage{
+						OrganizationName: orgName,
+						SpaceName:        space.Name,
+						Instances:        count,
+					})
+				}
+				sortServicePlanUsage(planAccess.Usage)
+			}
+		}
+	}
+
+	return brokerAccess, allWarnings, nil
+}
+
+// EnableServiceAccess makes the plans of the service offering visible. When a
+// plan name is provided only that plan is enabled. When an organization name
+// is provided the plans are made visible to that organization only;
+// otherwise they are made public.
+func (actor Actor) EnableServiceAccess(serviceName string, servicePlanName string, orgName string) (Warnings, error) {
+	return actor.updateServiceAccess(serviceName, servicePlanName, orgName, true)
+}
+
+// DisableServiceAccess hides the plans of the service offering. When a plan
+// name is provided only that plan is disabled. When an organization name is
+// provided the plans are hidden from that organization only; otherwise they
+// are hidden from every organization. A public plan cannot be hidden from a
+// single organization, so nothing is changed when one of the plans is public.
+func (actor Actor) DisableServiceAccess(serviceName string, servicePlanName string, orgName string) (Warnings, error) {
+	return actor.updateServiceAccess(serviceName, servicePlanName, orgName, false)
+}
+
+// getServiceAccess returns the service access matching the filter along with
+// the names of every organization, keyed by GUID.
+func (actor Actor) getServiceAccess(filter ServiceAccessFilter) ([]ServiceBrokerAccess, map[string]string, Warnings, error) {
+	var allWarnings Warnings
+
+	var filterOrg Organization
+	if filter.OrganizationName != "" {
+		org, warnings, err := actor.GetOrganizationByName(filter.OrganizationName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, nil, allWarnings, err
+		}
+		filterOrg = org
+	}
+
+	var brokerFilters []ccv2.Filter
+	if filter.BrokerName != "" {
+		brokerFilters = append(brokerFilters, ccv2.Filter{
+			Type:     constant.NameFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{filter.BrokerName},
+		})
+	}
+	brokers, warnings, err := actor.CloudControllerClient.GetServiceBrokers(brokerFilters...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+	if filter.BrokerName != "" && len(brokers) == 0 {
+		return nil, nil, allWarnings, actionerror.ServiceBrokerNotFoundError{Name: filter.BrokerName}
+	}
+
+	var serviceFilters []ccv2.Filter
+	if filter.ServiceName != "" {
+		serviceFilters = append(serviceFilters, ccv2.Filter{
+			Type:     constant.LabelFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{filter.ServiceName},
+		})
+	}
+	services, warnings, err := actor.CloudControllerClient.GetServices(serviceFilters...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	visibilities, warnings, err := actor.CloudControllerClient.GetServicePlanVisibilities()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range orgs {
+		orgNames[org.GUID] = org.Name
+	}
+
+	visibleOrgGUIDs := map[string][]string{}
+	for _, visibility := range visibilities {
+		visibleOrgGUIDs[visibility.ServicePlanGUID] = append(visibleOrgGUIDs[visibility.ServicePlanGUID], visibility.OrganizationGUID)
+	}
+
+	plansByService := map[string][]ServicePlanAccess{}
+	for _, plan := range plans {
+		planAccess := ServicePlanAccess{ServicePlan: ServicePlan(plan)}
+		for _, orgGUID := range visibleOrgGUIDs[plan.GUID] {
+			if filterOrg.GUID != "" && orgGUID != filterOrg.GUID {
+				continue
+			}
+			planAccess.OrganizationNames = append(planAccess.OrganizationNames, orgNames[orgGUID])
+		}
+		sort.Strings(planAccess.OrganizationNames)
+
+		if filterOrg.GUID != "" && planAccess.AccessLevel() == ServicePlanAccessNone {
+			continue
+		}
+		plansByService[plan.ServiceGUID] = append(plansByService[plan.ServiceGUID], planAccess)
+	}
+
+	servicesByBroker := map[string][]ServiceAccess{}
+	for _, service := range services {
+		servicesByBroker[service.ServiceBrokerGUID] = append(servicesByBroker[service.ServiceBrokerGUID], ServiceAccess{
+			Service: Service(service),
+			Plans:   plansByService[service.GUID],
+		})
+	}
+
+	var brokerAccess []ServiceBrokerAccess
+	var serviceFound bool
+	for _, broker := range brokers {
+		brokerServices := servicesByBroker[broker.GUID]
+		if filter.ServiceName != "" && len(brokerServices) == 0 {
+			continue
+		}
+		serviceFound = serviceFound || len(brokerServices) > 0
+
+		sort.Slice(brokerServices, func(i int, j int) bool {
+			return brokerServices[i].Label < brokerServices[j].Label
+		})
+		brokerAccess = append(brokerAccess, ServiceBrokerAccess{
+			ServiceBroker: ServiceBroker(broker),
+			Services:      brokerServices,
+		})
+	}
+	if filter.ServiceName != "" && !serviceFound {
+		return nil, nil, allWarnings, actionerror.ServiceNotFoundError{Name: filter.ServiceName}
+	}
+
+	sort.Slice(brokerAccess, func(i int, j int) bool {
+		return brokerAccess[i].Name < brokerAccess[j].Name
+	})
+
+	return brokerAccess, orgNames, allWarnings, nil
+}
+
+func (actor Actor) updateServiceAccess(serviceName string, servicePlanName string, orgName string, enable bool) (Warnings, error) {
+	service, allWarnings, err := actor.getServiceByName(serviceName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	summary, warnings, err := actor.getServiceSummary(service)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	plans := summary.Plans
+	if servicePlanName != "" {
+		plans = nil
+		for _, plan := range summary.Plans {
+			if plan.Name == servicePlanName {
+				plans = append(plans, plan)
+			}
+		}
+		if len(plans) == 0 {
+			return allWarnings, actionerror.ServicePlanNotFoundError{PlanName: servicePlanName, ServiceName: serviceName}
+		}
+	}
+
+	var org Organization
+	if orgName != "" {
+		org, warnings, err = actor.GetOrganizationByName(orgName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if orgName != "" && !enable {
+		for _, plan := range plans {
+			if plan.Public {
+				return allWarnings, actionerror.ServicePlanIsPublicError{PlanName: plan.Name, ServiceName: serviceName, OrgName: orgName}
+			}
+		}
+	}
+
+	for _, plan := range plans {
+		if orgName == "" {
+			warnings, err = actor.updateServicePlanVisibilityForAllOrgs(plan, enable)
+		} else {
+			warnings, err = actor.updateServicePlanVisibilityForOrg(plan, org, enable)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// updateServicePlanVisibilityForAllOrgs removes the organization specific
+// visibilities of the plan, which become redundant, and makes it public or
+// private.
+func (actor Actor) updateServicePlanVisibilityForAllOrgs(plan ServicePlan, public bool) (Warnings, error) {
+	visibilities, allWarnings, err := actor.getServicePlanVisibilities(plan.GUID, "")
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, visibility := range visibilities {
+		warnings, err := actor.CloudControllerClient.DeleteServicePlanVisibility(visibility.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if plan.Public == public {
+		return allWarnings, nil
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateServicePlan(plan.GUID, public)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// updateServicePlanVisibilityForOrg makes the plan visible to or hides it from
+// the organization. Public plans are already visible to every organization
+// and are left untouched.
+func (actor Actor) updateServicePlanVisibilityForOrg(plan ServicePlan, org Organization, visible bool) (Warnings, error) {
+	if plan.Public {
+		return nil, nil
+	}
+
+	visibilities, allWarnings, err := actor.getServicePlanVisibilities(plan.GUID, org.GUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if visible {
+		if len(visibilities) > 0 {
+			return allWarnings, nil
+		}
+		_, warnings, err := actor.CloudControllerClient.CreateServicePlanVisibility(plan.GUID, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		return allWarnings, err
+	}
+
+	for _, visibility := range visibilities {
+		warnings, err := actor.CloudControllerClient.DeleteServicePlanVisibility(visibility.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+func (actor Actor) getServicePlanVisibilities(planGUID string, orgGUID string) ([]ccv2.ServicePlanVisibility, Warnings, error) {
+	filters := []ccv2.Filter{{
+		Type:     constant.ServicePlanGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{planGUID},
+	}}
+	if orgGUID != "" {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.OrganizationGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{orgGUID},
+		})
+	}
+
+	visibilities, warnings, err := actor.CloudControllerClient.GetServicePlanVisibilities(filters...)
+	return visibilities, Warnings(warnings), err
+}
+
+func sortServicePlanUsage(usage []ServicePlanUsage) {
+	sort.Slice(usage, func(i int, j int) bool {
+		if usage[i].OrganizationName != usage[j].OrganizationName {
+			return usage[i].OrganizationName < usage[j].OrganizationName
+		}
+		return usage[i].SpaceName < usage[j].SpaceName
+	})
+}
@@ -0,0 +1,442 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Access Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ServicePlanAccess", func() {
+		Describe("AccessLevel", func() {
+			It("is all for public plans", func() {
+				Expect(ServicePlanAccess{ServicePlan: ServicePlan{Public: true}}.AccessLevel()).To(Equal(ServicePlanAccessAll))
+			})
+
+			It("is limited for plans visible to some orgs", func() {
+				Expect(ServicePlanAccess{OrganizationNames: []string{"org-1"}}.AccessLevel()).To(Equal(ServicePlanAccessLimited))
+			})
+
+			It("is none for plans not visible to any org", func() {
+				Expect(ServicePlanAccess{}.AccessLevel()).To(Equal(ServicePlanAccessNone))
+			})
+		})
+	})
+
+	Describe("GetServiceBrokers", func() {
+		It("returns the service brokers sorted by name", func() {
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]ccv2.ServiceBroker{{Name: "broker-b"}, {Name: "broker-a"}},
+				ccv2.Warnings{"get-brokers-warning"},
+				nil)
+
+			brokers, warnings, err := actor.GetServiceBrokers()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-brokers-warning"))
+			Expect(brokers).To(Equal([]ServiceBroker{{Name: "broker-a"}, {Name: "broker-b"}}))
+		})
+
+		It("returns the error and warnings when getting the brokers fails", func() {
+			fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv2.Warnings{"get-brokers-warning"}, errors.New("boom"))
+
+			_, warnings, err := actor.GetServiceBrokers()
+			Expect(err).To(MatchError("boom"))
+			Expect(warnings).To(ConsistOf("get-brokers-warning"))
+		})
+	})
+
+	Describe("GetServiceAccess and AuditServiceAccess", func() {
+		var (
+			filter     ServiceAccessFilter
+			access     []ServiceBrokerAccess
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = ServiceAccessFilter{}
+
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]ccv2.ServiceBroker{{GUID: "broker-guid-2", Name: "broker-b"}, {GUID: "broker-guid-1", Name: "broker-a"}},
+				ccv2.Warnings{"get-brokers-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicesReturns(
+				[]ccv2.Service{
+					{GUID: "service-guid-2", Label: "redis", ServiceBrokerGUID: "broker-guid-1"},
+					{GUID: "service-guid-1", Label: "mysql", ServiceBrokerGUID: "broker-guid-1"},
+				},
+				ccv2.Warnings{"get-services-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{
+					{GUID: "plan-guid-1", Name: "small", ServiceGUID: "service-guid-1", Public: true},
+					{GUID: "plan-guid-2", Name: "large", ServiceGUID: "service-guid-1"},
+					{GUID: "plan-guid-3", Name: "cache", ServiceGUID: "service-guid-2"},
+				},
+				ccv2.Warnings{"get-plans-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(
+				[]ccv2.ServicePlanVisibility{
+					{ServicePlanGUID: "plan-guid-2", OrganizationGUID: "org-guid-2"},
+					{ServicePlanGUID: "plan-guid-2", OrganizationGUID: "org-guid-1"},
+				},
+				ccv2.Warnings{"get-visibilities-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationsStub = func(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
+				if len(filters) > 0 {
+					return []ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}}, ccv2.Warnings{"get-org-warning"}, nil
+				}
+				return []ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}, {GUID: "org-guid-2", Name: "org-2"}},
+					ccv2.Warnings{"get-orgs-warning"}, nil
+			}
+		})
+
+		Describe("GetServiceAccess", func() {
+			JustBeforeEach(func() {
+				access, warnings, executeErr = actor.GetServiceAccess(filter)
+			})
+
+			It("returns the access to every plan grouped by broker and service", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-brokers-warning", "get-services-warning", "get-plans-warning", "get-visibilities-warning", "get-orgs-warning"))
+
+				Expect(access).To(HaveLen(2))
+				Expect(access[0].Name).To(Equal("broker-a"))
+				Expect(access[0].Services).To(HaveLen(2))
+				Expect(access[0].Services[0].Label).To(Equal("mysql"))
+				Expect(access[0].Services[0].Plans).To(Equal([]ServicePlanAccess{
+					{ServicePlan: ServicePlan{GUID: "plan-guid-1", Name: "small", ServiceGUID: "service-guid-1", Public: true}},
+					{ServicePlan: ServicePlan{GUID: "plan-guid-2", Name: "large", ServiceGUID: "service-guid-1"}, OrganizationNames: []string{"org-1", "org-2"}},
+				}))
+				Expect(access[0].Services[1].Label).To(Equal("redis"))
+				Expect(access[0].Services[1].Plans[0].AccessLevel()).To(Equal(ServicePlanAccessNone))
+				Expect(access[1].Name).To(Equal("broker-b"))
+				Expect(access[1].Services).To(BeEmpty())
+			})
+
+			Context("when filtering by broker", func() {
+				BeforeEach(func() {
+					filter.BrokerName = "broker-a"
+				})
+
+				It("queries the broker by name", func() {
+					Expect(fakeCloudControllerClient.GetServiceBrokersArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+						Type:     constant.NameFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"broker-a"},
+					}))
+				})
+
+				Context("when the broker does not exist", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv2.Warnings{"get-brokers-warning"}, nil)
+					})
+
+					It("returns a ServiceBrokerNotFoundError", func() {
+						Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "broker-a"}))
+						Expect(warnings).To(ConsistOf("get-brokers-warning"))
+					})
+				})
+			})
+
+			Context("when filtering by service", func() {
+				BeforeEach(func() {
+					filter.ServiceName = "mysql"
+					fakeCloudControllerClient.GetServicesReturns(
+						[]ccv2.Service{{GUID: "service-guid-1", Label: "mysql", ServiceBrokerGUID: "broker-guid-1"}},
+						ccv2.Warnings{"get-services-warning"},
+						nil)
+				})
+
+				It("only returns the brokers providing the service", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(access).To(HaveLen(1))
+					Expect(access[0].Services).To(HaveLen(1))
+					Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+						Type:     constant.LabelFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"mysql"},
+					}))
+				})
+
+				Context("when the service does not exist", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"get-services-warning"}, nil)
+					})
+
+					It("returns a ServiceNotFoundError", func() {
+						Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "mysql"}))
+					})
+				})
+			})
+
+			Context("when filtering by organization", func() {
+				BeforeEach(func() {
+					filter.OrganizationName = "org-1"
+				})
+
+				It("only returns the plans visible to the organization", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("get-org-warning"))
+					Expect(access[0].Services[0].Plans).To(HaveLen(2))
+					Expect(access[0].Services[0].Plans[1].OrganizationNames).To(Equal([]string{"org-1"}))
+					Expect(access[0].Services[1].Plans).To(BeEmpty())
+				})
+			})
+
+			Context("when getting the plan visibilities fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(nil, ccv2.Warnings{"get-visibilities-warning"}, errors.New("boom"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("boom"))
+					Expect(warnings).To(ConsistOf("get-brokers-warning", "get-services-warning", "get-plans-warning", "get-visibilities-warning"))
+				})
+			})
+		})
+
+		Describe("AuditServiceAccess", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+						{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-2"},
+					},
+					ccv2.Warnings{"get-spaces-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{SpaceGUID: "space-guid-2", ServicePlanGUID: "plan-guid-2"},
+						{SpaceGUID: "space-guid-1", ServicePlanGUID: "plan-guid-2"},
+						{SpaceGUID: "space-guid-1", ServicePlanGUID: "plan-guid-2"},
+						{SpaceGUID: "space-guid-1"},
+					},
+					ccv2.Warnings{"get-instances-warning"},
+					nil)
+			})
+
+			JustBeforeEach(func() {
+				access, warnings, executeErr = actor.AuditServiceAccess(filter)
+			})
+
+			It("reports the spaces that have instances of each plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-spaces-warning"))
+				Expect(warnings).To(ContainElement("get-instances-warning"))
+
+				Expect(access[0].Services[0].Plans[0].Usage).To(BeEmpty())
+				Expect(access[0].Services[0].Plans[1].Usage).To(Equal([]ServicePlanUsage{
+					{OrganizationName: "org-1", SpaceName: "space-1", Instances: 2},
+					{OrganizationName: "org-2", SpaceName: "space-2", Instances: 1},
+				}))
+			})
+
+			Context("when filtering by organization", func() {
+				BeforeEach(func() {
+					filter.OrganizationName = "org-1"
+				})
+
+				It("only reports the spaces of the organization", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(access[0].Services[0].Plans[1].Usage).To(Equal([]ServicePlanUsage{
+						{OrganizationName: "org-1", SpaceName: "space-1", Instances: 2},
+					}))
+				})
+			})
+
+			Context("when getting the service instances fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv2.Warnings{"get-instances-warning"}, errors.New("boom"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("boom"))
+					Expect(warnings).To(ContainElement("get-instances-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("EnableServiceAccess and DisableServiceAccess", func() {
+		var (
+			planName   string
+			orgName    string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			planName = ""
+			orgName = ""
+
+			fakeCloudControllerClient.GetServicesReturns(
+				[]ccv2.Service{{GUID: "service-guid", Label: "mysql"}},
+				ccv2.Warnings{"get-services-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{
+					{GUID: "plan-guid-1", Name: "small", Public: true},
+					{GUID: "plan-guid-2", Name: "large"},
+				},
+				ccv2.Warnings{"get-plans-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(
+				[]ccv2.ServicePlanVisibility{{GUID: "visibility-guid"}},
+				ccv2.Warnings{"get-visibilities-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil)
+		})
+
+		Describe("EnableServiceAccess", func() {
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.EnableServiceAccess("mysql", planName, orgName)
+			})
+
+			It("makes every plan public and removes redundant visibilities", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-services-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServicePlanVisibilityCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.DeleteServicePlanVisibilityArgsForCall(0)).To(Equal("visibility-guid"))
+
+				Expect(fakeCloudControllerClient.UpdateServicePlanCallCount()).To(Equal(1))
+				planGUID, public := fakeCloudControllerClient.UpdateServicePlanArgsForCall(0)
+				Expect(planGUID).To(Equal("plan-guid-2"))
+				Expect(public).To(BeTrue())
+			})
+
+			Context("when the plan does not exist", func() {
+				BeforeEach(func() {
+					planName = "huge"
+				})
+
+				It("returns a ServicePlanNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "huge", ServiceName: "mysql"}))
+				})
+			})
+
+			Context("when an organization is provided", func() {
+				BeforeEach(func() {
+					orgName = "some-org"
+					fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(nil, ccv2.Warnings{"get-visibilities-warning"}, nil)
+					fakeCloudControllerClient.CreateServicePlanVisibilityReturns(ccv2.ServicePlanVisibility{}, ccv2.Warnings{"create-visibility-warning"}, nil)
+				})
+
+				It("makes the non-public plans visible to the organization", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("create-visibility-warning"))
+
+					Expect(fakeCloudControllerClient.GetServicePlanVisibilitiesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServicePlanVisibilitiesArgsForCall(0)).To(ConsistOf(
+						ccv2.Filter{Type: constant.ServicePlanGUIDFilter, Operator: constant.EqualOperator, Values: []string{"plan-guid-2"}},
+						ccv2.Filter{Type: constant.OrganizationGUIDFilter, Operator: constant.EqualOperator, Values: []string{"org-guid"}},
+					))
+
+					Expect(fakeCloudControllerClient.CreateServicePlanVisibilityCallCount()).To(Equal(1))
+					planGUID, orgGUID := fakeCloudControllerClient.CreateServicePlanVisibilityArgsForCall(0)
+					Expect(planGUID).To(Equal("plan-guid-2"))
+					Expect(orgGUID).To(Equal("org-guid"))
+					Expect(fakeCloudControllerClient.UpdateServicePlanCallCount()).To(Equal(0))
+				})
+
+				Context("when the plan is already visible to the organization", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(
+							[]ccv2.ServicePlanVisibility{{GUID: "visibility-guid"}},
+							ccv2.Warnings{"get-visibilities-warning"},
+							nil)
+					})
+
+					It("does not create another visibility", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeCloudControllerClient.CreateServicePlanVisibilityCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		Describe("DisableServiceAccess", func() {
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.DisableServiceAccess("mysql", planName, orgName)
+			})
+
+			Context("when a plan name is provided", func() {
+				BeforeEach(func() {
+					planName = "small"
+					fakeCloudControllerClient.UpdateServicePlanReturns(ccv2.Warnings{"update-plan-warning"}, nil)
+				})
+
+				It("makes only that plan private", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("update-plan-warning"))
+
+					Expect(fakeCloudControllerClient.UpdateServicePlanCallCount()).To(Equal(1))
+					planGUID, public := fakeCloudControllerClient.UpdateServicePlanArgsForCall(0)
+					Expect(planGUID).To(Equal("plan-guid-1"))
+					Expect(public).To(BeFalse())
+				})
+			})
+
+			Context("when an organization is provided", func() {
+				BeforeEach(func() {
+					orgName = "some-org"
+				})
+
+				Context("when none of the plans is public", func() {
+					BeforeEach(func() {
+						planName = "large"
+					})
+
+					It("removes the visibilities of the plans for the organization", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeCloudControllerClient.GetServicePlanVisibilitiesCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.DeleteServicePlanVisibilityCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.DeleteServicePlanVisibilityArgsForCall(0)).To(Equal("visibility-guid"))
+						Expect(fakeCloudControllerClient.UpdateServicePlanCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when one of the plans is public", func() {
+					It("returns a ServicePlanIsPublicError without changing any visibility", func() {
+						Expect(executeErr).To(MatchError(actionerror.ServicePlanIsPublicError{PlanName: "small", ServiceName: "mysql", OrgName: "some-org"}))
+						Expect(warnings).To(ContainElement("get-plans-warning"))
+						Expect(fakeCloudControllerClient.GetServicePlanVisibilitiesCallCount()).To(Equal(0))
+						Expect(fakeCloudControllerClient.DeleteServicePlanVisibilityCallCount()).To(Equal(0))
+						Expect(fakeCloudControllerClient.UpdateServicePlanCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the organization does not exist", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+					})
+
+					It("returns an OrganizationNotFoundError", func() {
+						Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+						Expect(warnings).To(ContainElement("get-org-warning"))
+					})
+				})
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
@@ -97,6 +98,64 @@ func (actor Actor) UnbindServiceBySpace(appName string, serviceInstanceName stri
 	return allWarnings, err
 }
 
+// RebindServiceBySpace deletes the service binding between an application and
+// service instance for a given space and binds them again with the same
+// binding name and, when the service broker supports fetching them, the same
+// parameters. The application is returned so that it can be restarted to pick
+// up the new credentials. A ServiceBindingRecreateFailedError is returned when
+// the binding is deleted but cannot be created again.
+func (actor Actor) RebindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (Application, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	serviceBinding, warnings, err := actor.GetServiceBindingByApplicationAndServiceInstance(app.GUID, serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	var parameters map[string]interface{}
+	if serviceInstance.IsManaged() {
+		var ccWarnings ccv2.Warnings
+		parameters, ccWarnings, err = actor.CloudControllerClient.GetServiceBindingParameters(serviceBinding.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if _, ok := err.(ccerror.ServiceFetchBindingParametersNotSupportedError); ok {
+			parameters = nil
+		} else if err != nil {
+			return Application{}, allWarnings, err
+		}
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.DeleteServiceBinding(serviceBinding.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	_, ccWarnings, err = actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstance.GUID, serviceBinding.Name, parameters)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return Application{}, allWarnings, actionerror.ServiceBindingRecreateFailedError{
+			AppName:             appName,
+			ServiceInstanceName: serviceInstanceName,
+			BindErr:             err.Error(),
+		}
+	}
+
+	return app, allWarnings, nil
+}
+
 func (actor Actor) GetServiceBindingsByServiceInstance(serviceInstanceGUID string) ([]ServiceBinding, Warnings, error) {
 	serviceBindings, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceBindings(serviceInstanceGUID)
 	if err != nil {
@@ -0,0 +1,72 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+
+// ServiceBindingSummary contains a service binding along with the names of the
+// application and service instance it links and the binding's parameters.
+type ServiceBindingSummary struct {
+	ServiceBinding
+
+	// AppName is the name of the bound application.
+	AppName string
+
+	// ServiceInstanceName is the name of the bound service instance.
+	ServiceInstanceName string
+
+	// Parameters are the parameters the service broker stored for the
+	// binding. They are nil when ParametersSupported is false.
+	Parameters map[string]interface{}
+
+	// ParametersSupported is false when the binding's parameters cannot be
+	// fetched, either because the service instance is user provided or
+	// because its service broker does not support it.
+	ParametersSupported bool
+}
+
+// GetServiceBindingSummaryBySpace returns the binding between the application
+// and the service instance with the provided names in the provided space,
+// including its credentials and, where the service broker supports it, its
+// parameters.
+func (actor Actor) GetServiceBindingSummaryBySpace(appName string, serviceInstanceName string, spaceGUID string) (ServiceBindingSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	serviceBinding, warnings, err := actor.GetServiceBindingByApplicationAndServiceInstance(app.GUID, serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	summary := ServiceBindingSummary{
+		ServiceBinding:      serviceBinding,
+		AppName:             app.Name,
+		ServiceInstanceName: serviceInstance.Name,
+	}
+
+	if serviceInstance.IsUserProvided() {
+		return summary, allWarnings, nil
+	}
+
+	parameters, ccWarnings, err := actor.CloudControllerClient.GetServiceBindingParameters(serviceBinding.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if _, ok := err.(ccerror.ServiceFetchBindingParametersNotSupportedError); ok {
+		return summary, allWarnings, nil
+	} else if err != nil {
+		return ServiceBindingSummary{}, allWarnings, err
+	}
+
+	summary.Parameters = parameters
+	summary.ParametersSupported = true
+	return summary, allWarnings, nil
+}
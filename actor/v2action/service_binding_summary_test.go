@@ -0,0 +1,143 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Binding Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceBindingSummaryBySpace", func() {
+		var (
+			summary    ServiceBindingSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"get-app-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance", Type: constant.ServiceInstanceTypeManagedService}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{GUID: "some-binding-guid", Name: "some-binding", Credentials: map[string]interface{}{"password": "secret"}}},
+				ccv2.Warnings{"get-binding-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetServiceBindingSummaryBySpace("some-app", "some-service-instance", "some-space-guid")
+		})
+
+		Context("when the service broker supports fetching binding parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(
+					map[string]interface{}{"some-key": "some-value"},
+					ccv2.Warnings{"get-parameters-warning"},
+					nil)
+			})
+
+			It("returns the binding with its parameters and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary).To(Equal(ServiceBindingSummary{
+					ServiceBinding:      ServiceBinding{GUID: "some-binding-guid", Name: "some-binding", Credentials: map[string]interface{}{"password": "secret"}},
+					AppName:             "some-app",
+					ServiceInstanceName: "some-service-instance",
+					Parameters:          map[string]interface{}{"some-key": "some-value"},
+					ParametersSupported: true,
+				}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-instance-warning", "get-binding-warning", "get-parameters-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(
+					ccv2.Filter{Type: constant.AppGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-app-guid"}},
+					ccv2.Filter{Type: constant.ServiceInstanceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-service-instance-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetServiceBindingParametersCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceBindingParametersArgsForCall(0)).To(Equal("some-binding-guid"))
+			})
+		})
+
+		Context("when the service broker does not support fetching binding parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(
+					nil,
+					ccv2.Warnings{"get-parameters-warning"},
+					ccerror.ServiceFetchBindingParametersNotSupportedError{Message: "not supported"})
+			})
+
+			It("returns the binding without parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary.GUID).To(Equal("some-binding-guid"))
+				Expect(summary.Parameters).To(BeNil())
+				Expect(summary.ParametersSupported).To(BeFalse())
+				Expect(warnings).To(ContainElement("get-parameters-warning"))
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance", Type: constant.ServiceInstanceTypeUserProvidedService}},
+					nil,
+					nil)
+			})
+
+			It("does not fetch the parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summary.Credentials).To(Equal(map[string]interface{}{"password": "secret"}))
+				Expect(summary.ParametersSupported).To(BeFalse())
+				Expect(fakeCloudControllerClient.GetServiceBindingParametersCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the binding does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"get-binding-warning"}, nil)
+			})
+
+			It("returns a ServiceBindingNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBindingNotFoundError{
+					AppGUID:             "some-app-guid",
+					ServiceInstanceGUID: "some-service-instance-guid",
+				}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-instance-warning", "get-binding-warning"))
+			})
+		})
+
+		Context("when fetching the parameters returns another error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(nil, ccv2.Warnings{"get-parameters-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-parameters-warning"))
+			})
+		})
+	})
+})
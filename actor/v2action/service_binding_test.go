@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 
@@ -421,4 +422,93 @@ var _ = Describe("Service Binding Actions", func() {
 			})
 		})
 	})
+
+	Describe("RebindServiceBySpace", func() {
+		var (
+			app        Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"get-app-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Type: constant.ServiceInstanceTypeManagedService}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{GUID: "some-binding-guid", Name: "some-binding-name"}},
+				ccv2.Warnings{"get-binding-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceBindingParametersReturns(
+				map[string]interface{}{"some-key": "some-value"},
+				ccv2.Warnings{"get-parameters-warning"},
+				nil)
+			fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.Warnings{"unbind-warning"}, nil)
+			fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"bind-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, executeErr = actor.RebindServiceBySpace("some-app", "some-service-instance", "some-space-guid")
+		})
+
+		It("unbinds and binds the service instance with the same name and parameters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-instance-warning", "get-binding-warning", "get-parameters-warning", "unbind-warning", "bind-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)).To(Equal("some-binding-guid"))
+
+			appGUID, serviceInstanceGUID, bindingName, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(bindingName).To(Equal("some-binding-name"))
+			Expect(parameters).To(Equal(map[string]interface{}{"some-key": "some-value"}))
+		})
+
+		Context("when the service broker does not support fetching binding parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(nil, nil, ccerror.ServiceFetchBindingParametersNotSupportedError{})
+			})
+
+			It("binds without parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+				Expect(parameters).To(BeNil())
+			})
+		})
+
+		Context("when unbinding fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind failed")
+				fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.Warnings{"unbind-warning"}, expectedErr)
+			})
+
+			It("returns the error without binding again", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("unbind-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when binding again fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"bind-warning"}, errors.New("bind failed"))
+			})
+
+			It("returns a ServiceBindingRecreateFailedError naming the unbound app", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBindingRecreateFailedError{
+					AppName:             "some-app",
+					ServiceInstanceName: "some-service-instance",
+					BindErr:             "bind failed",
+				}))
+				Expect(warnings).To(ContainElement("bind-warning"))
+			})
+		})
+	})
 })
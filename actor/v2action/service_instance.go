@@ -89,3 +89,14 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// DeleteServiceInstance deletes the provided service instance. The returned
+// service instance contains the last operation of an asynchronous deletion; it
+// is empty when the instance was deleted synchronously.
+func (actor Actor) DeleteServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	instance, warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstance.GUID)
+	if _, ok := err.(ccerror.AssociationNotEmptyError); ok {
+		return ServiceInstance{}, Warnings(warnings), actionerror.ServiceInstanceHasAssociationsError{Name: serviceInstance.Name}
+	}
+	return ServiceInstance(instance), Warnings(warnings), err
+}
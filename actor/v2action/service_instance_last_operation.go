@@ -0,0 +1,91 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// LastOperation is the status of the last operation requested on a service
+// instance.
+type LastOperation ccv2.LastOperation
+
+// maxServiceInstancePollingInterval is the longest time
+// PollServiceInstanceLastOperation waits between two requests.
+const maxServiceInstancePollingInterval = 30 * time.Second
+
+// PollServiceInstanceLastOperation polls the provided service instance until
+// its last operation is no longer in progress. The last operation is sent to
+// the returned stream initially and every time its state or description
+// changes. The time between requests starts at the config's PollingInterval
+// and doubles up to 30 seconds.
+//
+// A ServiceInstanceOperationFailedError is returned if the service broker
+// reports that the operation failed, and a
+// ServiceInstanceOperationTimeoutError if the operation is still in progress
+// after the provided timeout. A service instance that is no longer found while
+// being deleted has finished its operation.
+func (actor Actor) PollServiceInstanceLastOperation(serviceInstance ServiceInstance, timeout time.Duration) (<-chan LastOperation, <-chan Warnings, <-chan error) {
+	lastOperationStream := make(chan LastOperation)
+	warningsStream := make(chan Warnings)
+	errorStream := make(chan error)
+
+	go func() {
+		defer close(lastOperationStream)
+		defer close(warningsStream)
+		defer close(errorStream)
+
+		lastOperation := serviceInstance.LastOperation
+		if lastOperation.State != "" {
+			lastOperationStream <- LastOperation(lastOperation)
+		}
+
+		interval := actor.Config.PollingInterval()
+		deadline := time.Now().Add(timeout)
+
+		for lastOperation.State == constant.LastOperationInProgress {
+			if !time.Now().Before(deadline) {
+				errorStream <- actionerror.ServiceInstanceOperationTimeoutError{
+					Name:      serviceInstance.Name,
+					Operation: lastOperation.Type,
+					Timeout:   timeout,
+				}
+				return
+			}
+
+			time.Sleep(interval)
+			interval *= 2
+			if interval > maxServiceInstancePollingInterval {
+				interval = maxServiceInstancePollingInterval
+			}
+
+			instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(serviceInstance.GUID)
+			warningsStream <- Warnings(warnings)
+			if _, ok := err.(ccerror.ResourceNotFoundError); ok && lastOperation.Type == "delete" {
+				return
+			}
+			if err != nil {
+				errorStream <- err
+				return
+			}
+
+			if instance.LastOperation.State != lastOperation.State || instance.LastOperation.Description != lastOperation.Description {
+				lastOperationStream <- LastOperation(instance.LastOperation)
+			}
+			lastOperation = instance.LastOperation
+		}
+
+		if lastOperation.State == constant.LastOperationFailed {
+			errorStream <- actionerror.ServiceInstanceOperationFailedError{
+				Name:        serviceInstance.Name,
+				Operation:   lastOperation.Type,
+				Description: lastOperation.Description,
+			}
+		}
+	}()
+
+	return lastOperationStream, warningsStream, errorStream
+}
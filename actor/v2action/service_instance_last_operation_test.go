@@ -0,0 +1,166 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Last Operation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("PollServiceInstanceLastOperation", func() {
+		var (
+			serviceInstance ServiceInstance
+			timeout         time.Duration
+
+			lastOperationStream <-chan LastOperation
+			warningsStream      <-chan Warnings
+			errStream           <-chan error
+		)
+
+		BeforeEach(func() {
+			timeout = time.Minute
+			serviceInstance = ServiceInstance{
+				GUID: "some-service-instance-guid",
+				Name: "some-service-instance",
+				LastOperation: ccv2.LastOperation{
+					Type:  "create",
+					State: constant.LastOperationInProgress,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			lastOperationStream, warningsStream, errStream = actor.PollServiceInstanceLastOperation(serviceInstance, timeout)
+		})
+
+		AfterEach(func() {
+			Eventually(lastOperationStream).Should(BeClosed())
+			Eventually(warningsStream).Should(BeClosed())
+			Eventually(errStream).Should(BeClosed())
+		})
+
+		Context("when the operation succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(0,
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationInProgress}},
+					ccv2.Warnings{"get-instance-warning-1"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1,
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationInProgress, Description: "50% done"}},
+					ccv2.Warnings{"get-instance-warning-2"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(2,
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationSucceeded}},
+					ccv2.Warnings{"get-instance-warning-3"}, nil)
+			})
+
+			It("streams every change of the last operation and all warnings", func() {
+				Eventually(lastOperationStream).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationInProgress})))
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-instance-warning-1")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-instance-warning-2")))
+				Eventually(lastOperationStream).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationInProgress, Description: "50% done"})))
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-instance-warning-3")))
+				Eventually(lastOperationStream).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationSucceeded})))
+				Consistently(errStream).ShouldNot(Receive())
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationFailed, Description: "quota exceeded"}},
+					ccv2.Warnings{"get-instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceOperationFailedError with the broker's description", func() {
+				Eventually(lastOperationStream).Should(Receive())
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-instance-warning")))
+				Eventually(lastOperationStream).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationFailed, Description: "quota exceeded"})))
+				Eventually(errStream).Should(Receive(MatchError(actionerror.ServiceInstanceOperationFailedError{
+					Name:        "some-service-instance",
+					Operation:   "create",
+					Description: "quota exceeded",
+				})))
+			})
+		})
+
+		Context("when the service instance is no longer found while being deleted", func() {
+			BeforeEach(func() {
+				serviceInstance.LastOperation.Type = "delete"
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{},
+					ccv2.Warnings{"get-instance-warning"},
+					ccerror.ResourceNotFoundError{})
+			})
+
+			It("finishes without an error", func() {
+				Eventually(lastOperationStream).Should(Receive())
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-instance-warning")))
+				Consistently(errStream).ShouldNot(Receive())
+			})
+		})
+
+		Context("when getting the service instance returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"get-instance-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Eventually(lastOperationStream).Should(Receive())
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-instance-warning")))
+				Eventually(errStream).Should(Receive(MatchError(expectedErr)))
+			})
+		})
+
+		Context("when the operation is still in progress after the polling timeout", func() {
+			BeforeEach(func() {
+				timeout = 0
+			})
+
+			It("returns a ServiceInstanceOperationTimeoutError", func() {
+				Eventually(lastOperationStream).Should(Receive())
+				Eventually(errStream).Should(Receive(MatchError(actionerror.ServiceInstanceOperationTimeoutError{
+					Name:      "some-service-instance",
+					Operation: "create",
+				})))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the operation is not in progress", func() {
+			BeforeEach(func() {
+				serviceInstance.LastOperation = ccv2.LastOperation{}
+			})
+
+			It("finishes without polling", func() {
+				Consistently(lastOperationStream).ShouldNot(Receive())
+				Consistently(errStream).ShouldNot(Receive())
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
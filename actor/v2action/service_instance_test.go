@@ -328,4 +328,46 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("DeleteServiceInstance", func() {
+		var (
+			deletedInstance ServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		JustBeforeEach(func() {
+			deletedInstance, warnings, executeErr = actor.DeleteServiceInstance(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"})
+		})
+
+		Context("when the deletion is accepted", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", LastOperation: ccv2.LastOperation{Type: "delete", State: constant.LastOperationInProgress}},
+					ccv2.Warnings{"delete-warning"},
+					nil)
+			})
+
+			It("returns the instance with its last operation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deletedInstance.LastOperation.State).To(Equal(constant.LastOperationInProgress))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the instance still has associations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(
+					ccv2.ServiceInstance{},
+					ccv2.Warnings{"delete-warning"},
+					ccerror.AssociationNotEmptyError{Message: "not empty"})
+			})
+
+			It("returns a ServiceInstanceHasAssociationsError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceHasAssociationsError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,98 @@
+package v2action
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServiceKey represents a set of credentials for a service instance that is
+// not tied to an application.
+type ServiceKey ccv2.ServiceKey
+
+// versionedServiceKeyNameRegexp matches service key names ending in a
+// version suffix such as "-v2".
+var versionedServiceKeyNameRegexp = regexp.MustCompile(`^(.+)-v(\d+)$`)
+
+// CreateRotatedServiceKeyBySpace creates a replacement for the service key
+// with the provided name of the service instance with the provided name in
+// the provided space. The replacement is named after the existing key with
+// the next free version suffix, so "key" is replaced by "key-v2" and "key-v2"
+// by "key-v3". The replacement is created with the parameters of the existing
+// key when the service broker supports fetching them. Both the existing and
+// the new service key are returned.
+func (actor Actor) CreateRotatedServiceKeyBySpace(serviceInstanceName string, keyName string, spaceGUID string) (ServiceKey, ServiceKey, Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceKey{}, ServiceKey{}, allWarnings, err
+	}
+
+	serviceKeys, ccWarnings, err := actor.CloudControllerClient.GetServiceInstanceServiceKeys(serviceInstance.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceKey{}, ServiceKey{}, allWarnings, err
+	}
+
+	var (
+		oldKey   ServiceKey
+		found    bool
+		keyNames = map[string]bool{}
+	)
+	for _, serviceKey := range serviceKeys {
+		keyNames[serviceKey.Name] = true
+		if serviceKey.Name == keyName {
+			oldKey = ServiceKey(serviceKey)
+			found = true
+		}
+	}
+	if !found {
+		return ServiceKey{}, ServiceKey{}, allWarnings, actionerror.ServiceKeyNotFoundError{Name: keyName, ServiceInstanceName: serviceInstanceName}
+	}
+
+	parameters, ccWarnings, err := actor.CloudControllerClient.GetServiceKeyParameters(oldKey.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if _, ok := err.(ccerror.ServiceFetchBindingParametersNotSupportedError); ok {
+		parameters = nil
+	} else if err != nil {
+		return ServiceKey{}, ServiceKey{}, allWarnings, err
+	}
+
+	newKey, ccWarnings, err := actor.CloudControllerClient.CreateServiceKey(serviceInstance.GUID, rotatedServiceKeyName(keyName, keyNames), parameters)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceKey{}, ServiceKey{}, allWarnings, err
+	}
+
+	return oldKey, ServiceKey(newKey), allWarnings, nil
+}
+
+// DeleteServiceKey deletes the provided service key.
+func (actor Actor) DeleteServiceKey(serviceKey ServiceKey) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKey.GUID)
+	return Warnings(warnings), err
+}
+
+// rotatedServiceKeyName returns the name of the key replacing the key with
+// the provided name, skipping names that are already in use.
+func rotatedServiceKeyName(keyName string, existingNames map[string]bool) string {
+	baseName, version := keyName, 1
+	if matches := versionedServiceKeyNameRegexp.FindStringSubmatch(keyName); matches != nil {
+		baseName = matches[1]
+		version, _ = strconv.Atoi(matches[2])
+	}
+
+	for {
+		version++
+		name := fmt.Sprintf("%s-v%d", baseName, version)
+		if !existingNames[name] {
+			return name
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateRotatedServiceKeyBySpace", func() {
+		var (
+			keyName    string
+			oldKey     ServiceKey
+			newKey     ServiceKey
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			keyName = "some-key"
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+				[]ccv2.ServiceKey{
+					{GUID: "some-key-guid", Name: "some-key"},
+					{GUID: "some-key-v2-guid", Name: "some-key-v2"},
+					{GUID: "other-key-v3-guid", Name: "other-key-v3"},
+				},
+				ccv2.Warnings{"get-keys-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceKeyParametersReturns(
+				map[string]interface{}{"some-key": "some-value"},
+				ccv2.Warnings{"get-parameters-warning"},
+				nil)
+			fakeCloudControllerClient.CreateServiceKeyStub = func(serviceInstanceGUID string, name string, _ map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error) {
+				return ccv2.ServiceKey{GUID: "new-key-guid", Name: name}, ccv2.Warnings{"create-key-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			oldKey, newKey, warnings, executeErr = actor.CreateRotatedServiceKeyBySpace("some-service-instance", keyName, "some-space-guid")
+		})
+
+		It("creates a key with the next free version suffix and the parameters of the existing key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(oldKey).To(Equal(ServiceKey{GUID: "some-key-guid", Name: "some-key"}))
+			Expect(newKey).To(Equal(ServiceKey{GUID: "new-key-guid", Name: "some-key-v3"}))
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "get-parameters-warning", "create-key-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceKeyParametersArgsForCall(0)).To(Equal("some-key-guid"))
+
+			serviceInstanceGUID, name, parameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+			Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(name).To(Equal("some-key-v3"))
+			Expect(parameters).To(Equal(map[string]interface{}{"some-key": "some-value"}))
+		})
+
+		Context("when the service broker does not support fetching binding parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeyParametersReturns(nil, nil, ccerror.ServiceFetchBindingParametersNotSupportedError{})
+			})
+
+			It("creates the key without parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, parameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+				Expect(parameters).To(BeNil())
+			})
+		})
+
+		Context("when getting the parameters fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get parameters failed")
+				fakeCloudControllerClient.GetServiceKeyParametersReturns(nil, ccv2.Warnings{"get-parameters-warning"}, expectedErr)
+			})
+
+			It("returns the error without creating a key", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-parameters-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the key name has a version suffix", func() {
+			BeforeEach(func() {
+				keyName = "other-key-v3"
+			})
+
+			It("increments the version", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(newKey.Name).To(Equal("other-key-v4"))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				keyName = "missing-key"
+			})
+
+			It("returns a ServiceKeyNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{Name: "missing-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the key fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create failed")
+				fakeCloudControllerClient.CreateServiceKeyStub = nil
+				fakeCloudControllerClient.CreateServiceKeyReturns(ccv2.ServiceKey{}, ccv2.Warnings{"create-key-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "get-parameters-warning", "create-key-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-key-warning"}, nil)
+		})
+
+		It("deletes the service key", func() {
+			warnings, err := actor.DeleteServiceKey(ServiceKey{GUID: "some-key-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-key-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+		})
+	})
+})
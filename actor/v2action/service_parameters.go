@@ -0,0 +1,46 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/jsonschema"
+)
+
+// ValidateServiceBindingParameters validates parameters against the schema
+// published for creating service bindings by the plan of the service instance
+// with the provided name. User provided service instances accept any
+// parameters.
+func (actor Actor) ValidateServiceBindingParameters(serviceInstanceName string, spaceGUID string, parameters map[string]interface{}) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if !serviceInstance.IsManaged() {
+		return allWarnings, nil
+	}
+
+	servicePlan, warnings, err := actor.GetServicePlan(serviceInstance.ServicePlanGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	return allWarnings, validateServiceParameters(servicePlan.Schemas.ServiceBindingCreateParameters, parameters)
+}
+
+func validateServiceParameters(schema map[string]interface{}, parameters map[string]interface{}) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	validationErrors := jsonschema.Validate(schema, parameters)
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	var paramErrs []actionerror.ServiceParameterError
+	for _, validationError := range validationErrors {
+		paramErrs = append(paramErrs, actionerror.ServiceParameterError(validationError))
+	}
+	return actionerror.ServiceParametersInvalidError{Errors: paramErrs}
+}
@@ -0,0 +1,76 @@
+package v2action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Parameters Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		schema                    map[string]interface{}
+		expectedErr               error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		schema = map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"size"},
+		}
+		expectedErr = actionerror.ServiceParametersInvalidError{
+			Errors: []actionerror.ServiceParameterError{{Path: "(root)", Message: "size is required"}},
+		}
+	})
+
+	Describe("ValidateServiceBindingParameters", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{Type: constant.ServiceInstanceTypeManagedService, ServicePlanGUID: "some-plan-guid"}},
+				ccv2.Warnings{"get-instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlanReturns(
+				ccv2.ServicePlan{Schemas: ccv2.ServicePlanSchemas{ServiceBindingCreateParameters: schema}},
+				ccv2.Warnings{"get-plan-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ValidateServiceBindingParameters("some-service-instance", "some-space-guid", map[string]interface{}{})
+		})
+
+		It("validates against the plan's binding schema", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-plan-warning"))
+			Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("some-plan-guid"))
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{Type: constant.ServiceInstanceTypeUserProvidedService}},
+					ccv2.Warnings{"get-instance-warning"},
+					nil)
+			})
+
+			It("does not validate the parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-instance-warning"))
+				Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,163 @@
+package v2action
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// ServiceSummary represents a service offering in the marketplace along with
+// its service plans.
+type ServiceSummary struct {
+	Service
+	Plans []ServicePlan
+}
+
+// IsPlanBindable returns true if instances of the provided plan can be bound
+// to applications. Plans that do not override bindability inherit it from the
+// service offering.
+func (summary ServiceSummary) IsPlanBindable(plan ServicePlan) bool {
+	if plan.Bindable.IsSet {
+		return plan.Bindable.Value
+	}
+	return summary.Bindable
+}
+
+// MarketplaceFilter narrows down the service offerings listed in the
+// marketplace.
+type MarketplaceFilter struct {
+	// Search matches service offerings whose label, description or tags
+	// contain the term, ignoring case.
+	Search string
+
+	// BrokerName matches service offerings provided by the service broker
+	// with this name.
+	BrokerName string
+}
+
+func (filter MarketplaceFilter) matches(service ccv2.Service) bool {
+	if filter.BrokerName != "" && service.ServiceBrokerName != filter.BrokerName {
+		return false
+	}
+
+	if filter.Search == "" {
+		return true
+	}
+
+	term := strings.ToLower(filter.Search)
+	if strings.Contains(strings.ToLower(service.Label), term) ||
+		strings.Contains(strings.ToLower(service.Description), term) {
+		return true
+	}
+	for _, tag := range service.Tags {
+		if strings.Contains(strings.ToLower(tag), term) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetServiceSummaries returns the service offerings that match the filter,
+// sorted by label, along with their service plans. When spaceGUID is empty,
+// every service offering is returned instead of only the ones available in
+// the space.
+func (actor Actor) GetServiceSummaries(spaceGUID string, filter MarketplaceFilter) ([]ServiceSummary, Warnings, error) {
+	var (
+		services    []ccv2.Service
+		allWarnings Warnings
+		err         error
+		warnings    ccv2.Warnings
+	)
+
+	if spaceGUID == "" {
+		services, warnings, err = actor.CloudControllerClient.GetServices()
+	} else {
+		services, warnings, err = actor.CloudControllerClient.GetSpaceServices(spaceGUID)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []ServiceSummary
+	for _, service := range services {
+		if !filter.matches(service) {
+			continue
+		}
+
+		summary, warnings, err := actor.getServiceSummary(Service(service))
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i int, j int) bool {
+		return summaries[i].Label < summaries[j].Label
+	})
+
+	return summaries, allWarnings, nil
+}
+
+// GetServiceSummaryByName returns the service offering with the provided
+// label along with its service plans. When spaceGUID is empty, the service
+// offering is looked up among every service offering instead of only the ones
+// available in the space.
+func (actor Actor) GetServiceSummaryByName(serviceName string, spaceGUID string) (ServiceSummary, Warnings, error) {
+	var (
+		service  Service
+		warnings Warnings
+		err      error
+	)
+
+	if spaceGUID == "" {
+		service, warnings, err = actor.getServiceByName(serviceName)
+	} else {
+		service, warnings, err = actor.GetServiceByNameAndSpace(serviceName, spaceGUID)
+	}
+	if err != nil {
+		return ServiceSummary{}, warnings, err
+	}
+
+	summary, summaryWarnings, err := actor.getServiceSummary(service)
+	return summary, append(warnings, summaryWarnings...), err
+}
+
+func (actor Actor) getServiceByName(serviceName string) (Service, Warnings, error) {
+	services, warnings, err := actor.CloudControllerClient.GetServices(ccv2.Filter{
+		Type:     constant.LabelFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{serviceName},
+	})
+	if err != nil {
+		return Service{}, Warnings(warnings), err
+	}
+
+	if len(services) == 0 {
+		return Service{}, Warnings(warnings), actionerror.ServiceNotFoundError{Name: serviceName}
+	}
+
+	return Service(services[0]), Warnings(warnings), nil
+}
+
+func (actor Actor) getServiceSummary(service Service) (ServiceSummary, Warnings, error) {
+	servicePlans, warnings, err := actor.CloudControllerClient.GetServicePlans(ccv2.Filter{
+		Type:     constant.ServiceGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{service.GUID},
+	})
+	if err != nil {
+		return ServiceSummary{}, Warnings(warnings), err
+	}
+
+	summary := ServiceSummary{Service: service}
+	for _, servicePlan := range servicePlans {
+		summary.Plans = append(summary.Plans, ServicePlan(servicePlan))
+	}
+
+	return summary, Warnings(warnings), nil
+}
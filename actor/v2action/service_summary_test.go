@@ -0,0 +1,229 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ServiceSummary", func() {
+		Describe("IsPlanBindable", func() {
+			var summary ServiceSummary
+
+			BeforeEach(func() {
+				summary = ServiceSummary{Service: Service{Bindable: true}}
+			})
+
+			It("inherits bindability from the service offering", func() {
+				Expect(summary.IsPlanBindable(ServicePlan{})).To(BeTrue())
+			})
+
+			It("uses the plan's bindability when the plan overrides it", func() {
+				Expect(summary.IsPlanBindable(ServicePlan{Bindable: types.NullBool{IsSet: true, Value: false}})).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("GetServiceSummaries", func() {
+		var (
+			spaceGUID  string
+			filter     MarketplaceFilter
+			summaries  []ServiceSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			spaceGUID = "some-space-guid"
+			filter = MarketplaceFilter{}
+
+			services := []ccv2.Service{
+				{GUID: "service-guid-2", Label: "redis", Description: "key value store", ServiceBrokerName: "broker-b"},
+				{GUID: "service-guid-1", Label: "mysql", Description: "relational database", ServiceBrokerName: "broker-a"},
+				{GUID: "service-guid-3", Label: "postgres", Tags: []string{"SQL"}, ServiceBrokerName: "broker-a"},
+			}
+			fakeCloudControllerClient.GetSpaceServicesReturns(services, ccv2.Warnings{"get-space-services-warning"}, nil)
+			fakeCloudControllerClient.GetServicesReturns(services, ccv2.Warnings{"get-services-warning"}, nil)
+			fakeCloudControllerClient.GetServicePlansStub = func(filters ...ccv2.Filter) ([]ccv2.ServicePlan, ccv2.Warnings, error) {
+				serviceGUID := filters[0].Values[0]
+				return []ccv2.ServicePlan{{Name: serviceGUID + "-plan", ServiceGUID: serviceGUID}}, ccv2.Warnings{"get-plans-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetServiceSummaries(spaceGUID, filter)
+		})
+
+		It("returns the services available in the space with their plans, sorted by label", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-services-warning", "get-plans-warning", "get-plans-warning", "get-plans-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpaceServicesCallCount()).To(Equal(1))
+			passedSpaceGUID, _ := fakeCloudControllerClient.GetSpaceServicesArgsForCall(0)
+			Expect(passedSpaceGUID).To(Equal("some-space-guid"))
+
+			Expect(summaries).To(HaveLen(3))
+			Expect(summaries[0].Label).To(Equal("mysql"))
+			Expect(summaries[0].Plans).To(Equal([]ServicePlan{{Name: "service-guid-1-plan", ServiceGUID: "service-guid-1"}}))
+			Expect(summaries[1].Label).To(Equal("postgres"))
+			Expect(summaries[2].Label).To(Equal("redis"))
+
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.ServiceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"service-guid-2"},
+			}))
+		})
+
+		Context("when no space is provided", func() {
+			BeforeEach(func() {
+				spaceGUID = ""
+			})
+
+			It("returns every service", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(3))
+				Expect(warnings).To(ContainElement("get-services-warning"))
+				Expect(fakeCloudControllerClient.GetSpaceServicesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a search term is provided", func() {
+			BeforeEach(func() {
+				filter.Search = "sql"
+			})
+
+			It("returns the services whose label, description or tags match, ignoring case", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].Label).To(Equal("mysql"))
+				Expect(summaries[1].Label).To(Equal("postgres"))
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a broker name is provided", func() {
+			BeforeEach(func() {
+				filter.BrokerName = "broker-b"
+			})
+
+			It("returns the services provided by the broker", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(1))
+				Expect(summaries[0].Label).To(Equal("redis"))
+			})
+		})
+
+		Context("when getting the services fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get services failed")
+				fakeCloudControllerClient.GetSpaceServicesReturns(nil, ccv2.Warnings{"get-space-services-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-space-services-warning"))
+			})
+		})
+
+		Context("when getting the service plans fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get plans failed")
+				fakeCloudControllerClient.GetServicePlansStub = nil
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"get-plans-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-space-services-warning", "get-plans-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceSummaryByName", func() {
+		var (
+			spaceGUID  string
+			summary    ServiceSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			spaceGUID = "some-space-guid"
+			fakeCloudControllerClient.GetSpaceServicesReturns(
+				[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+				ccv2.Warnings{"get-space-services-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicesReturns(
+				[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+				ccv2.Warnings{"get-services-warning"},
+				nil)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{{GUID: "some-plan-guid", Name: "some-plan"}},
+				ccv2.Warnings{"get-plans-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetServiceSummaryByName("some-service", spaceGUID)
+		})
+
+		It("returns the service in the space with its plans", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-services-warning", "get-plans-warning"))
+			Expect(summary).To(Equal(ServiceSummary{
+				Service: Service{GUID: "some-service-guid", Label: "some-service"},
+				Plans:   []ServicePlan{{GUID: "some-plan-guid", Name: "some-plan"}},
+			}))
+		})
+
+		Context("when no space is provided", func() {
+			BeforeEach(func() {
+				spaceGUID = ""
+			})
+
+			It("looks the service up among every service", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-services-warning", "get-plans-warning"))
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.LabelFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-service"},
+				}))
+			})
+
+			Context("when the service does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"get-services-warning"}, nil)
+				})
+
+				It("returns a ServiceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "some-service"}))
+					Expect(warnings).To(ConsistOf("get-services-warning"))
+				})
+			})
+		})
+	})
+})
@@ -17,3 +17,20 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// GetSpaceQuotaByName returns the space quota with the provided name defined
+// by the organization with the provided GUID.
+func (actor Actor) GetSpaceQuotaByName(name string, orgGUID string) (SpaceQuota, Warnings, error) {
+	spaceQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(orgGUID)
+	if err != nil {
+		return SpaceQuota{}, Warnings(warnings), err
+	}
+
+	for _, spaceQuota := range spaceQuotas {
+		if spaceQuota.Name == name {
+			return SpaceQuota(spaceQuota), Warnings(warnings), nil
+		}
+	}
+
+	return SpaceQuota{}, Warnings(warnings), actionerror.SpaceQuotaNotFoundError{Name: name}
+}
@@ -0,0 +1,312 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// TeardownResourceType is the type of resource deleted by a TeardownStep.
+type TeardownResourceType string
+
+const (
+	TeardownServiceBinding              TeardownResourceType = "service binding"
+	TeardownServiceKey                  TeardownResourceType = "service key"
+	TeardownServiceInstance             TeardownResourceType = "service instance"
+	TeardownUserProvidedServiceInstance TeardownResourceType = "user provided service instance"
+	TeardownApplication                 TeardownResourceType = "app"
+	TeardownRoute                       TeardownResourceType = "route"
+	TeardownSpace                       TeardownResourceType = "space"
+	TeardownOrganization                TeardownResourceType = "org"
+)
+
+// TeardownStep is the deletion of a single resource.
+type TeardownStep struct {
+	Type TeardownResourceType
+	GUID string
+	// Name is a human readable description of the resource.
+	Name string
+}
+
+// SpaceInventory lists the resources destroyed when a space is deleted.
+type SpaceInventory struct {
+	Space
+	Applications     []Application
+	Routes           []Route
+	ServiceInstances []ServiceInstanceInventory
+}
+
+// ServiceInstanceInventory is a service instance along with the bindings and
+// keys that have to be deleted before it.
+type ServiceInstanceInventory struct {
+	ServiceInstance
+	Bindings []ServiceBindingInventory
+	Keys     []ServiceKey
+}
+
+// ServiceBindingInventory is a service binding along with the name of the
+// bound application.
+type ServiceBindingInventory struct {
+	ServiceBinding
+	// ApplicationName is the name of the bound application, or its GUID
+	// when the application is not in the space, as is the case for bindings
+	// of shared service instances.
+	ApplicationName string
+}
+
+// OrganizationInventory lists the resources destroyed when an organization is
+// deleted.
+type OrganizationInventory struct {
+	Organization
+	Spaces []SpaceInventory
+}
+
+// TeardownSteps returns the steps deleting every resource of the space in an
+// order that satisfies their dependencies: service bindings and keys first,
+// then service instances, apps, routes and finally the space itself.
+func (inventory SpaceInventory) TeardownSteps() []TeardownStep {
+	var steps []TeardownStep
+
+	for _, instance := range inventory.ServiceInstances {
+		for _, binding := range instance.Bindings {
+			steps = append(steps, TeardownStep{
+				Type: TeardownServiceBinding,
+				GUID: binding.GUID,
+				Name: fmt.Sprintf("%s -> %s", binding.ApplicationName, instance.Name),
+			})
+		}
+		for _, key := range instance.Keys {
+			steps = append(steps, TeardownStep{
+				Type: TeardownServiceKey,
+				GUID: key.GUID,
+				Name: fmt.Sprintf("%s (%s)", key.Name, instance.Name),
+			})
+		}
+	}
+
+	for _, instance := range inventory.ServiceInstances {
+		stepType := TeardownServiceInstance
+		if instance.Type == constant.ServiceInstanceTypeUserProvidedService {
+			stepType = TeardownUserProvidedServiceInstance
+		}
+		steps = append(steps, TeardownStep{Type: stepType, GUID: instance.GUID, Name: instance.Name})
+	}
+
+	for _, app := range inventory.Applications {
+		steps = append(steps, TeardownStep{Type: TeardownApplication, GUID: app.GUID, Name: app.Name})
+	}
+
+	for _, route := range inventory.Routes {
+		steps = append(steps, TeardownStep{Type: TeardownRoute, GUID: route.GUID, Name: route.String()})
+	}
+
+	return append(steps, TeardownStep{Type: TeardownSpace, GUID: inventory.GUID, Name: inventory.Name})
+}
+
+// GetSpaceInventory returns the resources of the space with the provided name
+// in the organization with the provided name.
+func (actor Actor) GetSpaceInventory(spaceName string, orgName string) (SpaceInventory, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return SpaceInventory{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceInventory{}, allWarnings, err
+	}
+
+	inventory, warnings, err := actor.getSpaceInventory(space)
+	allWarnings = append(allWarnings, warnings...)
+	return inventory, allWarnings, err
+}
+
+// GetOrganizationInventory returns the resources of every space of the
+// organization with the provided name.
+func (actor Actor) GetOrganizationInventory(orgName string) (OrganizationInventory, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return OrganizationInventory{}, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationInventory{}, allWarnings, err
+	}
+
+	inventory := OrganizationInventory{Organization: org}
+	for _, space := range spaces {
+		spaceInventory, warnings, err := actor.getSpaceInventory(space)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationInventory{}, allWarnings, err
+		}
+		inventory.Spaces = append(inventory.Spaces, spaceInventory)
+	}
+
+	return inventory, allWarnings, nil
+}
+
+// ExecuteTeardownStep deletes the resource of the step, waiting for
+// asynchronous deletions to finish. A resource that no longer exists is
+// considered deleted, so that an interrupted teardown can be run again.
+func (actor Actor) ExecuteTeardownStep(step TeardownStep) (Warnings, error) {
+	warnings, err := actor.executeTeardownStep(step)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return warnings, nil
+	}
+	return warnings, err
+}
+
+func (actor Actor) executeTeardownStep(step TeardownStep) (Warnings, error) {
+	switch step.Type {
+	case TeardownServiceBinding:
+		warnings, err := actor.CloudControllerClient.DeleteServiceBinding(step.GUID)
+		return Warnings(warnings), err
+	case TeardownServiceKey:
+		warnings, err := actor.CloudControllerClient.DeleteServiceKey(step.GUID)
+		return Warnings(warnings), err
+	case TeardownServiceInstance:
+		return actor.deleteServiceInstanceAndWait(step)
+	case TeardownUserProvidedServiceInstance:
+		warnings, err := actor.CloudControllerClient.DeleteUserProvidedServiceInstance(step.GUID)
+		return Warnings(warnings), err
+	case TeardownApplication:
+		warnings, err := actor.CloudControllerClient.DeleteApplication(step.GUID)
+		return Warnings(warnings), err
+	case TeardownRoute:
+		warnings, err := actor.CloudControllerClient.DeleteRoute(step.GUID)
+		return Warnings(warnings), err
+	case TeardownSpace:
+		job, allWarnings, err := actor.CloudControllerClient.DeleteSpaceJob(step.GUID)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+		warnings, err := actor.PollJob(Job(job))
+		return append(Warnings(allWarnings), warnings...), err
+	case TeardownOrganization:
+		job, allWarnings, err := actor.CloudControllerClient.DeleteOrganizationJob(step.GUID)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+		warnings, err := actor.PollJob(Job(job))
+		return append(Warnings(allWarnings), warnings...), err
+	}
+
+	return nil, fmt.Errorf("unknown teardown resource type %q", step.Type)
+}
+
+func (actor Actor) deleteServiceInstanceAndWait(step TeardownStep) (Warnings, error) {
+	instance, allWarnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: step.GUID, Name: step.Name})
+	if err != nil {
+		return allWarnings, err
+	}
+	instance.GUID = step.GUID
+	instance.Name = step.Name
+
+	lastOperations, warningsStream, errs := actor.PollServiceInstanceLastOperation(instance, actor.Config.OverallPollingTimeout())
+	for lastOperations != nil || warningsStream != nil || errs != nil {
+		select {
+		case _, ok := <-lastOperations:
+			if !ok {
+				lastOperations = nil
+			}
+		case warnings, ok := <-warningsStream:
+			if !ok {
+				warningsStream = nil
+				break
+			}
+			allWarnings = append(allWarnings, warnings...)
+		case pollErr, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			err = pollErr
+		}
+	}
+
+	return allWarnings, err
+}
+
+func (actor Actor) getSpaceInventory(space Space) (SpaceInventory, Warnings, error) {
+	inventory := SpaceInventory{Space: space}
+
+	apps, allWarnings, err := actor.GetApplicationsBySpace(space.GUID)
+	if err != nil {
+		return SpaceInventory{}, allWarnings, err
+	}
+	inventory.Applications = apps
+
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+	}
+
+	routes, warnings, err := actor.GetSpaceRoutes(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceInventory{}, allWarnings, err
+	}
+	inventory.Routes = routes
+
+	instances, warnings, err := actor.GetServiceInstancesBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceInventory{}, allWarnings, err
+	}
+
+	for _, instance := range instances {
+		instanceInventory, warnings, err := actor.getServiceInstanceInventory(instance, appNames)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceInventory{}, allWarnings, err
+		}
+		inventory.ServiceInstances = append(inventory.ServiceInstances, instanceInventory)
+	}
+
+	return inventory, allWarnings, nil
+}
+
+func (actor Actor) getServiceInstanceInventory(instance ServiceInstance, appNames map[string]string) (ServiceInstanceInventory, Warnings, error) {
+	inventory := ServiceInstanceInventory{ServiceInstance: instance}
+
+	if instance.Type == constant.ServiceInstanceTypeUserProvidedService {
+		bindings, warnings, err := actor.CloudControllerClient.GetUserProvidedServiceInstanceServiceBindings(instance.GUID)
+		for _, binding := range bindings {
+			inventory.Bindings = append(inventory.Bindings, newServiceBindingInventory(ServiceBinding(binding), appNames))
+		}
+		return inventory, Warnings(warnings), err
+	}
+
+	bindings, ccWarnings, err := actor.CloudControllerClient.GetServiceInstanceServiceBindings(instance.GUID)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return ServiceInstanceInventory{}, allWarnings, err
+	}
+	for _, binding := range bindings {
+		inventory.Bindings = append(inventory.Bindings, newServiceBindingInventory(ServiceBinding(binding), appNames))
+	}
+
+	keys, ccWarnings, err := actor.CloudControllerClient.GetServiceInstanceServiceKeys(instance.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceInstanceInventory{}, allWarnings, err
+	}
+	for _, key := range keys {
+		inventory.Keys = append(inventory.Keys, ServiceKey(key))
+	}
+
+	return inventory, allWarnings, nil
+}
+
+func newServiceBindingInventory(binding ServiceBinding, appNames map[string]string) ServiceBindingInventory {
+	appName, ok := appNames[binding.AppGUID]
+	if !ok {
+		appName = binding.AppGUID
+	}
+	return ServiceBindingInventory{ServiceBinding: binding, ApplicationName: appName}
+}
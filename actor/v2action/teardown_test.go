@@ -0,0 +1,247 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Teardown Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("SpaceInventory", func() {
+		Describe("TeardownSteps", func() {
+			It("deletes bindings and keys first, then instances, apps, routes and the space", func() {
+				inventory := SpaceInventory{
+					Space:        Space{GUID: "space-guid", Name: "some-space"},
+					Applications: []Application{{GUID: "app-guid", Name: "some-app"}},
+					Routes:       []Route{{GUID: "route-guid", Host: "some-host", Domain: Domain{Name: "example.com"}}},
+					ServiceInstances: []ServiceInstanceInventory{
+						{
+							ServiceInstance: ServiceInstance{GUID: "instance-guid", Name: "some-db", Type: constant.ServiceInstanceTypeManagedService},
+							Bindings:        []ServiceBindingInventory{{ServiceBinding: ServiceBinding{GUID: "binding-guid"}, ApplicationName: "some-app"}},
+							Keys:            []ServiceKey{{GUID: "key-guid", Name: "some-key"}},
+						},
+						{
+							ServiceInstance: ServiceInstance{GUID: "ups-guid", Name: "some-ups", Type: constant.ServiceInstanceTypeUserProvidedService},
+						},
+					},
+				}
+
+				Expect(inventory.TeardownSteps()).To(Equal([]TeardownStep{
+					{Type: TeardownServiceBinding, GUID: "binding-guid", Name: "some-app -> some-db"},
+					{Type: TeardownServiceKey, GUID: "key-guid", Name: "some-key (some-db)"},
+					{Type: TeardownServiceInstance, GUID: "instance-guid", Name: "some-db"},
+					{Type: TeardownUserProvidedServiceInstance, GUID: "ups-guid", Name: "some-ups"},
+					{Type: TeardownApplication, GUID: "app-guid", Name: "some-app"},
+					{Type: TeardownRoute, GUID: "route-guid", Name: "some-host.example.com"},
+					{Type: TeardownSpace, GUID: "space-guid", Name: "some-space"},
+				}))
+			})
+		})
+	})
+
+	Describe("GetSpaceInventory", func() {
+		var (
+			inventory  SpaceInventory
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "space-guid", Name: "some-space"}},
+				ccv2.Warnings{"get-space-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "app-guid", Name: "some-app"}},
+				ccv2.Warnings{"get-apps-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "instance-guid", Name: "some-db", Type: constant.ServiceInstanceTypeManagedService},
+					{GUID: "ups-guid", Name: "some-ups", Type: constant.ServiceInstanceTypeUserProvidedService},
+				},
+				ccv2.Warnings{"get-instances-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(
+				[]ccv2.ServiceBinding{
+					{GUID: "binding-guid-1", AppGUID: "app-guid"},
+					{GUID: "binding-guid-2", AppGUID: "other-space-app-guid"},
+				},
+				ccv2.Warnings{"get-bindings-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+				[]ccv2.ServiceKey{{GUID: "key-guid", Name: "some-key"}},
+				ccv2.Warnings{"get-keys-warning"},
+				nil)
+			fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{GUID: "ups-binding-guid", AppGUID: "app-guid"}},
+				ccv2.Warnings{"get-ups-bindings-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			inventory, warnings, executeErr = actor.GetSpaceInventory("some-space", "some-org")
+		})
+
+		It("returns the apps, routes and service instances with their bindings and keys", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-org-warning", "get-space-warning", "get-apps-warning", "get-routes-warning",
+				"get-instances-warning", "get-bindings-warning", "get-keys-warning", "get-ups-bindings-warning"))
+
+			Expect(inventory.GUID).To(Equal("space-guid"))
+			Expect(inventory.Applications).To(HaveLen(1))
+			Expect(inventory.ServiceInstances).To(HaveLen(2))
+			Expect(inventory.ServiceInstances[0].Bindings).To(Equal([]ServiceBindingInventory{
+				{ServiceBinding: ServiceBinding{GUID: "binding-guid-1", AppGUID: "app-guid"}, ApplicationName: "some-app"},
+				{ServiceBinding: ServiceBinding{GUID: "binding-guid-2", AppGUID: "other-space-app-guid"}, ApplicationName: "other-space-app-guid"},
+			}))
+			Expect(inventory.ServiceInstances[0].Keys).To(Equal([]ServiceKey{{GUID: "key-guid", Name: "some-key"}}))
+			Expect(inventory.ServiceInstances[1].Bindings).To(HaveLen(1))
+			Expect(inventory.ServiceInstances[1].Keys).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetServiceInstanceServiceKeysCallCount()).To(Equal(1))
+		})
+
+		Context("when getting the service bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(nil, ccv2.Warnings{"get-bindings-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ContainElement("get-bindings-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationInventory", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "space-guid-1", Name: "space-1"}, {GUID: "space-guid-2", Name: "space-2"}},
+				ccv2.Warnings{"get-spaces-warning"},
+				nil)
+		})
+
+		It("returns the inventory of every space in the org", func() {
+			inventory, warnings, err := actor.GetOrganizationInventory("some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("get-spaces-warning"))
+			Expect(inventory.GUID).To(Equal("org-guid"))
+			Expect(inventory.Spaces).To(HaveLen(2))
+			Expect(inventory.Spaces[1].Name).To(Equal("space-2"))
+			Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(2))
+		})
+	})
+
+	Describe("ExecuteTeardownStep", func() {
+		It("deletes service bindings", func() {
+			fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.Warnings{"delete-warning"}, nil)
+
+			warnings, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownServiceBinding, GUID: "binding-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)).To(Equal("binding-guid"))
+		})
+
+		It("deletes apps", func() {
+			_, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownApplication, GUID: "app-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("app-guid"))
+		})
+
+		It("deletes user provided service instances", func() {
+			_, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownUserProvidedServiceInstance, GUID: "ups-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(0)).To(Equal("ups-guid"))
+		})
+
+		It("deletes the space and polls the deletion job", func() {
+			fakeCloudControllerClient.DeleteSpaceJobReturns(ccv2.Job{GUID: "job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+
+			warnings, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownSpace, GUID: "space-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+			Expect(fakeCloudControllerClient.DeleteSpaceJobArgsForCall(0)).To(Equal("space-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "job-guid"}))
+		})
+
+		Context("when deleting a service instance", func() {
+			It("waits for an asynchronous deletion to finish", func() {
+				fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "delete", State: constant.LastOperationInProgress}},
+					ccv2.Warnings{"delete-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"poll-warning"}, ccerror.ResourceNotFoundError{})
+
+				warnings, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownServiceInstance, GUID: "instance-guid", Name: "some-db"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("instance-guid"))
+			})
+
+			It("returns the error when the asynchronous deletion fails", func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "delete", State: constant.LastOperationFailed, Description: "broker says no"}},
+					nil,
+					nil)
+
+				_, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownServiceInstance, GUID: "instance-guid", Name: "some-db"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the resource no longer exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteRouteReturns(ccv2.Warnings{"delete-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("considers it deleted", func() {
+				warnings, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownRoute, GUID: "route-guid"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+
+		Context("when the deletion fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.ExecuteTeardownStep(TeardownStep{Type: TeardownServiceKey, GUID: "key-guid"})
+				Expect(err).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+	})
+})
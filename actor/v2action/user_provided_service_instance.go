@@ -0,0 +1,320 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// UserProvidedServiceInstance represents a user provided service instance,
+// including its credentials.
+type UserProvidedServiceInstance ccv2.UserProvidedServiceInstance
+
+// UserProvidedServiceInstanceDefinition describes a user provided service
+// instance and the names of the applications bound to it, independently of
+// the space and foundation it was read from.
+type UserProvidedServiceInstanceDefinition struct {
+	Name            string                 `json:"name"`
+	Credentials     map[string]interface{} `json:"credentials,omitempty"`
+	SyslogDrainURL  string                 `json:"syslog_drain_url,omitempty"`
+	RouteServiceURL string                 `json:"route_service_url,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+	Applications    []string               `json:"applications,omitempty"`
+}
+
+// GetUserProvidedServiceInstanceByNameAndSpace returns the user provided
+// service instance with the provided name in the provided space.
+func (actor Actor) GetUserProvidedServiceInstanceByNameAndSpace(name string, spaceGUID string) (UserProvidedServiceInstance, Warnings, error) {
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetUserProvidedServiceInstances(
+		ccv2.Filter{
+			Type:     constant.NameFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{name},
+		},
+		ccv2.Filter{
+			Type:     constant.SpaceGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{spaceGUID},
+		},
+	)
+	if err != nil {
+		return UserProvidedServiceInstance{}, Warnings(warnings), err
+	}
+
+	if len(serviceInstances) == 0 {
+		return UserProvidedServiceInstance{}, Warnings(warnings), actionerror.ServiceInstanceNotFoundError{Name: name}
+	}
+
+	return UserProvidedServiceInstance(serviceInstances[0]), Warnings(warnings), nil
+}
+
+// GetUserProvidedServiceInstanceDefinitionsBySpace returns the definitions of
+// the user provided service instances with the provided names in the provided
+// space, sorted by name. When no names are provided, every user provided
+// service instance in the space is returned.
+func (actor Actor) GetUserProvidedServiceInstanceDefinitionsBySpace(spaceGUID string, names []string) ([]UserProvidedServiceInstanceDefinition, Warnings, error) {
+	var (
+		allWarnings      Warnings
+		serviceInstances []UserProvidedServiceInstance
+	)
+
+	if len(names) == 0 {
+		ccServiceInstances, warnings, err := actor.CloudControllerClient.GetUserProvidedServiceInstances(ccv2.Filter{
+			Type:     constant.SpaceGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{spaceGUID},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, serviceInstance := range ccServiceInstances {
+			serviceInstances = append(serviceInstances, UserProvidedServiceInstance(serviceInstance))
+		}
+	} else {
+		for _, name := range names {
+			serviceInstance, warnings, err := actor.GetUserProvidedServiceInstanceByNameAndSpace(name, spaceGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			serviceInstances = append(serviceInstances, serviceInstance)
+		}
+	}
+
+	var definitions []UserProvidedServiceInstanceDefinition
+	for _, serviceInstance := range serviceInstances {
+		definition, warnings, err := actor.getUserProvidedServiceInstanceDefinition(serviceInstance)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		definitions = append(definitions, definition)
+	}
+
+	sort.Slice(definitions, func(i int, j int) bool {
+		return definitions[i].Name < definitions[j].Name
+	})
+
+	return definitions, allWarnings, nil
+}
+
+// CreateUserProvidedServiceInstanceFromDefinition creates the user provided
+// service instance described by the definition in the provided space and
+// binds it to the definition's applications. Applications that do not exist
+// in the space are skipped with a warning. If a service instance with the same
+// name already exists in the space, a ServiceInstanceAlreadyExistsError is
+// returned.
+func (actor Actor) CreateUserProvidedServiceInstanceFromDefinition(spaceGUID string, definition UserProvidedServiceInstanceDefinition) (UserProvidedServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	serviceInstance, ccWarnings, err := actor.CloudControllerClient.CreateUserProvidedServiceInstance(ccv2.UserProvidedServiceInstance{
+		Name:            definition.Name,
+		SpaceGUID:       spaceGUID,
+		Credentials:     definition.Credentials,
+		SyslogDrainURL:  definition.SyslogDrainURL,
+		RouteServiceURL: definition.RouteServiceURL,
+		Tags:            definition.Tags,
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if _, ok := err.(ccerror.ServiceInstanceNameTakenError); ok {
+		return UserProvidedServiceInstance{}, allWarnings, actionerror.ServiceInstanceAlreadyExistsError{Name: definition.Name}
+	} else if err != nil {
+		return UserProvidedServiceInstance{}, allWarnings, err
+	}
+
+	for _, appName := range definition.Applications {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+			allWarnings = append(allWarnings, fmt.Sprintf("App %s not found; skipping binding to service instance %s.", appName, definition.Name))
+			continue
+		} else if err != nil {
+			return UserProvidedServiceInstance(serviceInstance), allWarnings, err
+		}
+
+		warnings, err = actor.BindServiceByApplicationAndServiceInstance(app.GUID, serviceInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return UserProvidedServiceInstance(serviceInstance), allWarnings, err
+		}
+	}
+
+	return UserProvidedServiceInstance(serviceInstance), allWarnings, nil
+}
+
+// MoveUserProvidedServiceInstance re-creates the user provided service
+// instance with the provided name from the source space in the target space,
+// binds it to the applications with the same names in the target space and
+// then deletes the original instance and its bindings. If any step after the
+// instance was created in the target space fails, the move is rolled back. If
+// the rollback fails as well, a ServiceInstanceMoveIncompleteError describing
+// what was left behind is returned.
+func (actor Actor) MoveUserProvidedServiceInstance(name string, sourceSpaceGUID string, targetSpaceGUID string) (UserProvidedServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	sourceInstance, warnings, err := actor.GetUserProvidedServiceInstanceByNameAndSpace(name, sourceSpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return UserProvidedServiceInstance{}, allWarnings, err
+	}
+
+	bindings, warnings, err := actor.GetServiceBindingsByUserProvidedServiceInstance(sourceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return UserProvidedServiceInstance{}, allWarnings, err
+	}
+
+	appNames, warnings, err := actor.getBoundApplicationNames(bindings)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return UserProvidedServiceInstance{}, allWarnings, err
+	}
+
+	movedInstance, warnings, err := actor.CreateUserProvidedServiceInstanceFromDefinition(targetSpaceGUID, newUserProvidedServiceInstanceDefinition(sourceInstance, appNames))
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if movedInstance.GUID == "" {
+			return UserProvidedServiceInstance{}, allWarnings, err
+		}
+		warnings, err = actor.rollbackUserProvidedServiceInstanceMove(sourceInstance, movedInstance, nil, appNames, err)
+		allWarnings = append(allWarnings, warnings...)
+		return UserProvidedServiceInstance{}, allWarnings, err
+	}
+
+	var unbound []ServiceBinding
+	for _, binding := range bindings {
+		ccWarnings, err := actor.CloudControllerClient.DeleteServiceBinding(binding.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			warnings, err = actor.rollbackUserProvidedServiceInstanceMove(sourceInstance, movedInstance, unbound, appNames, err)
+			allWarnings = append(allWarnings, warnings...)
+			return UserProvidedServiceInstance{}, allWarnings, err
+		}
+		unbound = append(unbound, binding)
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.DeleteUserProvidedServiceInstance(sourceInstance.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		warnings, err = actor.rollbackUserProvidedServiceInstanceMove(sourceInstance, movedInstance, unbound, appNames, err)
+		allWarnings = append(allWarnings, warnings...)
+		return UserProvidedServiceInstance{}, allWarnings, err
+	}
+
+	return movedInstance, allWarnings, nil
+}
+
+// rollbackUserProvidedServiceInstanceMove rebinds the source instance to the
+// applications it was unbound from and deletes the instance created in the
+// target space. It returns moveErr if the rollback succeeds.
+func (actor Actor) rollbackUserProvidedServiceInstanceMove(sourceInstance UserProvidedServiceInstance, movedInstance UserProvidedServiceInstance, unbound []ServiceBinding, appNames map[string]string, moveErr error) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		rollbackErr error
+		notRebound  []string
+	)
+
+	for _, binding := range unbound {
+		_, ccWarnings, err := actor.CloudControllerClient.CreateServiceBinding(binding.AppGUID, sourceInstance.GUID, binding.Name, nil)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			if rollbackErr == nil {
+				rollbackErr = err
+			}
+			notRebound = append(notRebound, appNames[binding.AppGUID])
+		}
+	}
+	sort.Strings(notRebound)
+
+	warnings, err := actor.deleteUserProvidedServiceInstanceAndBindings(movedInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil && rollbackErr == nil {
+		rollbackErr = err
+	}
+
+	if rollbackErr != nil {
+		return allWarnings, actionerror.ServiceInstanceMoveIncompleteError{
+			Name:                  sourceInstance.Name,
+			MoveErr:               moveErr.Error(),
+			RollbackErr:           rollbackErr.Error(),
+			TargetInstanceRemains: err != nil,
+			UnboundApplications:   notRebound,
+		}
+	}
+
+	return allWarnings, moveErr
+}
+
+func (actor Actor) deleteUserProvidedServiceInstanceAndBindings(serviceInstanceGUID string) (Warnings, error) {
+	bindings, allWarnings, err := actor.GetServiceBindingsByUserProvidedServiceInstance(serviceInstanceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, binding := range bindings {
+		ccWarnings, err := actor.CloudControllerClient.DeleteServiceBinding(binding.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.DeleteUserProvidedServiceInstance(serviceInstanceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getUserProvidedServiceInstanceDefinition(serviceInstance UserProvidedServiceInstance) (UserProvidedServiceInstanceDefinition, Warnings, error) {
+	bindings, allWarnings, err := actor.GetServiceBindingsByUserProvidedServiceInstance(serviceInstance.GUID)
+	if err != nil {
+		return UserProvidedServiceInstanceDefinition{}, allWarnings, err
+	}
+
+	appNames, warnings, err := actor.getBoundApplicationNames(bindings)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return UserProvidedServiceInstanceDefinition{}, allWarnings, err
+	}
+
+	return newUserProvidedServiceInstanceDefinition(serviceInstance, appNames), allWarnings, nil
+}
+
+// getBoundApplicationNames returns the names of the applications of the
+// provided bindings, keyed by application GUID.
+func (actor Actor) getBoundApplicationNames(bindings []ServiceBinding) (map[string]string, Warnings, error) {
+	var allWarnings Warnings
+	appNames := map[string]string{}
+
+	for _, binding := range bindings {
+		app, warnings, err := actor.GetApplication(binding.AppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		appNames[binding.AppGUID] = app.Name
+	}
+
+	return appNames, allWarnings, nil
+}
+
+func newUserProvidedServiceInstanceDefinition(serviceInstance UserProvidedServiceInstance, appNamesByGUID map[string]string) UserProvidedServiceInstanceDefinition {
+	var appNames []string
+	for _, appName := range appNamesByGUID {
+		appNames = append(appNames, appName)
+	}
+	sort.Strings(appNames)
+
+	return UserProvidedServiceInstanceDefinition{
+		Name:            serviceInstance.Name,
+		Credentials:     serviceInstance.Credentials,
+		SyslogDrainURL:  serviceInstance.SyslogDrainURL,
+		RouteServiceURL: serviceInstance.RouteServiceURL,
+		Tags:            serviceInstance.Tags,
+		Applications:    appNames,
+	}
+}
@@ -0,0 +1,54 @@
+package v2action
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// userProvidedServiceInstancesFile is the format of the file written by
+// WriteUserProvidedServiceInstanceDefinitions.
+type userProvidedServiceInstancesFile struct {
+	UserProvidedServices []UserProvidedServiceInstanceDefinition `json:"user_provided_services"`
+}
+
+// ReadUserProvidedServiceInstanceDefinitions reads the user provided service
+// instance definitions from the file at the provided path.
+func (Actor) ReadUserProvidedServiceInstanceDefinitions(path string) ([]UserProvidedServiceInstanceDefinition, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file userProvidedServiceInstancesFile
+	err = json.Unmarshal(raw, &file)
+	if err != nil {
+		return nil, actionerror.InvalidUserProvidedServiceInstancesFileError{Path: path, Reason: err.Error()}
+	}
+
+	for _, definition := range file.UserProvidedServices {
+		if definition.Name == "" {
+			return nil, actionerror.InvalidUserProvidedServiceInstancesFileError{Path: path, Reason: "every user provided service must have a name"}
+		}
+	}
+
+	return file.UserProvidedServices, nil
+}
+
+// WriteUserProvidedServiceInstanceDefinitions writes the user provided
+// service instance definitions to the file at the provided path. Because the
+// definitions contain credentials, the file is only readable by the current
+// user.
+func (Actor) WriteUserProvidedServiceInstanceDefinitions(path string, definitions []UserProvidedServiceInstanceDefinition) error {
+	if definitions == nil {
+		definitions = []UserProvidedServiceInstanceDefinition{}
+	}
+
+	raw, err := json.MarshalIndent(userProvidedServiceInstancesFile{UserProvidedServices: definitions}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(raw, '\n'), 0600)
+}
@@ -0,0 +1,369 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Provided Service Instance Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetUserProvidedServiceInstanceDefinitionsBySpace", func() {
+		var (
+			names       []string
+			definitions []UserProvidedServiceInstanceDefinition
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			names = nil
+			fakeCloudControllerClient.GetUserProvidedServiceInstancesReturns(
+				[]ccv2.UserProvidedServiceInstance{
+					{GUID: "ups-guid-2", Name: "ups-2", SyslogDrainURL: "syslog://example.com"},
+					{GUID: "ups-guid-1", Name: "ups-1", Credentials: map[string]interface{}{"password": "secret"}},
+				},
+				ccv2.Warnings{"get-ups-warning"},
+				nil)
+			fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsStub = func(guid string) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+				if guid == "ups-guid-1" {
+					return []ccv2.ServiceBinding{{AppGUID: "app-guid-b"}, {AppGUID: "app-guid-a"}}, ccv2.Warnings{"get-bindings-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationStub = func(guid string) (ccv2.Application, ccv2.Warnings, error) {
+				return ccv2.Application{GUID: guid, Name: "app-" + guid[len(guid)-1:]}, ccv2.Warnings{"get-app-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			definitions, warnings, executeErr = actor.GetUserProvidedServiceInstanceDefinitionsBySpace("some-space-guid", names)
+		})
+
+		Context("when no names are provided", func() {
+			It("returns every user provided service instance in the space sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(definitions).To(Equal([]UserProvidedServiceInstanceDefinition{
+					{Name: "ups-1", Credentials: map[string]interface{}{"password": "secret"}, Applications: []string{"app-a", "app-b"}},
+					{Name: "ups-2", SyslogDrainURL: "syslog://example.com"},
+				}))
+				Expect(warnings).To(ConsistOf("get-ups-warning", "get-bindings-warning", "get-app-warning", "get-app-warning"))
+
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstancesArgsForCall(0)).To(ConsistOf(
+					ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		Context("when names are provided", func() {
+			BeforeEach(func() {
+				names = []string{"ups-1"}
+				fakeCloudControllerClient.GetUserProvidedServiceInstancesReturns(
+					[]ccv2.UserProvidedServiceInstance{{GUID: "ups-guid-1", Name: "ups-1"}},
+					ccv2.Warnings{"get-ups-warning"},
+					nil)
+			})
+
+			It("looks up each user provided service instance by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(definitions).To(HaveLen(1))
+				Expect(definitions[0].Name).To(Equal("ups-1"))
+
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstancesArgsForCall(0)).To(ConsistOf(
+					ccv2.Filter{Type: constant.NameFilter, Operator: constant.EqualOperator, Values: []string{"ups-1"}},
+					ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+				))
+			})
+
+			Context("when a user provided service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetUserProvidedServiceInstancesReturns(nil, ccv2.Warnings{"get-ups-warning"}, nil)
+				})
+
+				It("returns a ServiceInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "ups-1"}))
+					Expect(warnings).To(ConsistOf("get-ups-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("CreateUserProvidedServiceInstanceFromDefinition", func() {
+		var (
+			definition      UserProvidedServiceInstanceDefinition
+			serviceInstance UserProvidedServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			definition = UserProvidedServiceInstanceDefinition{
+				Name:            "some-ups",
+				Credentials:     map[string]interface{}{"password": "secret"},
+				RouteServiceURL: "https://route.example.com",
+				Applications:    []string{"existing-app", "missing-app"},
+			}
+			fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(
+				ccv2.UserProvidedServiceInstance{GUID: "new-ups-guid", Name: "some-ups"},
+				ccv2.Warnings{"create-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationsStub = func(filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+				if filters[0].Values[0] == "existing-app" {
+					return []ccv2.Application{{GUID: "existing-app-guid"}}, ccv2.Warnings{"get-app-warning"}, nil
+				}
+				return nil, ccv2.Warnings{"get-app-warning"}, nil
+			}
+			fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"bind-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executeErr = actor.CreateUserProvidedServiceInstanceFromDefinition("some-space-guid", definition)
+		})
+
+		It("creates the instance and binds the existing applications", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(serviceInstance).To(Equal(UserProvidedServiceInstance{GUID: "new-ups-guid", Name: "some-ups"}))
+			Expect(warnings).To(ConsistOf(
+				"create-warning",
+				"get-app-warning",
+				"bind-warning",
+				"get-app-warning",
+				"App missing-app not found; skipping binding to service instance some-ups.",
+			))
+
+			Expect(fakeCloudControllerClient.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(ccv2.UserProvidedServiceInstance{
+				Name:            "some-ups",
+				SpaceGUID:       "some-space-guid",
+				Credentials:     map[string]interface{}{"password": "secret"},
+				RouteServiceURL: "https://route.example.com",
+			}))
+
+			Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID, _, _ := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("existing-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("new-ups-guid"))
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(
+					ccv2.UserProvidedServiceInstance{},
+					ccv2.Warnings{"create-warning"},
+					ccerror.ServiceInstanceNameTakenError{})
+			})
+
+			It("returns a ServiceInstanceAlreadyExistsError without binding", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceAlreadyExistsError{Name: "some-ups"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("MoveUserProvidedServiceInstance", func() {
+		var (
+			movedInstance UserProvidedServiceInstance
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetUserProvidedServiceInstancesReturns(
+				[]ccv2.UserProvidedServiceInstance{{GUID: "source-ups-guid", Name: "some-ups", SyslogDrainURL: "syslog://example.com"}},
+				ccv2.Warnings{"get-ups-warning"},
+				nil)
+			fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{GUID: "source-binding-guid", AppGUID: "source-app-guid"}},
+				ccv2.Warnings{"get-bindings-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{GUID: "source-app-guid", Name: "some-app"}, nil, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{{GUID: "target-app-guid", Name: "some-app"}}, nil, nil)
+			fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(
+				ccv2.UserProvidedServiceInstance{GUID: "target-ups-guid", Name: "some-ups"},
+				ccv2.Warnings{"create-warning"},
+				nil)
+			fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.Warnings{"unbind-warning"}, nil)
+			fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			movedInstance, warnings, executeErr = actor.MoveUserProvidedServiceInstance("some-ups", "source-space-guid", "target-space-guid")
+		})
+
+		It("re-creates the instance in the target space and deletes the original", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(movedInstance.GUID).To(Equal("target-ups-guid"))
+			Expect(warnings).To(ContainElement("create-warning"))
+			Expect(warnings).To(ContainElement("unbind-warning"))
+			Expect(warnings).To(ContainElement("delete-warning"))
+
+			Expect(fakeCloudControllerClient.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(ccv2.UserProvidedServiceInstance{
+				Name:           "some-ups",
+				SpaceGUID:      "target-space-guid",
+				SyslogDrainURL: "syslog://example.com",
+			}))
+
+			appGUID, serviceInstanceGUID, _, _ := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("target-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("target-ups-guid"))
+
+			Expect(fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)).To(Equal("source-binding-guid"))
+			Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(0)).To(Equal("source-ups-guid"))
+		})
+
+		Context("when creating the instance in the target space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create failed")
+				fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(ccv2.UserProvidedServiceInstance{}, nil, expectedErr)
+			})
+
+			It("leaves the original instance in place", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when binding the instance in the target space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bind failed")
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, nil, expectedErr)
+				fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturnsOnCall(1,
+					nil,
+					ccv2.Warnings{"get-target-bindings-warning"},
+					nil)
+			})
+
+			It("deletes the instance created in the target space and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-target-bindings-warning"))
+				Expect(warnings).To(ContainElement("delete-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(0)).To(Equal("target-ups-guid"))
+			})
+		})
+
+		Context("when deleting the original instance fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete failed")
+				fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturnsOnCall(0, nil, expectedErr)
+				fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturnsOnCall(1,
+					[]ccv2.ServiceBinding{{GUID: "target-binding-guid", AppGUID: "target-app-guid"}},
+					nil,
+					nil)
+			})
+
+			Context("when the rollback succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturnsOnCall(1, ccv2.Warnings{"rollback-delete-warning"}, nil)
+				})
+
+				It("rebinds the original instance, deletes the moved instance and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("rollback-delete-warning"))
+
+					Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(2))
+					appGUID, serviceInstanceGUID, _, _ := fakeCloudControllerClient.CreateServiceBindingArgsForCall(1)
+					Expect(appGUID).To(Equal("source-app-guid"))
+					Expect(serviceInstanceGUID).To(Equal("source-ups-guid"))
+
+					Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.DeleteServiceBindingArgsForCall(1)).To(Equal("target-binding-guid"))
+					Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(1)).To(Equal("target-ups-guid"))
+				})
+			})
+
+			Context("when the rollback fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateServiceBindingReturnsOnCall(1, ccv2.ServiceBinding{}, nil, errors.New("rebind failed"))
+					fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturnsOnCall(1, nil, errors.New("rollback delete failed"))
+				})
+
+				It("returns a ServiceInstanceMoveIncompleteError describing what was left behind", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceMoveIncompleteError{
+						Name:                  "some-ups",
+						MoveErr:               "delete failed",
+						RollbackErr:           "rebind failed",
+						TargetInstanceRemains: true,
+						UnboundApplications:   []string{"some-app"},
+					}))
+				})
+			})
+		})
+	})
+
+	Describe("user provided service instance definition files", func() {
+		var (
+			tmpDir string
+			path   string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "ups-definitions")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(tmpDir, "services.json")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("round trips definitions through a file only readable by the user", func() {
+			definitions := []UserProvidedServiceInstanceDefinition{
+				{Name: "some-ups", Credentials: map[string]interface{}{"password": "secret"}, Tags: []string{"tag"}, Applications: []string{"some-app"}},
+			}
+			Expect(actor.WriteUserProvidedServiceInstanceDefinitions(path, definitions)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			readDefinitions, err := actor.ReadUserProvidedServiceInstanceDefinitions(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readDefinitions).To(Equal(definitions))
+		})
+
+		Context("when the file is not valid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte(`{"user_provided_services": [{"credentials": {}}]}`), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidUserProvidedServiceInstancesFileError", func() {
+				_, err := actor.ReadUserProvidedServiceInstanceDefinitions(path)
+				Expect(err).To(MatchError(actionerror.InvalidUserProvidedServiceInstancesFileError{
+					Path:   path,
+					Reason: "every user provided service must have a name",
+				}))
+			})
+		})
+	})
+})
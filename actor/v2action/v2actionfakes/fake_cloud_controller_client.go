@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -25,6 +26,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateOrganizationStub        func(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName   string
+		quotaGUID string
+	}
+	createOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -59,6 +76,55 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateServiceKeyStub        func(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error)
+	createServiceKeyMutex       sync.RWMutex
+	createServiceKeyArgsForCall []struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}
+	createServiceKeyReturns struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateServicePlanVisibilityStub        func(servicePlanGUID string, organizationGUID string) (ccv2.ServicePlanVisibility, ccv2.Warnings, error)
+	createServicePlanVisibilityMutex       sync.RWMutex
+	createServicePlanVisibilityArgsForCall []struct {
+		servicePlanGUID  string
+		organizationGUID string
+	}
+	createServicePlanVisibilityReturns struct {
+		result1 ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServicePlanVisibilityReturnsOnCall map[int]struct {
+		result1 ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -74,6 +140,34 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateUserProvidedServiceInstanceStub        func(serviceInstance ccv2.UserProvidedServiceInstance) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error)
+	createUserProvidedServiceInstanceMutex       sync.RWMutex
+	createUserProvidedServiceInstanceArgsForCall []struct {
+		serviceInstance ccv2.UserProvidedServiceInstance
+	}
+	createUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteApplicationStub        func(appGUID string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		appGUID string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationJobStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationJobMutex       sync.RWMutex
 	deleteOrganizationJobArgsForCall []struct {
@@ -89,6 +183,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteOrganizationUserByRoleStub        func(role constant.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	deleteOrganizationUserByRoleMutex       sync.RWMutex
+	deleteOrganizationUserByRoleArgsForCall []struct {
+		role     constant.OrganizationRole
+		orgGUID  string
+		username string
+	}
+	deleteOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -157,6 +266,47 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteServicePlanVisibilityStub        func(servicePlanVisibilityGUID string) (ccv2.Warnings, error)
+	deleteServicePlanVisibilityMutex       sync.RWMutex
+	deleteServicePlanVisibilityArgsForCall []struct {
+		servicePlanVisibilityGUID string
+	}
+	deleteServicePlanVisibilityReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServicePlanVisibilityReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteSpaceJobStub        func(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteSpaceJobMutex       sync.RWMutex
 	deleteSpaceJobArgsForCall []struct {
@@ -172,6 +322,34 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteSpaceUserByRoleStub        func(role constant.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	deleteSpaceUserByRoleMutex       sync.RWMutex
+	deleteSpaceUserByRoleArgsForCall []struct {
+		role      constant.SpaceRole
+		spaceGUID string
+		username  string
+	}
+	deleteSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteUserProvidedServiceInstanceStub        func(userProvidedServiceInstanceGUID string) (ccv2.Warnings, error)
+	deleteUserProvidedServiceInstanceMutex       sync.RWMutex
+	deleteUserProvidedServiceInstanceArgsForCall []struct {
+		userProvidedServiceInstanceGUID string
+	}
+	deleteUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DoesRouteExistStub        func(route ccv2.Route) (bool, ccv2.Warnings, error)
 	doesRouteExistMutex       sync.RWMutex
 	doesRouteExistArgsForCall []struct {
@@ -263,6 +441,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetBuildpacksStub        func(filters ...ccv2.Filter) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetConfigFeatureFlagsStub        func() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
 	getConfigFeatureFlagsMutex       sync.RWMutex
 	getConfigFeatureFlagsArgsForCall []struct{}
@@ -337,6 +530,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(filters ...ccv2.Filter) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationUsersByRoleStub        func(role constant.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    constant.OrganizationRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -458,6 +682,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceBindingParametersStub        func(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceBindingParametersMutex       sync.RWMutex
+	getServiceBindingParametersArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	getServiceBindingParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceBindingsStub        func(filters ...ccv2.Filter) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getServiceBindingsMutex       sync.RWMutex
 	getServiceBindingsArgsForCall []struct {
@@ -473,6 +712,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceBrokersStub        func(filters ...ccv2.Filter) ([]ccv2.ServiceBroker, ccv2.Warnings, error)
+	getServiceBrokersMutex       sync.RWMutex
+	getServiceBrokersArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getServiceBrokersReturns struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBrokersReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
 	getServiceInstanceMutex       sync.RWMutex
 	getServiceInstanceArgsForCall []struct {
@@ -503,6 +757,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceInstanceServiceKeysStub        func(serviceInstanceGUID string, filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceInstanceServiceKeysMutex       sync.RWMutex
+	getServiceInstanceServiceKeysArgsForCall []struct {
+		serviceInstanceGUID string
+		filters             []ccv2.Filter
+	}
+	getServiceInstanceServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceInstanceSharedFromStub        func(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
 	getServiceInstanceSharedFromMutex       sync.RWMutex
 	getServiceInstanceSharedFromArgsForCall []struct {
@@ -548,6 +818,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeyParametersStub        func(serviceKeyGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceKeyParametersMutex       sync.RWMutex
+	getServiceKeyParametersArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	getServiceKeyParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeyParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
 	getServicePlanMutex       sync.RWMutex
 	getServicePlanArgsForCall []struct {
@@ -563,6 +848,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServicePlansStub        func(filters ...ccv2.Filter) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getServicePlansReturns struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlanVisibilitiesStub        func(filters ...ccv2.Filter) ([]ccv2.ServicePlanVisibility, ccv2.Warnings, error)
+	getServicePlanVisibilitiesMutex       sync.RWMutex
+	getServicePlanVisibilitiesArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getServicePlanVisibilitiesReturns struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanVisibilitiesReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicesStub        func(filters ...ccv2.Filter) ([]ccv2.Service, ccv2.Warnings, error)
+	getServicesMutex       sync.RWMutex
+	getServicesArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSharedDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getSharedDomainMutex       sync.RWMutex
 	getSharedDomainArgsForCall []struct {
@@ -608,6 +938,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceQuotasStub        func(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	getSpaceQuotasMutex       sync.RWMutex
+	getSpaceQuotasArgsForCall []struct {
+		orgGUID string
+	}
+	getSpaceQuotasReturns struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceRoutesStub        func(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	getSpaceRoutesMutex       sync.RWMutex
 	getSpaceRoutesArgsForCall []struct {
@@ -657,6 +1002,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceServicesStub        func(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Service, ccv2.Warnings, error)
+	getSpaceServicesMutex       sync.RWMutex
+	getSpaceServicesArgsForCall []struct {
+		spaceGUID string
+		filters   []ccv2.Filter
+	}
+	getSpaceServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceStagingSecurityGroupsStub        func(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSpaceStagingSecurityGroupsMutex       sync.RWMutex
 	getSpaceStagingSecurityGroupsArgsForCall []struct {
@@ -673,6 +1034,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(role constant.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      constant.SpaceRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -718,6 +1095,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetUserOrganizationsByRoleStub        func(role constant.OrganizationRole, userGUID string) ([]ccv2.Organization, ccv2.Warnings, error)
+	getUserOrganizationsByRoleMutex       sync.RWMutex
+	getUserOrganizationsByRoleArgsForCall []struct {
+		role     constant.OrganizationRole
+		userGUID string
+	}
+	getUserOrganizationsByRoleReturns struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getUserOrganizationsByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetUserProvidedServiceInstanceServiceBindingsStub        func(userProvidedServiceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getUserProvidedServiceInstanceServiceBindingsMutex       sync.RWMutex
 	getUserProvidedServiceInstanceServiceBindingsArgsForCall []struct {
@@ -733,6 +1126,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetUserProvidedServiceInstancesStub        func(filters ...ccv2.Filter) ([]ccv2.UserProvidedServiceInstance, ccv2.Warnings, error)
+	getUserProvidedServiceInstancesMutex       sync.RWMutex
+	getUserProvidedServiceInstancesArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getUserProvidedServiceInstancesReturns struct {
+		result1 []ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getUserProvidedServiceInstancesReturnsOnCall map[int]struct {
+		result1 []ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetUserSpacesByRoleStub        func(role constant.SpaceRole, userGUID string) ([]ccv2.Space, ccv2.Warnings, error)
+	getUserSpacesByRoleMutex       sync.RWMutex
+	getUserSpacesByRoleArgsForCall []struct {
+		role     constant.SpaceRole
+		userGUID string
+	}
+	getUserSpacesByRoleReturns struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getUserSpacesByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -761,6 +1185,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	SetSpaceQuotaStub        func(spaceGUID string, quotaGUID string) (ccv2.Warnings, error)
+	setSpaceQuotaMutex       sync.RWMutex
+	setSpaceQuotaArgsForCall []struct {
+		spaceGUID string
+		quotaGUID string
+	}
+	setSpaceQuotaReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	setSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	TargetCFStub        func(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	targetCFMutex       sync.RWMutex
 	targetCFArgsForCall []struct {
@@ -789,6 +1227,35 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateOrganizationQuotaStub        func(orgGUID string, quotaGUID string) (ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgGUID   string
+		quotaGUID string
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationUserByRoleStub        func(role constant.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleMutex       sync.RWMutex
+	updateOrganizationUserByRoleArgsForCall []struct {
+		role     constant.OrganizationRole
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateResourceMatchStub        func(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	updateResourceMatchMutex       sync.RWMutex
 	updateResourceMatchArgsForCall []struct {
@@ -848,17 +1315,46 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
-	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
-	uploadApplicationPackageMutex       sync.RWMutex
-	uploadApplicationPackageArgsForCall []struct {
-		appGUID            string
-		existingResources  []ccv2.Resource
-		newResources       ccv2.Reader
-		newResourcesLength int64
+	UpdateServicePlanStub        func(servicePlanGUID string, public bool) (ccv2.Warnings, error)
+	updateServicePlanMutex       sync.RWMutex
+	updateServicePlanArgsForCall []struct {
+		servicePlanGUID string
+		public          bool
 	}
-	uploadApplicationPackageReturns struct {
-		result1 ccv2.Job
-		result2 ccv2.Warnings
+	updateServicePlanReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateServicePlanReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceUserByRoleStub        func(role constant.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleMutex       sync.RWMutex
+	updateSpaceUserByRoleArgsForCall []struct {
+		role      constant.SpaceRole
+		spaceGUID string
+		username  string
+	}
+	updateSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
+	uploadApplicationPackageMutex       sync.RWMutex
+	uploadApplicationPackageArgsForCall []struct {
+		appGUID            string
+		existingResources  []ccv2.Resource
+		newResources       ccv2.Reader
+		newResourcesLength int64
+	}
+	uploadApplicationPackageReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
 		result3 error
 	}
 	uploadApplicationPackageReturnsOnCall map[int]struct {
@@ -1004,6 +1500,61 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateOrganization(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName   string
+		quotaGUID string
+	}{orgName, quotaGUID})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName, quotaGUID})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationArgsForCall(i int) (string, string) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName, fake.createOrganizationArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -1116,6 +1667,172 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.createServiceKeyMutex.Lock()
+	ret, specificReturn := fake.createServiceKeyReturnsOnCall[len(fake.createServiceKeyArgsForCall)]
+	fake.createServiceKeyArgsForCall = append(fake.createServiceKeyArgsForCall, struct {
+		serviceInstanceGUID string
+		keyName             string
+		parameters          map[string]interface{}
+	}{serviceInstanceGUID, keyName, parameters})
+	fake.recordInvocation("CreateServiceKey", []interface{}{serviceInstanceGUID, keyName, parameters})
+	fake.createServiceKeyMutex.Unlock()
+	if fake.CreateServiceKeyStub != nil {
+		return fake.CreateServiceKeyStub(serviceInstanceGUID, keyName, parameters)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceKeyReturns.result1, fake.createServiceKeyReturns.result2, fake.createServiceKeyReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyCallCount() int {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return len(fake.createServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return fake.createServiceKeyArgsForCall[i].serviceInstanceGUID, fake.createServiceKeyArgsForCall[i].keyName, fake.createServiceKeyArgsForCall[i].parameters
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturns(result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	fake.createServiceKeyReturns = struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceKeyReturnsOnCall(i int, result1 ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceKeyStub = nil
+	if fake.createServiceKeyReturnsOnCall == nil {
+		fake.createServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServicePlanVisibility(servicePlanGUID string, organizationGUID string) (ccv2.ServicePlanVisibility, ccv2.Warnings, error) {
+	fake.createServicePlanVisibilityMutex.Lock()
+	ret, specificReturn := fake.createServicePlanVisibilityReturnsOnCall[len(fake.createServicePlanVisibilityArgsForCall)]
+	fake.createServicePlanVisibilityArgsForCall = append(fake.createServicePlanVisibilityArgsForCall, struct {
+		servicePlanGUID  string
+		organizationGUID string
+	}{servicePlanGUID, organizationGUID})
+	fake.recordInvocation("CreateServicePlanVisibility", []interface{}{servicePlanGUID, organizationGUID})
+	fake.createServicePlanVisibilityMutex.Unlock()
+	if fake.CreateServicePlanVisibilityStub != nil {
+		return fake.CreateServicePlanVisibilityStub(servicePlanGUID, organizationGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServicePlanVisibilityReturns.result1, fake.createServicePlanVisibilityReturns.result2, fake.createServicePlanVisibilityReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServicePlanVisibilityCallCount() int {
+	fake.createServicePlanVisibilityMutex.RLock()
+	defer fake.createServicePlanVisibilityMutex.RUnlock()
+	return len(fake.createServicePlanVisibilityArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServicePlanVisibilityArgsForCall(i int) (string, string) {
+	fake.createServicePlanVisibilityMutex.RLock()
+	defer fake.createServicePlanVisibilityMutex.RUnlock()
+	return fake.createServicePlanVisibilityArgsForCall[i].servicePlanGUID, fake.createServicePlanVisibilityArgsForCall[i].organizationGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateServicePlanVisibilityReturns(result1 ccv2.ServicePlanVisibility, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServicePlanVisibilityStub = nil
+	fake.createServicePlanVisibilityReturns = struct {
+		result1 ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServicePlanVisibilityReturnsOnCall(i int, result1 ccv2.ServicePlanVisibility, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServicePlanVisibilityStub = nil
+	if fake.createServicePlanVisibilityReturnsOnCall == nil {
+		fake.createServicePlanVisibilityReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServicePlanVisibility
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createServicePlanVisibilityReturnsOnCall[i] = struct {
+		result1 ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -1170,6 +1887,111 @@ func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstance(serviceInstance ccv2.UserProvidedServiceInstance) (ccv2.UserProvidedServiceInstance, ccv2.Warnings, error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createUserProvidedServiceInstanceReturnsOnCall[len(fake.createUserProvidedServiceInstanceArgsForCall)]
+	fake.createUserProvidedServiceInstanceArgsForCall = append(fake.createUserProvidedServiceInstanceArgsForCall, struct {
+		serviceInstance ccv2.UserProvidedServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("CreateUserProvidedServiceInstance", []interface{}{serviceInstance})
+	fake.createUserProvidedServiceInstanceMutex.Unlock()
+	if fake.CreateUserProvidedServiceInstanceStub != nil {
+		return fake.CreateUserProvidedServiceInstanceStub(serviceInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createUserProvidedServiceInstanceReturns.result1, fake.createUserProvidedServiceInstanceReturns.result2, fake.createUserProvidedServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceCallCount() int {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.createUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceArgsForCall(i int) ccv2.UserProvidedServiceInstance {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.createUserProvidedServiceInstanceArgsForCall[i].serviceInstance
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceReturns(result1 ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	fake.createUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	if fake.createUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.createUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.UserProvidedServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplication(appGUID string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("DeleteApplication", []interface{}{appGUID})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganizationJob(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationJobMutex.Lock()
 	ret, specificReturn := fake.deleteOrganizationJobReturnsOnCall[len(fake.deleteOrganizationJobArgsForCall)]
@@ -1224,6 +2046,59 @@ func (fake *FakeCloudControllerClient) DeleteOrganizationJobReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRole(role constant.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationUserByRoleReturnsOnCall[len(fake.deleteOrganizationUserByRoleArgsForCall)]
+	fake.deleteOrganizationUserByRoleArgsForCall = append(fake.deleteOrganizationUserByRoleArgsForCall, struct {
+		role     constant.OrganizationRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("DeleteOrganizationUserByRole", []interface{}{role, orgGUID, username})
+	fake.deleteOrganizationUserByRoleMutex.Unlock()
+	if fake.DeleteOrganizationUserByRoleStub != nil {
+		return fake.DeleteOrganizationUserByRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteOrganizationUserByRoleReturns.result1, fake.deleteOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleCallCount() int {
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.deleteOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleArgsForCall(i int) (constant.OrganizationRole, string, string) {
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
+	return fake.deleteOrganizationUserByRoleArgsForCall[i].role, fake.deleteOrganizationUserByRoleArgsForCall[i].orgGUID, fake.deleteOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserByRoleStub = nil
+	fake.deleteOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserByRoleStub = nil
+	if fake.deleteOrganizationUserByRoleReturnsOnCall == nil {
+		fake.deleteOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
 	fake.deleteRouteMutex.Lock()
 	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
@@ -1482,6 +2357,162 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2, fake.deleteServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibility(servicePlanVisibilityGUID string) (ccv2.Warnings, error) {
+	fake.deleteServicePlanVisibilityMutex.Lock()
+	ret, specificReturn := fake.deleteServicePlanVisibilityReturnsOnCall[len(fake.deleteServicePlanVisibilityArgsForCall)]
+	fake.deleteServicePlanVisibilityArgsForCall = append(fake.deleteServicePlanVisibilityArgsForCall, struct {
+		servicePlanVisibilityGUID string
+	}{servicePlanVisibilityGUID})
+	fake.recordInvocation("DeleteServicePlanVisibility", []interface{}{servicePlanVisibilityGUID})
+	fake.deleteServicePlanVisibilityMutex.Unlock()
+	if fake.DeleteServicePlanVisibilityStub != nil {
+		return fake.DeleteServicePlanVisibilityStub(servicePlanVisibilityGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServicePlanVisibilityReturns.result1, fake.deleteServicePlanVisibilityReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityCallCount() int {
+	fake.deleteServicePlanVisibilityMutex.RLock()
+	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
+	return len(fake.deleteServicePlanVisibilityArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityArgsForCall(i int) string {
+	fake.deleteServicePlanVisibilityMutex.RLock()
+	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
+	return fake.deleteServicePlanVisibilityArgsForCall[i].servicePlanVisibilityGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServicePlanVisibilityStub = nil
+	fake.deleteServicePlanVisibilityReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServicePlanVisibilityStub = nil
+	if fake.deleteServicePlanVisibilityReturnsOnCall == nil {
+		fake.deleteServicePlanVisibilityReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServicePlanVisibilityReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpaceJob(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteSpaceJobMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceJobReturnsOnCall[len(fake.deleteSpaceJobArgsForCall)]
@@ -1536,6 +2567,110 @@ func (fake *FakeCloudControllerClient) DeleteSpaceJobReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRole(role constant.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.deleteSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceUserByRoleReturnsOnCall[len(fake.deleteSpaceUserByRoleArgsForCall)]
+	fake.deleteSpaceUserByRoleArgsForCall = append(fake.deleteSpaceUserByRoleArgsForCall, struct {
+		role      constant.SpaceRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("DeleteSpaceUserByRole", []interface{}{role, spaceGUID, username})
+	fake.deleteSpaceUserByRoleMutex.Unlock()
+	if fake.DeleteSpaceUserByRoleStub != nil {
+		return fake.DeleteSpaceUserByRoleStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteSpaceUserByRoleReturns.result1, fake.deleteSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleCallCount() int {
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	return len(fake.deleteSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleArgsForCall(i int) (constant.SpaceRole, string, string) {
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	return fake.deleteSpaceUserByRoleArgsForCall[i].role, fake.deleteSpaceUserByRoleArgsForCall[i].spaceGUID, fake.deleteSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserByRoleStub = nil
+	fake.deleteSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserByRoleStub = nil
+	if fake.deleteSpaceUserByRoleReturnsOnCall == nil {
+		fake.deleteSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstance(userProvidedServiceInstanceGUID string) (ccv2.Warnings, error) {
+	fake.deleteUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteUserProvidedServiceInstanceReturnsOnCall[len(fake.deleteUserProvidedServiceInstanceArgsForCall)]
+	fake.deleteUserProvidedServiceInstanceArgsForCall = append(fake.deleteUserProvidedServiceInstanceArgsForCall, struct {
+		userProvidedServiceInstanceGUID string
+	}{userProvidedServiceInstanceGUID})
+	fake.recordInvocation("DeleteUserProvidedServiceInstance", []interface{}{userProvidedServiceInstanceGUID})
+	fake.deleteUserProvidedServiceInstanceMutex.Unlock()
+	if fake.DeleteUserProvidedServiceInstanceStub != nil {
+		return fake.DeleteUserProvidedServiceInstanceStub(userProvidedServiceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteUserProvidedServiceInstanceReturns.result1, fake.deleteUserProvidedServiceInstanceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceCallCount() int {
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.deleteUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceArgsForCall(i int) string {
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.deleteUserProvidedServiceInstanceArgsForCall[i].userProvidedServiceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserProvidedServiceInstanceStub = nil
+	fake.deleteUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserProvidedServiceInstanceStub = nil
+	if fake.deleteUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.deleteUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DoesRouteExist(route ccv2.Route) (bool, ccv2.Warnings, error) {
 	fake.doesRouteExistMutex.Lock()
 	ret, specificReturn := fake.doesRouteExistReturnsOnCall[len(fake.doesRouteExistArgsForCall)]
@@ -1861,6 +2996,60 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetBuildpacks(filters ...ccv2.Filter) ([]ccv2.Buildpack, ccv2.Warnings, error) {
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetBuildpacks", []interface{}{filters})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv2.Filter {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Buildpack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetConfigFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error) {
 	fake.getConfigFeatureFlagsMutex.Lock()
 	ret, specificReturn := fake.getConfigFeatureFlagsReturnsOnCall[len(fake.getConfigFeatureFlagsArgsForCall)]
@@ -2124,6 +3313,115 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(filters ...ccv2.Filter) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{filters})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Filter {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(role constant.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    constant.OrganizationRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (constant.OrganizationRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -2557,6 +3855,60 @@ func (fake *FakeCloudControllerClient) GetServiceReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingParametersReturnsOnCall[len(fake.getServiceBindingParametersArgsForCall)]
+	fake.getServiceBindingParametersArgsForCall = append(fake.getServiceBindingParametersArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("GetServiceBindingParameters", []interface{}{serviceBindingGUID})
+	fake.getServiceBindingParametersMutex.Unlock()
+	if fake.GetServiceBindingParametersStub != nil {
+		return fake.GetServiceBindingParametersStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingParametersReturns.result1, fake.getServiceBindingParametersReturns.result2, fake.getServiceBindingParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersCallCount() int {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return len(fake.getServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersArgsForCall(i int) string {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return fake.getServiceBindingParametersArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	fake.getServiceBindingParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	if fake.getServiceBindingParametersReturnsOnCall == nil {
+		fake.getServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceBindings(filters ...ccv2.Filter) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.getServiceBindingsMutex.Lock()
 	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
@@ -2611,6 +3963,60 @@ func (fake *FakeCloudControllerClient) GetServiceBindingsReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceBrokers(filters ...ccv2.Filter) ([]ccv2.ServiceBroker, ccv2.Warnings, error) {
+	fake.getServiceBrokersMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokersReturnsOnCall[len(fake.getServiceBrokersArgsForCall)]
+	fake.getServiceBrokersArgsForCall = append(fake.getServiceBrokersArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetServiceBrokers", []interface{}{filters})
+	fake.getServiceBrokersMutex.Unlock()
+	if fake.GetServiceBrokersStub != nil {
+		return fake.GetServiceBrokersStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBrokersReturns.result1, fake.getServiceBrokersReturns.result2, fake.getServiceBrokersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersCallCount() int {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	return len(fake.getServiceBrokersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersArgsForCall(i int) []ccv2.Filter {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	return fake.getServiceBrokersArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersReturns(result1 []ccv2.ServiceBroker, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBrokersStub = nil
+	fake.getServiceBrokersReturns = struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersReturnsOnCall(i int, result1 []ccv2.ServiceBroker, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBrokersStub = nil
+	if fake.getServiceBrokersReturnsOnCall == nil {
+		fake.getServiceBrokersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceBroker
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokersReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
 	fake.getServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceReturnsOnCall[len(fake.getServiceInstanceArgsForCall)]
@@ -2719,6 +4125,61 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceServiceBindingsReturnsO
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeys(serviceInstanceGUID string, filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceInstanceServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceServiceKeysReturnsOnCall[len(fake.getServiceInstanceServiceKeysArgsForCall)]
+	fake.getServiceInstanceServiceKeysArgsForCall = append(fake.getServiceInstanceServiceKeysArgsForCall, struct {
+		serviceInstanceGUID string
+		filters             []ccv2.Filter
+	}{serviceInstanceGUID, filters})
+	fake.recordInvocation("GetServiceInstanceServiceKeys", []interface{}{serviceInstanceGUID, filters})
+	fake.getServiceInstanceServiceKeysMutex.Unlock()
+	if fake.GetServiceInstanceServiceKeysStub != nil {
+		return fake.GetServiceInstanceServiceKeysStub(serviceInstanceGUID, filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceServiceKeysReturns.result1, fake.getServiceInstanceServiceKeysReturns.result2, fake.getServiceInstanceServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysCallCount() int {
+	fake.getServiceInstanceServiceKeysMutex.RLock()
+	defer fake.getServiceInstanceServiceKeysMutex.RUnlock()
+	return len(fake.getServiceInstanceServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysArgsForCall(i int) (string, []ccv2.Filter) {
+	fake.getServiceInstanceServiceKeysMutex.RLock()
+	defer fake.getServiceInstanceServiceKeysMutex.RUnlock()
+	return fake.getServiceInstanceServiceKeysArgsForCall[i].serviceInstanceGUID, fake.getServiceInstanceServiceKeysArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceServiceKeysStub = nil
+	fake.getServiceInstanceServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceServiceKeysStub = nil
+	if fake.getServiceInstanceServiceKeysReturnsOnCall == nil {
+		fake.getServiceInstanceServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error) {
 	fake.getServiceInstanceSharedFromMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceSharedFromReturnsOnCall[len(fake.getServiceInstanceSharedFromArgsForCall)]
@@ -2881,6 +4342,60 @@ func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceKeyParameters(serviceKeyGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceKeyParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyParametersReturnsOnCall[len(fake.getServiceKeyParametersArgsForCall)]
+	fake.getServiceKeyParametersArgsForCall = append(fake.getServiceKeyParametersArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("GetServiceKeyParameters", []interface{}{serviceKeyGUID})
+	fake.getServiceKeyParametersMutex.Unlock()
+	if fake.GetServiceKeyParametersStub != nil {
+		return fake.GetServiceKeyParametersStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeyParametersReturns.result1, fake.getServiceKeyParametersReturns.result2, fake.getServiceKeyParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersCallCount() int {
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	return len(fake.getServiceKeyParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersArgsForCall(i int) string {
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	return fake.getServiceKeyParametersArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeyParametersStub = nil
+	fake.getServiceKeyParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeyParametersStub = nil
+	if fake.getServiceKeyParametersReturnsOnCall == nil {
+		fake.getServiceKeyParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeyParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
 	fake.getServicePlanMutex.Lock()
 	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
@@ -2935,6 +4450,168 @@ func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServicePlans(filters ...ccv2.Filter) ([]ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetServicePlans", []interface{}{filters})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlansReturns.result1, fake.getServicePlansReturns.result2, fake.getServicePlansReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv2.Filter {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return fake.getServicePlansArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilities(filters ...ccv2.Filter) ([]ccv2.ServicePlanVisibility, ccv2.Warnings, error) {
+	fake.getServicePlanVisibilitiesMutex.Lock()
+	ret, specificReturn := fake.getServicePlanVisibilitiesReturnsOnCall[len(fake.getServicePlanVisibilitiesArgsForCall)]
+	fake.getServicePlanVisibilitiesArgsForCall = append(fake.getServicePlanVisibilitiesArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetServicePlanVisibilities", []interface{}{filters})
+	fake.getServicePlanVisibilitiesMutex.Unlock()
+	if fake.GetServicePlanVisibilitiesStub != nil {
+		return fake.GetServicePlanVisibilitiesStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanVisibilitiesReturns.result1, fake.getServicePlanVisibilitiesReturns.result2, fake.getServicePlanVisibilitiesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesCallCount() int {
+	fake.getServicePlanVisibilitiesMutex.RLock()
+	defer fake.getServicePlanVisibilitiesMutex.RUnlock()
+	return len(fake.getServicePlanVisibilitiesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesArgsForCall(i int) []ccv2.Filter {
+	fake.getServicePlanVisibilitiesMutex.RLock()
+	defer fake.getServicePlanVisibilitiesMutex.RUnlock()
+	return fake.getServicePlanVisibilitiesArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesReturns(result1 []ccv2.ServicePlanVisibility, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanVisibilitiesStub = nil
+	fake.getServicePlanVisibilitiesReturns = struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesReturnsOnCall(i int, result1 []ccv2.ServicePlanVisibility, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanVisibilitiesStub = nil
+	if fake.getServicePlanVisibilitiesReturnsOnCall == nil {
+		fake.getServicePlanVisibilitiesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlanVisibility
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanVisibilitiesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServices(filters ...ccv2.Filter) ([]ccv2.Service, ccv2.Warnings, error) {
+	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
+	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetServices", []interface{}{filters})
+	fake.getServicesMutex.Unlock()
+	if fake.GetServicesStub != nil {
+		return fake.GetServicesStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicesReturns.result1, fake.getServicesReturns.result2, fake.getServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicesCallCount() int {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return len(fake.getServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicesArgsForCall(i int) []ccv2.Filter {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return fake.getServicesArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	fake.getServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
 	fake.getSharedDomainMutex.Lock()
 	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
@@ -3097,6 +4774,60 @@ func (fake *FakeCloudControllerClient) GetSpaceQuotaDefinitionReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotasReturnsOnCall[len(fake.getSpaceQuotasArgsForCall)]
+	fake.getSpaceQuotasArgsForCall = append(fake.getSpaceQuotasArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetSpaceQuotas", []interface{}{orgGUID})
+	fake.getSpaceQuotasMutex.Unlock()
+	if fake.GetSpaceQuotasStub != nil {
+		return fake.GetSpaceQuotasStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotasReturns.result1, fake.getSpaceQuotasReturns.result2, fake.getSpaceQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCallCount() int {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return len(fake.getSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasArgsForCall(i int) string {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return fake.getSpaceQuotasArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturns(result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	fake.getSpaceQuotasReturns = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	if fake.getSpaceQuotasReturnsOnCall == nil {
+		fake.getSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceRoutes(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
 	fake.getSpaceRoutesMutex.Lock()
 	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
@@ -3263,6 +4994,61 @@ func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceServices(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Service, ccv2.Warnings, error) {
+	fake.getSpaceServicesMutex.Lock()
+	ret, specificReturn := fake.getSpaceServicesReturnsOnCall[len(fake.getSpaceServicesArgsForCall)]
+	fake.getSpaceServicesArgsForCall = append(fake.getSpaceServicesArgsForCall, struct {
+		spaceGUID string
+		filters   []ccv2.Filter
+	}{spaceGUID, filters})
+	fake.recordInvocation("GetSpaceServices", []interface{}{spaceGUID, filters})
+	fake.getSpaceServicesMutex.Unlock()
+	if fake.GetSpaceServicesStub != nil {
+		return fake.GetSpaceServicesStub(spaceGUID, filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceServicesReturns.result1, fake.getSpaceServicesReturns.result2, fake.getSpaceServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesCallCount() int {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return len(fake.getSpaceServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesArgsForCall(i int) (string, []ccv2.Filter) {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return fake.getSpaceServicesArgsForCall[i].spaceGUID, fake.getSpaceServicesArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	fake.getSpaceServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	if fake.getSpaceServicesReturnsOnCall == nil {
+		fake.getSpaceServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroups(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
 	fake.getSpaceStagingSecurityGroupsMutex.Lock()
 	ret, specificReturn := fake.getSpaceStagingSecurityGroupsReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsArgsForCall)]
@@ -3318,6 +5104,61 @@ func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      constant.SpaceRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (constant.SpaceRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -3480,6 +5321,61 @@ func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetUserOrganizationsByRole(role constant.OrganizationRole, userGUID string) ([]ccv2.Organization, ccv2.Warnings, error) {
+	fake.getUserOrganizationsByRoleMutex.Lock()
+	ret, specificReturn := fake.getUserOrganizationsByRoleReturnsOnCall[len(fake.getUserOrganizationsByRoleArgsForCall)]
+	fake.getUserOrganizationsByRoleArgsForCall = append(fake.getUserOrganizationsByRoleArgsForCall, struct {
+		role     constant.OrganizationRole
+		userGUID string
+	}{role, userGUID})
+	fake.recordInvocation("GetUserOrganizationsByRole", []interface{}{role, userGUID})
+	fake.getUserOrganizationsByRoleMutex.Unlock()
+	if fake.GetUserOrganizationsByRoleStub != nil {
+		return fake.GetUserOrganizationsByRoleStub(role, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUserOrganizationsByRoleReturns.result1, fake.getUserOrganizationsByRoleReturns.result2, fake.getUserOrganizationsByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUserOrganizationsByRoleCallCount() int {
+	fake.getUserOrganizationsByRoleMutex.RLock()
+	defer fake.getUserOrganizationsByRoleMutex.RUnlock()
+	return len(fake.getUserOrganizationsByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUserOrganizationsByRoleArgsForCall(i int) (constant.OrganizationRole, string) {
+	fake.getUserOrganizationsByRoleMutex.RLock()
+	defer fake.getUserOrganizationsByRoleMutex.RUnlock()
+	return fake.getUserOrganizationsByRoleArgsForCall[i].role, fake.getUserOrganizationsByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) GetUserOrganizationsByRoleReturns(result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserOrganizationsByRoleStub = nil
+	fake.getUserOrganizationsByRoleReturns = struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserOrganizationsByRoleReturnsOnCall(i int, result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserOrganizationsByRoleStub = nil
+	if fake.getUserOrganizationsByRoleReturnsOnCall == nil {
+		fake.getUserOrganizationsByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getUserOrganizationsByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceServiceBindings(userProvidedServiceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.getUserProvidedServiceInstanceServiceBindingsMutex.Lock()
 	ret, specificReturn := fake.getUserProvidedServiceInstanceServiceBindingsReturnsOnCall[len(fake.getUserProvidedServiceInstanceServiceBindingsArgsForCall)]
@@ -3534,6 +5430,115 @@ func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceServiceBind
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstances(filters ...ccv2.Filter) ([]ccv2.UserProvidedServiceInstance, ccv2.Warnings, error) {
+	fake.getUserProvidedServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getUserProvidedServiceInstancesReturnsOnCall[len(fake.getUserProvidedServiceInstancesArgsForCall)]
+	fake.getUserProvidedServiceInstancesArgsForCall = append(fake.getUserProvidedServiceInstancesArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetUserProvidedServiceInstances", []interface{}{filters})
+	fake.getUserProvidedServiceInstancesMutex.Unlock()
+	if fake.GetUserProvidedServiceInstancesStub != nil {
+		return fake.GetUserProvidedServiceInstancesStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUserProvidedServiceInstancesReturns.result1, fake.getUserProvidedServiceInstancesReturns.result2, fake.getUserProvidedServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstancesCallCount() int {
+	fake.getUserProvidedServiceInstancesMutex.RLock()
+	defer fake.getUserProvidedServiceInstancesMutex.RUnlock()
+	return len(fake.getUserProvidedServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstancesArgsForCall(i int) []ccv2.Filter {
+	fake.getUserProvidedServiceInstancesMutex.RLock()
+	defer fake.getUserProvidedServiceInstancesMutex.RUnlock()
+	return fake.getUserProvidedServiceInstancesArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstancesReturns(result1 []ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserProvidedServiceInstancesStub = nil
+	fake.getUserProvidedServiceInstancesReturns = struct {
+		result1 []ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstancesReturnsOnCall(i int, result1 []ccv2.UserProvidedServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserProvidedServiceInstancesStub = nil
+	if fake.getUserProvidedServiceInstancesReturnsOnCall == nil {
+		fake.getUserProvidedServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.UserProvidedServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getUserProvidedServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv2.UserProvidedServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesByRole(role constant.SpaceRole, userGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getUserSpacesByRoleMutex.Lock()
+	ret, specificReturn := fake.getUserSpacesByRoleReturnsOnCall[len(fake.getUserSpacesByRoleArgsForCall)]
+	fake.getUserSpacesByRoleArgsForCall = append(fake.getUserSpacesByRoleArgsForCall, struct {
+		role     constant.SpaceRole
+		userGUID string
+	}{role, userGUID})
+	fake.recordInvocation("GetUserSpacesByRole", []interface{}{role, userGUID})
+	fake.getUserSpacesByRoleMutex.Unlock()
+	if fake.GetUserSpacesByRoleStub != nil {
+		return fake.GetUserSpacesByRoleStub(role, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUserSpacesByRoleReturns.result1, fake.getUserSpacesByRoleReturns.result2, fake.getUserSpacesByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesByRoleCallCount() int {
+	fake.getUserSpacesByRoleMutex.RLock()
+	defer fake.getUserSpacesByRoleMutex.RUnlock()
+	return len(fake.getUserSpacesByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesByRoleArgsForCall(i int) (constant.SpaceRole, string) {
+	fake.getUserSpacesByRoleMutex.RLock()
+	defer fake.getUserSpacesByRoleMutex.RUnlock()
+	return fake.getUserSpacesByRoleArgsForCall[i].role, fake.getUserSpacesByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesByRoleReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserSpacesByRoleStub = nil
+	fake.getUserSpacesByRoleReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesByRoleReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetUserSpacesByRoleStub = nil
+	if fake.getUserSpacesByRoleReturnsOnCall == nil {
+		fake.getUserSpacesByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getUserSpacesByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -3639,6 +5644,58 @@ func (fake *FakeCloudControllerClient) RestageApplicationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) SetSpaceQuota(spaceGUID string, quotaGUID string) (ccv2.Warnings, error) {
+	fake.setSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.setSpaceQuotaReturnsOnCall[len(fake.setSpaceQuotaArgsForCall)]
+	fake.setSpaceQuotaArgsForCall = append(fake.setSpaceQuotaArgsForCall, struct {
+		spaceGUID string
+		quotaGUID string
+	}{spaceGUID, quotaGUID})
+	fake.recordInvocation("SetSpaceQuota", []interface{}{spaceGUID, quotaGUID})
+	fake.setSpaceQuotaMutex.Unlock()
+	if fake.SetSpaceQuotaStub != nil {
+		return fake.SetSpaceQuotaStub(spaceGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setSpaceQuotaReturns.result1, fake.setSpaceQuotaReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaCallCount() int {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return len(fake.setSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaArgsForCall(i int) (string, string) {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return fake.setSpaceQuotaArgsForCall[i].spaceGUID, fake.setSpaceQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaReturns(result1 ccv2.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	fake.setSpaceQuotaReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) SetSpaceQuotaReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	if fake.setSpaceQuotaReturnsOnCall == nil {
+		fake.setSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.setSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
 	fake.targetCFMutex.Lock()
 	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
@@ -3744,6 +5801,111 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgGUID   string
+		quotaGUID string
+	}{orgGUID, quotaGUID})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgGUID, quotaGUID})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgGUID, fake.updateOrganizationQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRole(role constant.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleReturnsOnCall[len(fake.updateOrganizationUserByRoleArgsForCall)]
+	fake.updateOrganizationUserByRoleArgsForCall = append(fake.updateOrganizationUserByRoleArgsForCall, struct {
+		role     constant.OrganizationRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserByRole", []interface{}{role, orgGUID, username})
+	fake.updateOrganizationUserByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleStub != nil {
+		return fake.UpdateOrganizationUserByRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserByRoleReturns.result1, fake.updateOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCallCount() int {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleArgsForCall(i int) (constant.OrganizationRole, string, string) {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return fake.updateOrganizationUserByRoleArgsForCall[i].role, fake.updateOrganizationUserByRoleArgsForCall[i].orgGUID, fake.updateOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	fake.updateOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	if fake.updateOrganizationUserByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error) {
 	var resourcesToMatchCopy []ccv2.Resource
 	if resourcesToMatch != nil {
@@ -3962,6 +6124,111 @@ func (fake *FakeCloudControllerClient) UpdateSecurityGroupStagingSpaceReturnsOnC
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateServicePlan(servicePlanGUID string, public bool) (ccv2.Warnings, error) {
+	fake.updateServicePlanMutex.Lock()
+	ret, specificReturn := fake.updateServicePlanReturnsOnCall[len(fake.updateServicePlanArgsForCall)]
+	fake.updateServicePlanArgsForCall = append(fake.updateServicePlanArgsForCall, struct {
+		servicePlanGUID string
+		public          bool
+	}{servicePlanGUID, public})
+	fake.recordInvocation("UpdateServicePlan", []interface{}{servicePlanGUID, public})
+	fake.updateServicePlanMutex.Unlock()
+	if fake.UpdateServicePlanStub != nil {
+		return fake.UpdateServicePlanStub(servicePlanGUID, public)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateServicePlanReturns.result1, fake.updateServicePlanReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanCallCount() int {
+	fake.updateServicePlanMutex.RLock()
+	defer fake.updateServicePlanMutex.RUnlock()
+	return len(fake.updateServicePlanArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanArgsForCall(i int) (string, bool) {
+	fake.updateServicePlanMutex.RLock()
+	defer fake.updateServicePlanMutex.RUnlock()
+	return fake.updateServicePlanArgsForCall[i].servicePlanGUID, fake.updateServicePlanArgsForCall[i].public
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateServicePlanStub = nil
+	fake.updateServicePlanReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateServicePlanStub = nil
+	if fake.updateServicePlanReturnsOnCall == nil {
+		fake.updateServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateServicePlanReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRole(role constant.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleReturnsOnCall[len(fake.updateSpaceUserByRoleArgsForCall)]
+	fake.updateSpaceUserByRoleArgsForCall = append(fake.updateSpaceUserByRoleArgsForCall, struct {
+		role      constant.SpaceRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceUserByRole", []interface{}{role, spaceGUID, username})
+	fake.updateSpaceUserByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleStub != nil {
+		return fake.UpdateSpaceUserByRoleStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserByRoleReturns.result1, fake.updateSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCallCount() int {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleArgsForCall(i int) (constant.SpaceRole, string, string) {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return fake.updateSpaceUserByRoleArgsForCall[i].role, fake.updateSpaceUserByRoleArgsForCall[i].spaceGUID, fake.updateSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	fake.updateSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	if fake.updateSpaceUserByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error) {
 	var existingResourcesCopy []ccv2.Resource
 	if existingResources != nil {
@@ -4365,14 +6632,28 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.invocationsMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	fake.createServicePlanVisibilityMutex.RLock()
+	defer fake.createServicePlanVisibilityMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteOrganizationJobMutex.RLock()
 	defer fake.deleteOrganizationJobMutex.RUnlock()
+	fake.deleteOrganizationUserByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserByRoleMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteRouteApplicationMutex.RLock()
@@ -4383,8 +6664,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteSecurityGroupStagingSpaceMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	fake.deleteServicePlanVisibilityMutex.RLock()
+	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
 	fake.deleteSpaceJobMutex.RLock()
 	defer fake.deleteSpaceJobMutex.RUnlock()
+	fake.deleteSpaceUserByRoleMutex.RLock()
+	defer fake.deleteSpaceUserByRoleMutex.RUnlock()
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
 	fake.doesRouteExistMutex.RLock()
 	defer fake.doesRouteExistMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
@@ -4397,6 +6688,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getConfigFeatureFlagsMutex.RLock()
 	defer fake.getConfigFeatureFlagsMutex.RUnlock()
 	fake.getJobMutex.RLock()
@@ -4407,6 +6700,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
@@ -4423,50 +6720,82 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
 	fake.getServiceInstanceMutex.RLock()
 	defer fake.getServiceInstanceMutex.RUnlock()
 	fake.getServiceInstanceServiceBindingsMutex.RLock()
 	defer fake.getServiceInstanceServiceBindingsMutex.RUnlock()
+	fake.getServiceInstanceServiceKeysMutex.RLock()
+	defer fake.getServiceInstanceServiceKeysMutex.RUnlock()
 	fake.getServiceInstanceSharedFromMutex.RLock()
 	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
 	fake.getServiceInstanceSharedTosMutex.RLock()
 	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
 	fake.getServicePlanMutex.RLock()
 	defer fake.getServicePlanMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	fake.getServicePlanVisibilitiesMutex.RLock()
+	defer fake.getServicePlanVisibilitiesMutex.RUnlock()
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
 	fake.getSharedDomainMutex.RLock()
 	defer fake.getSharedDomainMutex.RUnlock()
 	fake.getSharedDomainsMutex.RLock()
 	defer fake.getSharedDomainsMutex.RUnlock()
 	fake.getSpaceQuotaDefinitionMutex.RLock()
 	defer fake.getSpaceQuotaDefinitionMutex.RUnlock()
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
 	fake.getSpaceRoutesMutex.RLock()
 	defer fake.getSpaceRoutesMutex.RUnlock()
 	fake.getSpaceSecurityGroupsMutex.RLock()
 	defer fake.getSpaceSecurityGroupsMutex.RUnlock()
 	fake.getSpaceServiceInstancesMutex.RLock()
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
+	fake.getUserOrganizationsByRoleMutex.RLock()
+	defer fake.getUserOrganizationsByRoleMutex.RUnlock()
 	fake.getUserProvidedServiceInstanceServiceBindingsMutex.RLock()
 	defer fake.getUserProvidedServiceInstanceServiceBindingsMutex.RUnlock()
+	fake.getUserProvidedServiceInstancesMutex.RLock()
+	defer fake.getUserProvidedServiceInstancesMutex.RUnlock()
+	fake.getUserSpacesByRoleMutex.RLock()
+	defer fake.getUserSpacesByRoleMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
 	defer fake.restageApplicationMutex.RUnlock()
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
 	fake.targetCFMutex.RLock()
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
 	fake.updateResourceMatchMutex.RLock()
 	defer fake.updateResourceMatchMutex.RUnlock()
 	fake.updateRouteApplicationMutex.RLock()
@@ -4475,6 +6804,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateSecurityGroupSpaceMutex.RUnlock()
 	fake.updateSecurityGroupStagingSpaceMutex.RLock()
 	defer fake.updateSecurityGroupStagingSpaceMutex.RUnlock()
+	fake.updateServicePlanMutex.RLock()
+	defer fake.updateServicePlanMutex.RUnlock()
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.uploadDropletMutex.RLock()
@@ -3,17 +3,20 @@ package v3action
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 //go:generate counterfeiter . ManifestParser
 
 type ManifestParser interface {
 	AppNames() []string
+	Processes(appName string) []manifestparser.Process
 	RawManifest(name string) ([]byte, error)
 }
 
 // ApplyApplicationManifest reads in the manifest from the path and provides it
-// to the cloud controller.
+// to the cloud controller, then applies the settings of the processes listed
+// for each application.
 func (actor Actor) ApplyApplicationManifest(parser ManifestParser, spaceGUID string) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -44,6 +47,12 @@ func (actor Actor) ApplyApplicationManifest(parser ManifestParser, spaceGUID str
 			}
 			return allWarnings, err
 		}
+
+		processWarnings, err := actor.ApplyApplicationProcesses(app.GUID, parser.Processes(appName))
+		allWarnings = append(allWarnings, processWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
 	}
 
 	return allWarnings, nil
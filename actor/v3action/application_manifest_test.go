@@ -8,6 +8,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -105,6 +107,26 @@ var _ = Describe("Application Manifest Actions", func() {
 								jobURL := fakeCloudControllerClient.PollJobArgsForCall(0)
 								Expect(jobURL).To(Equal(ccv3.JobURL("some-job-url")))
 							})
+
+							Context("when the app has processes", func() {
+								BeforeEach(func() {
+									instances := 3
+									fakeParser.ProcessesReturns([]manifestparser.Process{{Type: "worker", Instances: &instances}})
+									fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, nil)
+								})
+
+								It("applies the process settings after the manifest", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(warnings).To(Equal(Warnings{"app-1-warning", "apply-manifest-1-warning", "poll-1-warning", "scale-warning"}))
+
+									Expect(fakeParser.ProcessesArgsForCall(0)).To(Equal("app-1"))
+									Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(1))
+									appGUID, process := fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(0)
+									Expect(appGUID).To(Equal("app-1-guid"))
+									Expect(process.Type).To(Equal("worker"))
+									Expect(process.Instances).To(Equal(types.NullInt{Value: 3, IsSet: true}))
+								})
+							})
 						})
 
 						Context("when polling returns a generic error", func() {
@@ -0,0 +1,109 @@
+package v3action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// ApplicationSettings are the settings of an application that are carried
+// over when it is promoted to another space or foundation.
+type ApplicationSettings struct {
+	// EnvironmentVariables are the user provided environment variables.
+	EnvironmentVariables map[string]string
+	// Processes are the scale and health check settings of each process type.
+	Processes []Process
+}
+
+// GetApplicationSettings returns the user provided environment variables of
+// the application and the scale and health check settings of its processes.
+func (actor Actor) GetApplicationSettings(appGUID string) (ApplicationSettings, Warnings, error) {
+	env, warnings, err := actor.CloudControllerClient.GetApplicationEnvironment(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return ApplicationSettings{}, allWarnings, err
+	}
+
+	settings := ApplicationSettings{EnvironmentVariables: map[string]string{}}
+	for name, value := range env.EnvironmentVariables {
+		settings.EnvironmentVariables[name] = fmt.Sprint(value)
+	}
+
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationSettings{}, allWarnings, err
+	}
+
+	for _, process := range processes {
+		settings.Processes = append(settings.Processes, Process(process))
+	}
+
+	return settings, allWarnings, nil
+}
+
+// ApplyApplicationSettings sets the environment variables of the application
+// and scales and updates the health checks of its processes to match
+// settings. The process types in settings that the application does not have
+// are skipped and returned.
+func (actor Actor) ApplyApplicationSettings(appGUID string, settings ApplicationSettings) ([]string, Warnings, error) {
+	var allWarnings Warnings
+
+	if len(settings.EnvironmentVariables) > 0 {
+		envVars := ccv3.EnvironmentVariables{}
+		for name, value := range settings.EnvironmentVariables {
+			envVars[name] = types.FilteredString{Value: value, IsSet: true}
+		}
+
+		_, warnings, err := actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(appGUID, envVars)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processGUIDs := map[string]string{}
+	for _, process := range processes {
+		processGUIDs[process.Type] = process.GUID
+	}
+
+	var skippedProcessTypes []string
+	for _, process := range settings.Processes {
+		processGUID, ok := processGUIDs[process.Type]
+		if !ok {
+			skippedProcessTypes = append(skippedProcessTypes, process.Type)
+			continue
+		}
+
+		_, warnings, err = actor.CloudControllerClient.CreateApplicationProcessScale(appGUID, ccv3.Process{
+			Type:       process.Type,
+			Instances:  process.Instances,
+			MemoryInMB: process.MemoryInMB,
+			DiskInMB:   process.DiskInMB,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		_, warnings, err = actor.CloudControllerClient.UpdateProcess(ccv3.Process{
+			GUID:                processGUID,
+			HealthCheckType:     process.HealthCheckType,
+			HealthCheckEndpoint: process.HealthCheckEndpoint,
+			HealthCheckTimeout:  process.HealthCheckTimeout,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	return skippedProcessTypes, allWarnings, nil
+}
@@ -0,0 +1,177 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Settings Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationSettings", func() {
+		var (
+			settings   ApplicationSettings
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			settings, warnings, executeErr = actor.GetApplicationSettings("some-app-guid")
+		})
+
+		Context("when getting the environment and processes succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{
+					EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"},
+				}, ccv3.Warnings{"env-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{
+					{
+						GUID:            "some-process-guid",
+						Type:            "web",
+						Instances:       types.NullInt{Value: 2, IsSet: true},
+						HealthCheckType: "http",
+					},
+				}, ccv3.Warnings{"processes-warning"}, nil)
+			})
+
+			It("returns the environment variables and processes of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("env-warning", "processes-warning"))
+				Expect(settings).To(Equal(ApplicationSettings{
+					EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+					Processes: []Process{
+						{
+							GUID:            "some-process-guid",
+							Type:            "web",
+							Instances:       types.NullInt{Value: 2, IsSet: true},
+							HealthCheckType: "http",
+						},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the environment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"env-warning"}, errors.New("env-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("env-error"))
+				Expect(warnings).To(ConsistOf("env-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ApplyApplicationSettings", func() {
+		var (
+			settings            ApplicationSettings
+			skippedProcessTypes []string
+			warnings            Warnings
+			executeErr          error
+		)
+
+		BeforeEach(func() {
+			settings = ApplicationSettings{
+				EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+				Processes: []Process{
+					{
+						Type:                "web",
+						Instances:           types.NullInt{Value: 2, IsSet: true},
+						MemoryInMB:          types.NullUint64{Value: 256, IsSet: true},
+						HealthCheckType:     "http",
+						HealthCheckEndpoint: "/health",
+					},
+					{
+						Type:      "worker",
+						Instances: types.NullInt{Value: 1, IsSet: true},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"env-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{
+				{GUID: "web-process-guid", Type: "web"},
+			}, ccv3.Warnings{"processes-warning"}, nil)
+			fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, nil)
+			fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			skippedProcessTypes, warnings, executeErr = actor.ApplyApplicationSettings("some-app-guid", settings)
+		})
+
+		It("sets the environment variables and updates the processes the app has", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("env-warning", "processes-warning", "scale-warning", "update-warning"))
+
+			appGUID, envVars := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(envVars).To(Equal(ccv3.EnvironmentVariables{
+				"SOME_VAR": {Value: "some-value", IsSet: true},
+			}))
+
+			Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(1))
+			appGUID, scale := fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(scale).To(Equal(ccv3.Process{
+				Type:       "web",
+				Instances:  types.NullInt{Value: 2, IsSet: true},
+				MemoryInMB: types.NullUint64{Value: 256, IsSet: true},
+			}))
+
+			Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+				GUID:                "web-process-guid",
+				HealthCheckType:     "http",
+				HealthCheckEndpoint: "/health",
+			}))
+		})
+
+		It("returns the process types the app does not have", func() {
+			Expect(skippedProcessTypes).To(ConsistOf("worker"))
+		})
+
+		Context("when there are no environment variables", func() {
+			BeforeEach(func() {
+				settings.EnvironmentVariables = map[string]string{}
+			})
+
+			It("does not update the environment variables", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when scaling a process fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, errors.New("scale-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("scale-error"))
+				Expect(warnings).To(ConsistOf("env-warning", "processes-warning", "scale-warning"))
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v3action
 
 import (
+	"io"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
@@ -12,7 +14,10 @@ type CloudControllerClient interface {
 	AppSSHHostKeyFingerprint() string
 	AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
+	CopyDroplet(dropletGUID string, appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	CreateApplicationDeployment(appGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	CreateApplicationDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
@@ -20,8 +25,12 @@ type CloudControllerClient interface {
 	CreatePackage(pkg ccv3.Package) (ccv3.Package, ccv3.Warnings, error)
 	DeleteApplication(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
+	DeleteDroplet(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
+	DeletePackage(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error)
+	DownloadDroplet(dropletGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error)
+	DownloadPackage(packageGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	GetApplicationDropletCurrent(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	GetApplicationEnvironment(appGUID string) (ccv3.Environment, ccv3.Warnings, error)
@@ -30,6 +39,7 @@ type CloudControllerClient interface {
 	GetApplications(query ...ccv3.Query) ([]ccv3.Application, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error)
 	GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error)
+	GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
 	GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	GetDroplets(query ...ccv3.Query) ([]ccv3.Droplet, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
@@ -54,6 +64,8 @@ type CloudControllerClient interface {
 	UpdateApplicationEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
 	UpdateApplicationStart(appGUID string) (ccv3.Application, ccv3.Warnings, error)
 	UpdateApplicationStop(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	UploadDropletBits(dropletGUID string, pathToDroplet string) (ccv3.JobURL, ccv3.Warnings, error)
 	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 }
@@ -0,0 +1,40 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// CreateDeployment starts a rolling deployment of the application's current
+// droplet and returns the deployment GUID.
+func (actor Actor) CreateDeployment(appGUID string) (string, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID)
+	return deployment.GUID, Warnings(warnings), err
+}
+
+// PollDeployment waits until the deployment has replaced all of the
+// application's instances. It returns a StartupTimeoutError if the deployment
+// does not finish within the startup timeout.
+func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<- Warnings) error {
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		switch deployment.State {
+		case constant.DeploymentDeployed:
+			return nil
+		case constant.DeploymentCanceling, constant.DeploymentCanceled:
+			return actionerror.DeploymentCanceledError{DeploymentGUID: deploymentGUID}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return actionerror.StartupTimeoutError{}
+}
@@ -0,0 +1,121 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+	})
+
+	Describe("CreateDeployment", func() {
+		It("creates a deployment of the app and returns its GUID", func() {
+			fakeCloudControllerClient.CreateApplicationDeploymentReturns(ccv3.Deployment{GUID: "some-deployment-guid"}, ccv3.Warnings{"create-warning"}, nil)
+
+			deploymentGUID, warnings, err := actor.CreateDeployment("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			warningsChannel chan Warnings
+			allWarnings     Warnings
+			funcDone        chan interface{}
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+
+			warningsChannel = make(chan Warnings)
+			funcDone = make(chan interface{})
+			allWarnings = Warnings{}
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.PollDeployment("some-deployment-guid", warningsChannel)
+			funcDone <- nil
+		})
+
+		Context("when the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0, ccv3.Deployment{State: constant.DeploymentDeploying}, ccv3.Warnings{"get-warning-1"}, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: constant.DeploymentDeployed}, ccv3.Warnings{"get-warning-2"}, nil)
+			})
+
+			It("polls until the deployment is deployed", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(allWarnings).To(ConsistOf("get-warning-1", "get-warning-2"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: constant.DeploymentCanceled}, nil, nil)
+			})
+
+			It("returns a DeploymentCanceledError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DeploymentCanceledError{DeploymentGUID: "some-deployment-guid"}))
+			})
+		})
+
+		Context("when the deployment does not finish in time", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(time.Millisecond)
+				fakeConfig.PollingIntervalReturns(2 * time.Millisecond)
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: constant.DeploymentDeploying}, nil, nil)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{}))
+			})
+		})
+
+		Context("when getting the deployment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{}, ccv3.Warnings{"get-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-error"))
+				Expect(allWarnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+})
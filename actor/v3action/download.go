@@ -0,0 +1,68 @@
+package v3action
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+//go:generate counterfeiter . ProgressBar
+
+// ProgressBar displays the progress of reading a file.
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
+
+// downloadToFile writes the bits provided by download to pathToFile, verifying
+// them against the checksum when its type is known. The file is only created
+// once the bits are downloaded and verified.
+func downloadToFile(pathToFile string, checksum ccv3.Checksum, progressBar ProgressBar, download func(io.Writer, func(io.Reader, int64) io.Reader) (ccv3.Warnings, error)) (Warnings, error) {
+	tempFile, err := ioutil.TempFile(filepath.Dir(pathToFile), filepath.Base(pathToFile)+".download-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tempFile.Name())
+
+	var hasher hash.Hash
+	switch checksum.Type {
+	case "sha256":
+		hasher = sha256.New()
+	case "sha1":
+		hasher = sha1.New()
+	}
+
+	var destination io.Writer = tempFile
+	if hasher != nil {
+		destination = io.MultiWriter(tempFile, hasher)
+	}
+
+	var wrapDownload func(io.Reader, int64) io.Reader
+	if progressBar != nil {
+		wrapDownload = progressBar.NewProgressBarWrapper
+	}
+
+	warnings, err := download(destination, wrapDownload)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return Warnings(warnings), err
+	}
+
+	if hasher != nil && checksum.Value != "" {
+		actual := hex.EncodeToString(hasher.Sum(nil))
+		if actual != checksum.Value {
+			return Warnings(warnings), actionerror.ChecksumMismatchError{Path: pathToFile, Expected: checksum.Value, Actual: actual}
+		}
+	}
+
+	return Warnings(warnings), os.Rename(tempFile.Name(), pathToFile)
+}
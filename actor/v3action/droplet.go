@@ -1,6 +1,9 @@
 package v3action
 
 import (
+	"io"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -9,12 +12,14 @@ import (
 
 // Droplet represents a Cloud Controller droplet.
 type Droplet struct {
-	GUID       string
-	State      constant.DropletState
-	CreatedAt  string
-	Stack      string
-	Image      string
-	Buildpacks []Buildpack
+	GUID         string
+	State        constant.DropletState
+	CreatedAt    string
+	Stack        string
+	Image        string
+	Buildpacks   []Buildpack
+	ProcessTypes map[string]string
+	PackageGUID  string
 }
 
 type Buildpack ccv3.DropletBuildpack
@@ -64,6 +69,95 @@ func (actor Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]D
 	return droplets, allWarnings, err
 }
 
+// DownloadApplicationDroplet writes the bits of the droplet with the given
+// GUID, or of the current droplet of the application when dropletGUID is
+// empty, to pathToFile after verifying their checksum.
+func (actor Actor) DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, pathToFile string, progressBar ProgressBar) (Droplet, Warnings, error) {
+	application, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	var (
+		droplet  ccv3.Droplet
+		warnings ccv3.Warnings
+	)
+	if dropletGUID == "" {
+		droplet, warnings, err = actor.CloudControllerClient.GetApplicationDropletCurrent(application.GUID)
+	} else {
+		droplet, warnings, err = actor.CloudControllerClient.GetDroplet(dropletGUID)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.DropletNotFoundError); ok {
+		return Droplet{}, allWarnings, actionerror.DropletNotFoundError{AppGUID: application.GUID}
+	} else if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	downloadWarnings, err := downloadToFile(pathToFile, droplet.Checksum, progressBar, func(destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error) {
+		return actor.CloudControllerClient.DownloadDroplet(droplet.GUID, destination, wrapDownload)
+	})
+	allWarnings = append(allWarnings, downloadWarnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	return actor.convertCCToActorDroplet(droplet), allWarnings, nil
+}
+
+// UploadDroplet creates a droplet for the application from the droplet file
+// at pathToDroplet, without staging a package.
+func (actor Actor) UploadDroplet(appGUID string, pathToDroplet string) (Droplet, Warnings, error) {
+	droplet, warnings, err := actor.CloudControllerClient.CreateApplicationDroplet(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.UploadDropletBits(droplet.GUID, pathToDroplet)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	return actor.convertCCToActorDroplet(droplet), allWarnings, nil
+}
+
+// CopyDroplet copies the droplet with the given GUID to the application and
+// waits for the copy to be staged.
+func (actor Actor) CopyDroplet(dropletGUID string, appGUID string) (Droplet, Warnings, error) {
+	droplet, warnings, err := actor.CloudControllerClient.CopyDroplet(dropletGUID, appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	timeout := time.Now().Add(actor.Config.StagingTimeout())
+	for time.Now().Before(timeout) {
+		switch droplet.State {
+		case constant.DropletStaged:
+			return actor.convertCCToActorDroplet(droplet), allWarnings, nil
+		case constant.DropletFailed, constant.DropletExpired:
+			return Droplet{}, allWarnings, actionerror.DropletCopyFailedError{DropletGUID: dropletGUID}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+		droplet, warnings, err = actor.CloudControllerClient.GetDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Droplet{}, allWarnings, err
+		}
+	}
+
+	return Droplet{}, allWarnings, actionerror.DropletCopyTimeoutError{DropletGUID: dropletGUID, Timeout: actor.Config.StagingTimeout()}
+}
+
 func (actor Actor) GetCurrentDropletByApplication(appGUID string) (Droplet, Warnings, error) {
 	droplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(appGUID)
 	switch err.(type) {
@@ -82,11 +176,13 @@ func (actor Actor) convertCCToActorDroplet(ccDroplet ccv3.Droplet) Droplet {
 	}
 
 	return Droplet{
-		GUID:       ccDroplet.GUID,
-		State:      constant.DropletState(ccDroplet.State),
-		CreatedAt:  ccDroplet.CreatedAt,
-		Stack:      ccDroplet.Stack,
-		Buildpacks: buildpacks,
-		Image:      ccDroplet.Image,
+		GUID:         ccDroplet.GUID,
+		State:        constant.DropletState(ccDroplet.State),
+		CreatedAt:    ccDroplet.CreatedAt,
+		Stack:        ccDroplet.Stack,
+		Buildpacks:   buildpacks,
+		Image:        ccDroplet.Image,
+		ProcessTypes: ccDroplet.ProcessTypes,
+		PackageGUID:  ccDroplet.PackageGUID,
 	}
 }
@@ -1,7 +1,15 @@
 package v3action_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v3action"
@@ -330,4 +338,278 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+
+	Describe("DownloadApplicationDroplet", func() {
+		var (
+			dropletGUID     string
+			tempDir         string
+			pathToFile      string
+			fakeProgressBar *v3actionfakes.FakeProgressBar
+
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "download-droplet-test")
+			Expect(err).ToNot(HaveOccurred())
+			pathToFile = filepath.Join(tempDir, "droplet.tgz")
+			dropletGUID = ""
+			fakeProgressBar = new(v3actionfakes.FakeProgressBar)
+
+			checksum := sha256.Sum256([]byte("some-droplet-bits"))
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				ccv3.Droplet{
+					GUID:     "some-droplet-guid",
+					State:    constant.DropletStaged,
+					Checksum: ccv3.Checksum{Type: "sha256", Value: hex.EncodeToString(checksum[:])},
+				},
+				ccv3.Warnings{"get-current-droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DownloadDropletStub = func(_ string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error) {
+				_, err := io.Copy(destination, wrapDownload(strings.NewReader("some-droplet-bits"), 17))
+				Expect(err).ToNot(HaveOccurred())
+				return ccv3.Warnings{"download-droplet-warning"}, nil
+			}
+			fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+				return reader
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.DownloadApplicationDroplet("some-app", "some-space-guid", dropletGUID, pathToFile, fakeProgressBar)
+		})
+
+		Context("when no droplet GUID is provided", func() {
+			It("downloads the current droplet of the app to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-droplet-warning"))
+				Expect(droplet.GUID).To(Equal("some-droplet-guid"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+				downloadedGUID, _, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+				Expect(downloadedGUID).To(Equal("some-droplet-guid"))
+
+				Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+				_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+				Expect(size).To(BeEquivalentTo(17))
+
+				contents, err := ioutil.ReadFile(pathToFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-droplet-bits"))
+			})
+		})
+
+		Context("when a droplet GUID is provided", func() {
+			BeforeEach(func() {
+				dropletGUID = "some-other-droplet-guid"
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-other-droplet-guid"},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("downloads the droplet without verifying an unknown checksum", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplet-warning", "download-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("some-other-droplet-guid"))
+				downloadedGUID, _, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+				Expect(downloadedGUID).To(Equal("some-other-droplet-guid"))
+				Expect(pathToFile).To(BeARegularFile())
+			})
+		})
+
+		Context("when the app does not have a current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"get-current-droplet-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DropletNotFoundError{AppGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning"))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					ccv3.Droplet{
+						GUID:     "some-droplet-guid",
+						Checksum: ccv3.Checksum{Type: "sha256", Value: "some-other-checksum"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a ChecksumMismatchError and does not create the file", func() {
+				_, ok := executeErr.(actionerror.ChecksumMismatchError)
+				Expect(ok).To(BeTrue())
+				Expect(executeErr.(actionerror.ChecksumMismatchError).Expected).To(Equal("some-other-checksum"))
+				Expect(pathToFile).ToNot(BeAnExistingFile())
+
+				files, err := ioutil.ReadDir(tempDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(BeEmpty())
+			})
+		})
+
+		Context("when the download fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletStub = nil
+				fakeCloudControllerClient.DownloadDropletReturns(ccv3.Warnings{"download-droplet-warning"}, errors.New("download-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("download-error"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-droplet-warning"))
+				Expect(pathToFile).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
+	Describe("CopyDroplet", func() {
+		var (
+			fakeConfig *v3actionfakes.FakeConfig
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.StagingTimeoutReturns(time.Minute)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+
+			fakeCloudControllerClient.CopyDropletReturns(ccv3.Droplet{GUID: "copied-droplet-guid", State: constant.DropletCopying}, ccv3.Warnings{"copy-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.CopyDroplet("some-droplet-guid", "some-app-guid")
+		})
+
+		Context("when the copy is staged", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturnsOnCall(0, ccv3.Droplet{GUID: "copied-droplet-guid", State: constant.DropletCopying}, ccv3.Warnings{"get-warning-1"}, nil)
+				fakeCloudControllerClient.GetDropletReturnsOnCall(1, ccv3.Droplet{GUID: "copied-droplet-guid", State: constant.DropletStaged}, ccv3.Warnings{"get-warning-2"}, nil)
+			})
+
+			It("copies the droplet and waits for it to be staged", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("copy-warning", "get-warning-1", "get-warning-2"))
+				Expect(droplet).To(Equal(Droplet{GUID: "copied-droplet-guid", State: constant.DropletStaged}))
+
+				Expect(fakeCloudControllerClient.CopyDropletCallCount()).To(Equal(1))
+				sourceGUID, appGUID := fakeCloudControllerClient.CopyDropletArgsForCall(0)
+				Expect(sourceGUID).To(Equal("some-droplet-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("copied-droplet-guid"))
+			})
+		})
+
+		Context("when the copy fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(ccv3.Droplet{GUID: "copied-droplet-guid", State: constant.DropletFailed}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a DropletCopyFailedError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DropletCopyFailedError{DropletGUID: "some-droplet-guid"}))
+				Expect(warnings).To(ConsistOf("copy-warning", "get-warning"))
+			})
+		})
+
+		Context("when the copy does not finish before the staging timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StagingTimeoutReturns(0)
+			})
+
+			It("returns a DropletCopyTimeoutError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DropletCopyTimeoutError{DropletGUID: "some-droplet-guid", Timeout: 0}))
+				Expect(warnings).To(ConsistOf("copy-warning"))
+			})
+		})
+
+		Context("when copying the droplet returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CopyDropletReturns(ccv3.Droplet{}, ccv3.Warnings{"copy-warning"}, errors.New("copy-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("copy-error"))
+				Expect(warnings).To(ConsistOf("copy-warning"))
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UploadDroplet", func() {
+		var (
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateApplicationDropletReturns(ccv3.Droplet{GUID: "some-droplet-guid"}, ccv3.Warnings{"create-droplet-warning"}, nil)
+			fakeCloudControllerClient.UploadDropletBitsReturns(ccv3.JobURL("some-job-url"), ccv3.Warnings{"upload-droplet-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.UploadDroplet("some-app-guid", "some-droplet-path")
+		})
+
+		It("creates the droplet, uploads its bits and waits for the upload to complete", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-droplet-warning", "upload-droplet-warning", "poll-job-warning"))
+			Expect(droplet.GUID).To(Equal("some-droplet-guid"))
+
+			Expect(fakeCloudControllerClient.CreateApplicationDropletArgsForCall(0)).To(Equal("some-app-guid"))
+			dropletGUID, path := fakeCloudControllerClient.UploadDropletBitsArgsForCall(0)
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(path).To(Equal("some-droplet-path"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+		})
+
+		Context("when uploading the bits fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadDropletBitsReturns("", ccv3.Warnings{"upload-droplet-warning"}, errors.New("upload-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("upload-error"))
+				Expect(warnings).To(ConsistOf("create-droplet-warning", "upload-droplet-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the upload job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, errors.New("job-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("job-error"))
+				Expect(warnings).To(ConsistOf("create-droplet-warning", "upload-droplet-warning", "poll-job-warning"))
+			})
+		})
+	})
 })
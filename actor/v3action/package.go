@@ -1,6 +1,7 @@
 package v3action
 
 import (
+	"io"
 	"os"
 	"time"
 
@@ -146,3 +147,51 @@ func (actor *Actor) GetApplicationPackages(appName string, spaceGUID string) ([]
 
 	return packages, allWarnings, nil
 }
+
+// DownloadApplicationPackage writes the bits of the package with the given
+// GUID, or of the most recent ready bits package of the application when
+// packageGUID is empty, to pathToFile after verifying their checksum.
+func (actor Actor) DownloadApplicationPackage(appName string, spaceGUID string, packageGUID string, pathToFile string, progressBar ProgressBar) (Package, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Package{}, allWarnings, err
+	}
+
+	var pkg ccv3.Package
+	if packageGUID == "" {
+		packages, warnings, err := actor.CloudControllerClient.GetPackages(
+			ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Package{}, allWarnings, err
+		}
+
+		for _, candidate := range packages {
+			if candidate.Type == constant.PackageTypeBits && candidate.State == constant.PackageReady &&
+				candidate.CreatedAt >= pkg.CreatedAt {
+				pkg = candidate
+			}
+		}
+		if pkg.GUID == "" {
+			return Package{}, allWarnings, actionerror.PackageNotFoundError{AppName: appName}
+		}
+	} else {
+		var warnings ccv3.Warnings
+		pkg, warnings, err = actor.CloudControllerClient.GetPackage(packageGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Package{}, allWarnings, err
+		}
+	}
+
+	downloadWarnings, err := downloadToFile(pathToFile, pkg.Checksum, progressBar, func(destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error) {
+		return actor.CloudControllerClient.DownloadPackage(pkg.GUID, destination, wrapDownload)
+	})
+	allWarnings = append(allWarnings, downloadWarnings...)
+	if err != nil {
+		return Package{}, allWarnings, err
+	}
+
+	return Package(pkg), allWarnings, nil
+}
@@ -2,8 +2,10 @@ package v3action_test
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -704,4 +706,105 @@ var _ = Describe("Package Actions", func() {
 			})
 		})
 	})
+
+	Describe("DownloadApplicationPackage", func() {
+		var (
+			packageGUID string
+			tempDir     string
+			pathToFile  string
+
+			pkg        Package
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "download-package-test")
+			Expect(err).ToNot(HaveOccurred())
+			pathToFile = filepath.Join(tempDir, "package.zip")
+			packageGUID = ""
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturns(
+				[]ccv3.Package{
+					{GUID: "old-package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "new-package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady, CreatedAt: "2017-02-01T00:00:00Z"},
+					{GUID: "failed-package-guid", Type: constant.PackageTypeBits, State: constant.PackageFailed, CreatedAt: "2017-03-01T00:00:00Z"},
+					{GUID: "docker-package-guid", Type: constant.PackageTypeDocker, State: constant.PackageReady, CreatedAt: "2017-04-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DownloadPackageStub = func(_ string, destination io.Writer, _ func(io.Reader, int64) io.Reader) (ccv3.Warnings, error) {
+				_, err := destination.Write([]byte("some-package-bits"))
+				Expect(err).ToNot(HaveOccurred())
+				return ccv3.Warnings{"download-package-warning"}, nil
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			pkg, warnings, executeErr = actor.DownloadApplicationPackage("some-app", "some-space-guid", packageGUID, pathToFile, nil)
+		})
+
+		Context("when no package GUID is provided", func() {
+			It("downloads the most recent ready bits package of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-packages-warning", "download-package-warning"))
+				Expect(pkg.GUID).To(Equal("new-package-guid"))
+
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+				downloadedGUID, _, wrapDownload := fakeCloudControllerClient.DownloadPackageArgsForCall(0)
+				Expect(downloadedGUID).To(Equal("new-package-guid"))
+				Expect(wrapDownload).To(BeNil())
+
+				contents, err := ioutil.ReadFile(pathToFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-package-bits"))
+			})
+		})
+
+		Context("when the app has no ready bits package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a PackageNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.PackageNotFoundError{AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-packages-warning"))
+				Expect(fakeCloudControllerClient.DownloadPackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a package GUID is provided", func() {
+			BeforeEach(func() {
+				packageGUID = "some-package-guid"
+				fakeCloudControllerClient.GetPackageReturns(
+					ccv3.Package{GUID: "some-package-guid", Checksum: ccv3.Checksum{Type: "sha256", Value: "some-other-checksum"}},
+					ccv3.Warnings{"get-package-warning"},
+					nil,
+				)
+			})
+
+			It("verifies the checksum of the downloaded package", func() {
+				Expect(fakeCloudControllerClient.GetPackageArgsForCall(0)).To(Equal("some-package-guid"))
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(0))
+
+				_, ok := executeErr.(actionerror.ChecksumMismatchError)
+				Expect(ok).To(BeTrue())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-package-warning", "download-package-warning"))
+				Expect(pathToFile).ToNot(BeAnExistingFile())
+			})
+		})
+	})
 })
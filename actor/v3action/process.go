@@ -4,6 +4,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 // Process represents a V3 actor process.
@@ -21,3 +23,106 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// ApplyApplicationProcesses scales the process types of the application and
+// updates their command and health check with the settings from a manifest.
+// Settings that are not provided are left unchanged.
+func (actor Actor) ApplyApplicationProcesses(appGUID string, processes []manifestparser.Process) (Warnings, error) {
+	var allWarnings Warnings
+	for _, manifestProcess := range processes {
+		scale := Process{Type: manifestProcess.Type}
+		scale.Instances.ParseIntValue(manifestProcess.Instances)
+
+		var size types.NullByteSizeInMb
+		if err := size.ParseStringValue(manifestProcess.Memory); err != nil {
+			return allWarnings, err
+		}
+		scale.MemoryInMB = types.NullUint64{Value: size.Value, IsSet: size.IsSet}
+		if err := size.ParseStringValue(manifestProcess.DiskQuota); err != nil {
+			return allWarnings, err
+		}
+		scale.DiskInMB = types.NullUint64{Value: size.Value, IsSet: size.IsSet}
+
+		if scale.Instances.IsSet || scale.MemoryInMB.IsSet || scale.DiskInMB.IsSet {
+			warnings, err := actor.ScaleProcessByApplication(appGUID, scale)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+
+		if manifestProcess.Command == "" && manifestProcess.HealthCheckType == "" &&
+			manifestProcess.HealthCheckHTTPEndpoint == "" && manifestProcess.HealthCheckTimeout == 0 {
+			continue
+		}
+
+		process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, manifestProcess.Type)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+				return allWarnings, actionerror.ProcessNotFoundError{ProcessType: manifestProcess.Type}
+			}
+			return allWarnings, err
+		}
+
+		_, warnings, err = actor.CloudControllerClient.UpdateProcess(ccv3.Process{
+			GUID:                process.GUID,
+			Command:             manifestProcess.Command,
+			HealthCheckType:     manifestProcess.HealthCheckType,
+			HealthCheckEndpoint: manifestProcess.HealthCheckHTTPEndpoint,
+			HealthCheckTimeout:  manifestProcess.HealthCheckTimeout,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// GetManifestProcessesBySpace returns the processes of every application in
+// the space, keyed by application GUID, with the settings that
+// ApplyApplicationProcesses reads from a manifest.
+func (actor Actor) GetManifestProcessesBySpace(spaceGUID string) (map[string][]manifestparser.Process, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processesByApp := map[string][]manifestparser.Process{}
+	for _, app := range apps {
+		ccProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var processes []manifestparser.Process
+		for _, ccProcess := range ccProcesses {
+			processes = append(processes, manifestProcess(ccProcess))
+		}
+		processesByApp[app.GUID] = processes
+	}
+
+	return processesByApp, allWarnings, nil
+}
+
+func manifestProcess(ccProcess ccv3.Process) manifestparser.Process {
+	process := manifestparser.Process{
+		Type:               ccProcess.Type,
+		Command:            ccProcess.Command,
+		Memory:             types.NullByteSizeInMb{Value: ccProcess.MemoryInMB.Value, IsSet: ccProcess.MemoryInMB.IsSet}.String(),
+		DiskQuota:          types.NullByteSizeInMb{Value: ccProcess.DiskInMB.Value, IsSet: ccProcess.DiskInMB.IsSet}.String(),
+		HealthCheckType:    ccProcess.HealthCheckType,
+		HealthCheckTimeout: ccProcess.HealthCheckTimeout,
+	}
+	if ccProcess.Instances.IsSet {
+		instances := ccProcess.Instances.Value
+		process.Instances = &instances
+	}
+	if ccProcess.HealthCheckType == "http" {
+		process.HealthCheckHTTPEndpoint = ccProcess.HealthCheckEndpoint
+	}
+	return process
+}
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -98,4 +99,168 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("ApplyApplicationProcesses", func() {
+		var (
+			processes []manifestparser.Process
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			instances := 2
+			processes = []manifestparser.Process{
+				{Type: constant.ProcessTypeWeb, Instances: &instances, Memory: "256M"},
+				{Type: "worker", DiskQuota: "1G", Command: "some-command", HealthCheckType: "process", HealthCheckTimeout: 90},
+			}
+
+			fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "worker-guid"}, ccv3.Warnings{"get-process-warning"}, nil)
+			fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{}, ccv3.Warnings{"update-process-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyApplicationProcesses("some-app-guid", processes)
+		})
+
+		It("scales and updates each process type", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"scale-warning", "scale-warning", "get-process-warning", "update-process-warning"}))
+
+			Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(2))
+			appGUID, process := fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(process).To(Equal(ccv3.Process{
+				Type:       constant.ProcessTypeWeb,
+				Instances:  types.NullInt{Value: 2, IsSet: true},
+				MemoryInMB: types.NullUint64{Value: 256, IsSet: true},
+			}))
+			_, process = fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(1)
+			Expect(process).To(Equal(ccv3.Process{
+				Type:     "worker",
+				DiskInMB: types.NullUint64{Value: 1024, IsSet: true},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+			appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(processType).To(Equal("worker"))
+
+			Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+				GUID:               "worker-guid",
+				Command:            "some-command",
+				HealthCheckType:    "process",
+				HealthCheckTimeout: 90,
+			}))
+		})
+
+		Context("when a process type does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(Equal(Warnings{"scale-warning", "scale-warning", "get-process-warning"}))
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when scaling fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, errors.New("scale-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("scale-error"))
+				Expect(warnings).To(Equal(Warnings{"scale-warning"}))
+			})
+		})
+
+		Context("when updating a process fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{}, ccv3.Warnings{"update-process-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(Equal(Warnings{"scale-warning", "scale-warning", "get-process-warning", "update-process-warning"}))
+			})
+		})
+	})
+
+	Describe("GetManifestProcessesBySpace", func() {
+		var (
+			processes  map[string][]manifestparser.Process
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "app-guid-1"}}, ccv3.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{
+				{
+					Type:                constant.ProcessTypeWeb,
+					Command:             "some-command",
+					Instances:           types.NullInt{Value: 2, IsSet: true},
+					MemoryInMB:          types.NullUint64{Value: 256, IsSet: true},
+					DiskInMB:            types.NullUint64{Value: 1024, IsSet: true},
+					HealthCheckType:     "http",
+					HealthCheckEndpoint: "/health",
+					HealthCheckTimeout:  60,
+				},
+				{
+					Type:                "worker",
+					Instances:           types.NullInt{Value: 0, IsSet: true},
+					HealthCheckType:     "process",
+					HealthCheckEndpoint: "/ignored",
+				},
+			}, ccv3.Warnings{"get-processes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			processes, warnings, executeErr = actor.GetManifestProcessesBySpace("some-space-guid")
+		})
+
+		It("returns the processes of each app as they are written in a manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning"))
+
+			two, zero := 2, 0
+			Expect(processes).To(Equal(map[string][]manifestparser.Process{
+				"app-guid-1": {
+					{
+						Type:                    constant.ProcessTypeWeb,
+						Command:                 "some-command",
+						Instances:               &two,
+						Memory:                  "256M",
+						DiskQuota:               "1G",
+						HealthCheckType:         "http",
+						HealthCheckHTTPEndpoint: "/health",
+						HealthCheckTimeout:      60,
+					},
+					{
+						Type:            "worker",
+						Instances:       &zero,
+						HealthCheckType: "process",
+					},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("app-guid-1"))
+		})
+
+		Context("when getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, errors.New("processes-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("processes-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning"))
+			})
+		})
+	})
 })
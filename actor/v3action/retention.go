@@ -0,0 +1,161 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// RetentionPlan is the droplets and packages of an application that are
+// deleted to clean it up.
+type RetentionPlan struct {
+	Application Application
+	Droplets    []Droplet
+	Packages    []Package
+}
+
+// IsEmpty returns true when there is nothing to delete.
+func (plan RetentionPlan) IsEmpty() bool {
+	return len(plan.Droplets) == 0 && len(plan.Packages) == 0
+}
+
+// GetApplicationRetentionPlan returns the droplets and packages of the
+// application to delete so that only its current droplet, the package it was
+// staged from, and the keep most recent of its other droplets and packages
+// remain. The current droplet and its package are kept even when keep is 0.
+// Droplets and packages that are still being processed are never deleted.
+func (actor Actor) GetApplicationRetentionPlan(appName string, spaceGUID string, keep int) (RetentionPlan, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return RetentionPlan{}, allWarnings, err
+	}
+
+	currentDroplet, warnings, err := actor.GetCurrentDropletByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(actionerror.DropletNotFoundError); err != nil && !ok {
+		return RetentionPlan{}, allWarnings, err
+	}
+
+	droplets, warnings, err := actor.GetApplicationDroplets(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RetentionPlan{}, allWarnings, err
+	}
+
+	packages, warnings, err := actor.GetApplicationPackages(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RetentionPlan{}, allWarnings, err
+	}
+
+	plan := RetentionPlan{Application: app}
+
+	sort.SliceStable(droplets, func(i, j int) bool { return droplets[i].CreatedAt > droplets[j].CreatedAt })
+	kept := 0
+	for _, droplet := range droplets {
+		if droplet.GUID == currentDroplet.GUID {
+			continue
+		}
+		if kept < keep {
+			kept++
+			continue
+		}
+		if dropletIsDeletable(droplet) {
+			plan.Droplets = append(plan.Droplets, droplet)
+		}
+	}
+
+	sort.SliceStable(packages, func(i, j int) bool { return packages[i].CreatedAt > packages[j].CreatedAt })
+	kept = 0
+	for _, pkg := range packages {
+		if pkg.GUID == currentDroplet.PackageGUID {
+			continue
+		}
+		if kept < keep {
+			kept++
+			continue
+		}
+		if packageIsDeletable(pkg) {
+			plan.Packages = append(plan.Packages, pkg)
+		}
+	}
+
+	return plan, allWarnings, nil
+}
+
+// GetSpaceRetentionPlans returns the retention plans of all the applications
+// in the space that have droplets or packages to delete.
+func (actor Actor) GetSpaceRetentionPlans(spaceGUID string, keep int) ([]RetentionPlan, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var plans []RetentionPlan
+	for _, app := range apps {
+		plan, warnings, err := actor.GetApplicationRetentionPlan(app.Name, spaceGUID, keep)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if !plan.IsEmpty() {
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans, allWarnings, nil
+}
+
+// ApplyRetentionPlan deletes the droplets and packages in the plan and waits
+// for each deletion to finish.
+func (actor Actor) ApplyRetentionPlan(plan RetentionPlan) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, droplet := range plan.Droplets {
+		jobURL, warnings, err := actor.CloudControllerClient.DeleteDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, pkg := range plan.Packages {
+		jobURL, warnings, err := actor.CloudControllerClient.DeletePackage(pkg.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func dropletIsDeletable(droplet Droplet) bool {
+	switch droplet.State {
+	case constant.DropletStaged, constant.DropletFailed, constant.DropletExpired:
+		return true
+	}
+	return false
+}
+
+func packageIsDeletable(pkg Package) bool {
+	switch pkg.State {
+	case constant.PackageReady, constant.PackageFailed, constant.PackageExpired:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,223 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retention Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationRetentionPlan", func() {
+		var (
+			keep       int
+			plan       RetentionPlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			keep = 1
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{GUID: "droplet-1", PackageGUID: "package-1"}, ccv3.Warnings{"get-current-droplet-warning"}, nil)
+			fakeCloudControllerClient.GetDropletsReturns([]ccv3.Droplet{
+				{GUID: "droplet-1", State: constant.DropletStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+				{GUID: "droplet-2", State: constant.DropletStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+				{GUID: "droplet-3", State: constant.DropletFailed, CreatedAt: "2017-01-03T00:00:00Z"},
+				{GUID: "droplet-4", State: constant.DropletStaged, CreatedAt: "2017-01-04T00:00:00Z"},
+				{GUID: "droplet-5", State: constant.DropletCopying, CreatedAt: "2016-12-01T00:00:00Z"},
+			}, ccv3.Warnings{"get-droplets-warning"}, nil)
+			fakeCloudControllerClient.GetPackagesReturns([]ccv3.Package{
+				{GUID: "package-1", State: constant.PackageReady, CreatedAt: "2017-01-01T00:00:00Z"},
+				{GUID: "package-2", State: constant.PackageReady, CreatedAt: "2017-01-03T00:00:00Z"},
+				{GUID: "package-3", State: constant.PackageAwaitingUpload, CreatedAt: "2016-12-01T00:00:00Z"},
+				{GUID: "package-4", State: constant.PackageExpired, CreatedAt: "2017-01-02T00:00:00Z"},
+			}, ccv3.Warnings{"get-packages-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.GetApplicationRetentionPlan("some-app", "some-space-guid", keep)
+		})
+
+		It("returns the droplets and packages beyond the current and most recent ones", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-app-warning", "get-current-droplet-warning",
+				"get-app-warning", "get-droplets-warning",
+				"get-app-warning", "get-packages-warning",
+			))
+
+			Expect(plan.Application.GUID).To(Equal("some-app-guid"))
+
+			var dropletGUIDs []string
+			for _, droplet := range plan.Droplets {
+				dropletGUIDs = append(dropletGUIDs, droplet.GUID)
+			}
+			Expect(dropletGUIDs).To(Equal([]string{"droplet-3", "droplet-2"}))
+
+			var packageGUIDs []string
+			for _, pkg := range plan.Packages {
+				packageGUIDs = append(packageGUIDs, pkg.GUID)
+			}
+			Expect(packageGUIDs).To(Equal([]string{"package-4"}))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+
+		Context("when keep is 0", func() {
+			BeforeEach(func() {
+				keep = 0
+			})
+
+			It("keeps the current droplet and its package", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var dropletGUIDs []string
+				for _, droplet := range plan.Droplets {
+					dropletGUIDs = append(dropletGUIDs, droplet.GUID)
+				}
+				Expect(dropletGUIDs).To(Equal([]string{"droplet-4", "droplet-3", "droplet-2"}))
+
+				var packageGUIDs []string
+				for _, pkg := range plan.Packages {
+					packageGUIDs = append(packageGUIDs, pkg.GUID)
+				}
+				Expect(packageGUIDs).To(Equal([]string{"package-2", "package-4"}))
+			})
+		})
+
+		Context("when the current droplet is the newest", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{GUID: "droplet-4", PackageGUID: "package-2"}, nil, nil)
+			})
+
+			It("keeps the most recent droplets and packages besides the current ones", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var dropletGUIDs []string
+				for _, droplet := range plan.Droplets {
+					dropletGUIDs = append(dropletGUIDs, droplet.GUID)
+				}
+				Expect(dropletGUIDs).To(Equal([]string{"droplet-2", "droplet-1"}))
+
+				var packageGUIDs []string
+				for _, pkg := range plan.Packages {
+					packageGUIDs = append(packageGUIDs, pkg.GUID)
+				}
+				Expect(packageGUIDs).To(Equal([]string{"package-1"}))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("only keeps the most recent droplets", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.Droplets).To(HaveLen(3))
+			})
+		})
+
+		Context("when getting the droplets fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, errors.New("droplets-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("droplets-error"))
+				Expect(warnings).To(ContainElement("get-droplets-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceRetentionPlans", func() {
+		var (
+			plans      []RetentionPlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv3.Application{
+				{Name: "app-1", GUID: "app-1-guid"},
+				{Name: "app-2", GUID: "app-2-guid"},
+			}, ccv3.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "app-1", GUID: "app-1-guid"}}, nil, nil)
+			fakeCloudControllerClient.GetDropletsReturnsOnCall(0, []ccv3.Droplet{
+				{GUID: "droplet-1", State: constant.DropletStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+				{GUID: "droplet-2", State: constant.DropletStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+			}, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			plans, warnings, executeErr = actor.GetSpaceRetentionPlans("some-space-guid", 1)
+		})
+
+		It("returns the plans of the apps that have something to delete", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("get-apps-warning"))
+			Expect(plans).To(HaveLen(1))
+			Expect(plans[0].Droplets).To(HaveLen(1))
+			Expect(plans[0].Droplets[0].GUID).To(Equal("droplet-2"))
+		})
+	})
+
+	Describe("ApplyRetentionPlan", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteDropletReturns(ccv3.JobURL("droplet-job"), ccv3.Warnings{"delete-droplet-warning"}, nil)
+			fakeCloudControllerClient.DeletePackageReturns(ccv3.JobURL("package-job"), ccv3.Warnings{"delete-package-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyRetentionPlan(RetentionPlan{
+				Droplets: []Droplet{{GUID: "droplet-1"}},
+				Packages: []Package{{GUID: "package-1"}},
+			})
+		})
+
+		It("deletes the droplets and packages and waits for each deletion", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-droplet-warning", "poll-warning", "delete-package-warning", "poll-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("droplet-1"))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-1"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("droplet-job")))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(1)).To(Equal(ccv3.JobURL("package-job")))
+		})
+
+		Context("when deleting a droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"delete-droplet-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("delete-droplet-warning"))
+				Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
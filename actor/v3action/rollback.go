@@ -0,0 +1,102 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// DropletRollback is the droplet an application is rolled back from and the
+// droplet it is rolled back to.
+type DropletRollback struct {
+	Application Application
+	From        Droplet
+	To          Droplet
+}
+
+// GetApplicationRollback returns the application's current droplet and the
+// staged droplet created previous droplets before it. A NoPreviousDropletError
+// is returned when the application does not have that many older staged
+// droplets.
+func (actor Actor) GetApplicationRollback(appName string, spaceGUID string, previous int) (DropletRollback, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return DropletRollback{}, allWarnings, err
+	}
+
+	currentDroplet, warnings, err := actor.GetCurrentDropletByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DropletRollback{}, allWarnings, err
+	}
+
+	droplets, warnings, err := actor.GetApplicationDroplets(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DropletRollback{}, allWarnings, err
+	}
+
+	var staged []Droplet
+	for _, droplet := range droplets {
+		if droplet.State == constant.DropletStaged {
+			staged = append(staged, droplet)
+		}
+	}
+	sort.SliceStable(staged, func(i, j int) bool { return staged[i].CreatedAt > staged[j].CreatedAt })
+
+	for i, droplet := range staged {
+		if droplet.GUID != currentDroplet.GUID {
+			continue
+		}
+
+		if previous < 1 || i+previous >= len(staged) {
+			break
+		}
+
+		return DropletRollback{
+			Application: app,
+			From:        currentDroplet,
+			To:          staged[i+previous],
+		}, allWarnings, nil
+	}
+
+	return DropletRollback{}, allWarnings, actionerror.NoPreviousDropletError{AppName: appName, Previous: previous}
+}
+
+// GetApplicationCrashedProcessTypes returns the types of the application's
+// processes whose instances have all crashed.
+func (actor Actor) GetApplicationCrashedProcessTypes(appGUID string) ([]string, Warnings, error) {
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var crashedTypes []string
+	for _, process := range processes {
+		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if len(instances) == 0 {
+			continue
+		}
+
+		crashed := true
+		for _, instance := range instances {
+			if instance.State != constant.ProcessInstanceCrashed {
+				crashed = false
+				break
+			}
+		}
+
+		if crashed {
+			crashedTypes = append(crashedTypes, process.Type)
+		}
+	}
+
+	return crashedTypes, allWarnings, nil
+}
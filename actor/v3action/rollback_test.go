@@ -0,0 +1,140 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollback Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationRollback", func() {
+		var (
+			previous   int
+			rollback   DropletRollback
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			previous = 1
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{GUID: "droplet-3", State: constant.DropletStaged}, ccv3.Warnings{"get-current-droplet-warning"}, nil)
+			fakeCloudControllerClient.GetDropletsReturns([]ccv3.Droplet{
+				{GUID: "droplet-1", State: constant.DropletStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+				{GUID: "droplet-2", State: constant.DropletFailed, CreatedAt: "2017-01-02T00:00:00Z"},
+				{GUID: "droplet-3", State: constant.DropletStaged, CreatedAt: "2017-01-04T00:00:00Z"},
+				{GUID: "droplet-4", State: constant.DropletStaged, CreatedAt: "2017-01-03T00:00:00Z"},
+				{GUID: "droplet-5", State: constant.DropletStaged, CreatedAt: "2017-01-05T00:00:00Z"},
+			}, ccv3.Warnings{"get-droplets-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			rollback, warnings, executeErr = actor.GetApplicationRollback("some-app", "some-space-guid", previous)
+		})
+
+		It("returns the staged droplet created before the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-current-droplet-warning", "get-app-warning", "get-droplets-warning"))
+			Expect(rollback.Application.GUID).To(Equal("some-app-guid"))
+			Expect(rollback.From.GUID).To(Equal("droplet-3"))
+			Expect(rollback.To.GUID).To(Equal("droplet-4"))
+		})
+
+		Context("when rolling back more than one droplet", func() {
+			BeforeEach(func() {
+				previous = 2
+			})
+
+			It("skips droplets that are not staged", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rollback.To.GUID).To(Equal("droplet-1"))
+			})
+		})
+
+		Context("when there are not enough older droplets", func() {
+			BeforeEach(func() {
+				previous = 3
+			})
+
+			It("returns a NoPreviousDropletError", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoPreviousDropletError{AppName: "some-app", Previous: 3}))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"get-current-droplet-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DropletNotFoundError{AppGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-current-droplet-warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationCrashedProcessTypes", func() {
+		var (
+			crashedTypes []string
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{
+				{GUID: "web-guid", Type: "web"},
+				{GUID: "worker-guid", Type: "worker"},
+				{GUID: "clock-guid", Type: "clock"},
+			}, ccv3.Warnings{"get-processes-warning"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, []ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceCrashed},
+				{State: constant.ProcessInstanceCrashed},
+			}, ccv3.Warnings{"get-instances-warning"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1, []ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceCrashed},
+				{State: constant.ProcessInstanceRunning},
+			}, nil, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2, nil, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			crashedTypes, warnings, executeErr = actor.GetApplicationCrashedProcessTypes("some-app-guid")
+		})
+
+		It("returns the processes whose instances have all crashed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+			Expect(crashedTypes).To(Equal([]string{"web"}))
+			Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+
+		Context("when getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, nil, ccv3.Warnings{"get-instances-warning"}, errors.New("instances-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+})
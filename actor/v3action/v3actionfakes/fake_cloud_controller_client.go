@@ -2,6 +2,7 @@
 package v3actionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -52,6 +53,22 @@ type FakeCloudControllerClient struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CopyDropletStub        func(dropletGUID string, appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	copyDropletMutex       sync.RWMutex
+	copyDropletArgsForCall []struct {
+		dropletGUID string
+		appGUID     string
+	}
+	copyDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	copyDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationStub        func(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -67,6 +84,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentStub        func(appGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		appGUID string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	CreateApplicationDropletStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	createApplicationDropletMutex       sync.RWMutex
+	createApplicationDropletArgsForCall []struct {
+		appGUID string
+	}
+	createApplicationDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -174,6 +221,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteDropletStub        func(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	deleteDropletReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(guid string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -187,6 +249,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		packageGUID string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error)
 	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
 	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
@@ -201,6 +278,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DownloadDropletStub        func(dropletGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error)
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		dropletGUID  string
+		destination  io.Writer
+		wrapDownload func(io.Reader, int64) io.Reader
+	}
+	downloadDropletReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	downloadDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	DownloadPackageStub        func(packageGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error)
+	downloadPackageMutex       sync.RWMutex
+	downloadPackageArgsForCall []struct {
+		packageGUID  string
+		destination  io.Writer
+		wrapDownload func(io.Reader, int64) io.Reader
+	}
+	downloadPackageReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	downloadPackageReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -324,6 +431,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -688,6 +810,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateProcessStub        func(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
+	updateProcessMutex       sync.RWMutex
+	updateProcessArgsForCall []struct {
+		process ccv3.Process
+	}
+	updateProcessReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateProcessReturnsOnCall map[int]struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -703,6 +840,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UploadDropletBitsStub        func(dropletGUID string, pathToDroplet string) (ccv3.JobURL, ccv3.Warnings, error)
+	uploadDropletBitsMutex       sync.RWMutex
+	uploadDropletBitsArgsForCall []struct {
+		dropletGUID   string
+		pathToDroplet string
+	}
+	uploadDropletBitsReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	uploadDropletBitsReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UploadPackageStub        func(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 	uploadPackageMutex       sync.RWMutex
 	uploadPackageArgsForCall []struct {
@@ -898,6 +1051,61 @@ func (fake *FakeCloudControllerClient) CloudControllerAPIVersionReturnsOnCall(i
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) CopyDroplet(dropletGUID string, appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.copyDropletMutex.Lock()
+	ret, specificReturn := fake.copyDropletReturnsOnCall[len(fake.copyDropletArgsForCall)]
+	fake.copyDropletArgsForCall = append(fake.copyDropletArgsForCall, struct {
+		dropletGUID string
+		appGUID     string
+	}{dropletGUID, appGUID})
+	fake.recordInvocation("CopyDroplet", []interface{}{dropletGUID, appGUID})
+	fake.copyDropletMutex.Unlock()
+	if fake.CopyDropletStub != nil {
+		return fake.CopyDropletStub(dropletGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.copyDropletReturns.result1, fake.copyDropletReturns.result2, fake.copyDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletCallCount() int {
+	fake.copyDropletMutex.RLock()
+	defer fake.copyDropletMutex.RUnlock()
+	return len(fake.copyDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletArgsForCall(i int) (string, string) {
+	fake.copyDropletMutex.RLock()
+	defer fake.copyDropletMutex.RUnlock()
+	return fake.copyDropletArgsForCall[i].dropletGUID, fake.copyDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CopyDropletStub = nil
+	fake.copyDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CopyDropletReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CopyDropletStub = nil
+	if fake.copyDropletReturnsOnCall == nil {
+		fake.copyDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.copyDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -952,6 +1160,114 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(appGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{appGUID})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) string {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.createApplicationDropletMutex.Lock()
+	ret, specificReturn := fake.createApplicationDropletReturnsOnCall[len(fake.createApplicationDropletArgsForCall)]
+	fake.createApplicationDropletArgsForCall = append(fake.createApplicationDropletArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("CreateApplicationDroplet", []interface{}{appGUID})
+	fake.createApplicationDropletMutex.Unlock()
+	if fake.CreateApplicationDropletStub != nil {
+		return fake.CreateApplicationDropletStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDropletReturns.result1, fake.createApplicationDropletReturns.result2, fake.createApplicationDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDropletCallCount() int {
+	fake.createApplicationDropletMutex.RLock()
+	defer fake.createApplicationDropletMutex.RUnlock()
+	return len(fake.createApplicationDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDropletArgsForCall(i int) string {
+	fake.createApplicationDropletMutex.RLock()
+	defer fake.createApplicationDropletMutex.RUnlock()
+	return fake.createApplicationDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDropletStub = nil
+	fake.createApplicationDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDropletReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDropletStub = nil
+	if fake.createApplicationDropletReturnsOnCall == nil {
+		fake.createApplicationDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -1331,6 +1647,60 @@ func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstanceReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("DeleteDroplet", []interface{}{dropletGUID})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2, fake.deleteDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(guid string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -1382,6 +1752,60 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("DeletePackage", []interface{}{packageGUID})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(packageGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2, fake.deletePackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error) {
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
@@ -1434,6 +1858,112 @@ func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedS
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DownloadDroplet(dropletGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error) {
+	fake.downloadDropletMutex.Lock()
+	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		dropletGUID  string
+		destination  io.Writer
+		wrapDownload func(io.Reader, int64) io.Reader
+	}{dropletGUID, destination, wrapDownload})
+	fake.recordInvocation("DownloadDroplet", []interface{}{dropletGUID, destination, wrapDownload})
+	fake.downloadDropletMutex.Unlock()
+	if fake.DownloadDropletStub != nil {
+		return fake.DownloadDropletStub(dropletGUID, destination, wrapDownload)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.downloadDropletReturns.result1, fake.downloadDropletReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) (string, io.Writer, func(io.Reader, int64) io.Reader) {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return fake.downloadDropletArgsForCall[i].dropletGUID, fake.downloadDropletArgsForCall[i].destination, fake.downloadDropletArgsForCall[i].wrapDownload
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.DownloadDropletStub = nil
+	if fake.downloadDropletReturnsOnCall == nil {
+		fake.downloadDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.downloadDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackage(packageGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (ccv3.Warnings, error) {
+	fake.downloadPackageMutex.Lock()
+	ret, specificReturn := fake.downloadPackageReturnsOnCall[len(fake.downloadPackageArgsForCall)]
+	fake.downloadPackageArgsForCall = append(fake.downloadPackageArgsForCall, struct {
+		packageGUID  string
+		destination  io.Writer
+		wrapDownload func(io.Reader, int64) io.Reader
+	}{packageGUID, destination, wrapDownload})
+	fake.recordInvocation("DownloadPackage", []interface{}{packageGUID, destination, wrapDownload})
+	fake.downloadPackageMutex.Unlock()
+	if fake.DownloadPackageStub != nil {
+		return fake.DownloadPackageStub(packageGUID, destination, wrapDownload)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.downloadPackageReturns.result1, fake.downloadPackageReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageCallCount() int {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	return len(fake.downloadPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageArgsForCall(i int) (string, io.Writer, func(io.Reader, int64) io.Reader) {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	return fake.downloadPackageArgsForCall[i].packageGUID, fake.downloadPackageArgsForCall[i].destination, fake.downloadPackageArgsForCall[i].wrapDownload
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DownloadPackageStub = nil
+	fake.downloadPackageReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.DownloadPackageStub = nil
+	if fake.downloadPackageReturnsOnCall == nil {
+		fake.downloadPackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.downloadPackageReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
 	var orgGUIDsCopy []string
 	if orgGUIDs != nil {
@@ -1874,6 +2404,60 @@ func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("GetDeployment", []interface{}{deploymentGUID})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -3182,6 +3766,60 @@ func (fake *FakeCloudControllerClient) UpdateApplicationStopReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
+	fake.updateProcessMutex.Lock()
+	ret, specificReturn := fake.updateProcessReturnsOnCall[len(fake.updateProcessArgsForCall)]
+	fake.updateProcessArgsForCall = append(fake.updateProcessArgsForCall, struct {
+		process ccv3.Process
+	}{process})
+	fake.recordInvocation("UpdateProcess", []interface{}{process})
+	fake.updateProcessMutex.Unlock()
+	if fake.UpdateProcessStub != nil {
+		return fake.UpdateProcessStub(process)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateProcessReturns.result1, fake.updateProcessReturns.result2, fake.updateProcessReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessCallCount() int {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return len(fake.updateProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessArgsForCall(i int) ccv3.Process {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return fake.updateProcessArgsForCall[i].process
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	fake.updateProcessReturns = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturnsOnCall(i int, result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	if fake.updateProcessReturnsOnCall == nil {
+		fake.updateProcessReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateProcessReturnsOnCall[i] = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
@@ -3236,6 +3874,61 @@ func (fake *FakeCloudControllerClient) UpdateTaskReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UploadDropletBits(dropletGUID string, pathToDroplet string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.uploadDropletBitsMutex.Lock()
+	ret, specificReturn := fake.uploadDropletBitsReturnsOnCall[len(fake.uploadDropletBitsArgsForCall)]
+	fake.uploadDropletBitsArgsForCall = append(fake.uploadDropletBitsArgsForCall, struct {
+		dropletGUID   string
+		pathToDroplet string
+	}{dropletGUID, pathToDroplet})
+	fake.recordInvocation("UploadDropletBits", []interface{}{dropletGUID, pathToDroplet})
+	fake.uploadDropletBitsMutex.Unlock()
+	if fake.UploadDropletBitsStub != nil {
+		return fake.UploadDropletBitsStub(dropletGUID, pathToDroplet)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.uploadDropletBitsReturns.result1, fake.uploadDropletBitsReturns.result2, fake.uploadDropletBitsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsCallCount() int {
+	fake.uploadDropletBitsMutex.RLock()
+	defer fake.uploadDropletBitsMutex.RUnlock()
+	return len(fake.uploadDropletBitsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsArgsForCall(i int) (string, string) {
+	fake.uploadDropletBitsMutex.RLock()
+	defer fake.uploadDropletBitsMutex.RUnlock()
+	return fake.uploadDropletBitsArgsForCall[i].dropletGUID, fake.uploadDropletBitsArgsForCall[i].pathToDroplet
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.UploadDropletBitsStub = nil
+	fake.uploadDropletBitsReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletBitsReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.UploadDropletBitsStub = nil
+	if fake.uploadDropletBitsReturnsOnCall == nil {
+		fake.uploadDropletBitsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.uploadDropletBitsReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error) {
 	fake.uploadPackageMutex.Lock()
 	ret, specificReturn := fake.uploadPackageReturnsOnCall[len(fake.uploadPackageArgsForCall)]
@@ -3302,8 +3995,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.assignSpaceToIsolationSegmentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.copyDropletMutex.RLock()
+	defer fake.copyDropletMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	fake.createApplicationDropletMutex.RLock()
+	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
@@ -3318,10 +4017,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getApplicationDropletCurrentMutex.RLock()
@@ -3338,6 +4045,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
@@ -3386,8 +4095,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationStartMutex.RUnlock()
 	fake.updateApplicationStopMutex.RLock()
 	defer fake.updateApplicationStopMutex.RUnlock()
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
+	fake.uploadDropletBitsMutex.RLock()
+	defer fake.uploadDropletBitsMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
 	defer fake.uploadPackageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
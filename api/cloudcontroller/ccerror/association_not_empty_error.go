@@ -0,0 +1,11 @@
+package ccerror
+
+// AssociationNotEmptyError is returned when deleting a resource that still
+// has associated resources, such as a service instance with bindings or keys.
+type AssociationNotEmptyError struct {
+	Message string
+}
+
+func (e AssociationNotEmptyError) Error() string {
+	return e.Message
+}
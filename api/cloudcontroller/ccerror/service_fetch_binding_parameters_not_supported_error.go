@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceFetchBindingParametersNotSupportedError is returned when the service
+// broker of a service binding does not support fetching its parameters.
+type ServiceFetchBindingParametersNotSupportedError struct {
+	Message string
+}
+
+func (e ServiceFetchBindingParametersNotSupportedError) Error() string {
+	return e.Message
+}
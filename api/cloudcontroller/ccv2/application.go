@@ -24,6 +24,10 @@ type Application struct {
 	// DetectedBuildpack is the buildpack automatically detected.
 	DetectedBuildpack types.FilteredString
 
+	// DetectedBuildpackGUID is the GUID of the admin buildpack automatically
+	// detected.
+	DetectedBuildpackGUID string
+
 	// DetectedStartCommand is the command used to start the application.
 	DetectedStartCommand types.FilteredString
 
@@ -164,13 +168,14 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	var ccApp struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Buildpack            string            `json:"buildpack"`
-			Command              string            `json:"command"`
-			DetectedBuildpack    string            `json:"detected_buildpack"`
-			DetectedStartCommand string            `json:"detected_start_command"`
-			DiskQuota            *uint64           `json:"disk_quota"`
-			DockerImage          string            `json:"docker_image"`
-			DockerCredentials    DockerCredentials `json:"docker_credentials"`
+			Buildpack             string            `json:"buildpack"`
+			Command               string            `json:"command"`
+			DetectedBuildpack     string            `json:"detected_buildpack"`
+			DetectedBuildpackGUID string            `json:"detected_buildpack_guid"`
+			DetectedStartCommand  string            `json:"detected_start_command"`
+			DiskQuota             *uint64           `json:"disk_quota"`
+			DockerImage           string            `json:"docker_image"`
+			DockerCredentials     DockerCredentials `json:"docker_credentials"`
 			// EnvironmentVariables' values can be any type, so we must accept
 			// interface{}, but we convert to string.
 			EnvironmentVariables     map[string]interface{} `json:"environment_json"`
@@ -196,6 +201,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	application.Buildpack.ParseValue(ccApp.Entity.Buildpack)
 	application.Command.ParseValue(ccApp.Entity.Command)
 	application.DetectedBuildpack.ParseValue(ccApp.Entity.DetectedBuildpack)
+	application.DetectedBuildpackGUID = ccApp.Entity.DetectedBuildpackGUID
 	application.DetectedStartCommand.ParseValue(ccApp.Entity.DetectedStartCommand)
 	application.DiskQuota.ParseUint64Value(ccApp.Entity.DiskQuota)
 	application.DockerCredentials = ccApp.Entity.DockerCredentials
@@ -256,6 +262,21 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the application with the given GUID.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -88,6 +88,47 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the app and returns warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found: some-app-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		BeforeEach(func() {
 			response := `{
@@ -213,6 +254,7 @@ var _ = Describe("Application", func() {
 						"entity": {
 							"name": "app-name-2",
 							"detected_buildpack": "ruby 1.6.29",
+							"detected_buildpack_guid": "some-buildpack-guid",
 							"package_updated_at": null
 						}
 					}
@@ -286,9 +328,10 @@ var _ = Describe("Application", func() {
 						State:                   constant.ApplicationStopped,
 					},
 					{
-						Name:              "app-name-2",
-						GUID:              "app-guid-2",
-						DetectedBuildpack: types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
+						Name:                  "app-name-2",
+						GUID:                  "app-guid-2",
+						DetectedBuildpack:     types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
+						DetectedBuildpackGUID: "some-buildpack-guid",
 					},
 					{Name: "app-name-3", GUID: "app-guid-3"},
 					{Name: "app-name-4", GUID: "app-guid-4"},
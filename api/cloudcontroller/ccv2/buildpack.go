@@ -0,0 +1,87 @@
+package ccv2
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Buildpack represents a Cloud Controller admin buildpack.
+type Buildpack struct {
+	// GUID is the unique buildpack identifier.
+	GUID string
+
+	// Name is the name given to the buildpack.
+	Name string
+
+	// Stack is the stack the buildpack is compatible with. It is empty when
+	// the buildpack can be used with any stack.
+	Stack string
+
+	// Position is the order in which the buildpack is used during detection.
+	Position int
+
+	// Enabled is true when the buildpack can be used for staging.
+	Enabled bool
+
+	// Locked is true when the buildpack cannot be updated.
+	Locked bool
+
+	// Filename is the name of the uploaded buildpack file.
+	Filename string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name     string `json:"name"`
+			Stack    string `json:"stack"`
+			Position int    `json:"position"`
+			Enabled  bool   `json:"enabled"`
+			Locked   bool   `json:"locked"`
+			Filename string `json:"filename"`
+		} `json:"entity"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccBuildpack)
+	if err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	buildpack.Stack = ccBuildpack.Entity.Stack
+	buildpack.Position = ccBuildpack.Entity.Position
+	buildpack.Enabled = ccBuildpack.Entity.Enabled
+	buildpack.Locked = ccBuildpack.Entity.Locked
+	buildpack.Filename = ccBuildpack.Entity.Filename
+	return nil
+}
+
+// GetBuildpacks returns a list of Buildpacks based off of the provided
+// filters.
+func (client *Client) GetBuildpacks(filters ...Filter) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
@@ -0,0 +1,123 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/buildpacks?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-buildpack-guid-1"
+							},
+							"entity": {
+								"name": "ruby_buildpack",
+								"stack": "cflinuxfs2",
+								"position": 1,
+								"enabled": true,
+								"locked": false,
+								"filename": "ruby_buildpack-cached-cflinuxfs2-v1.7.18.zip"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-buildpack-guid-2"
+							},
+							"entity": {
+								"name": "go_buildpack",
+								"stack": null,
+								"position": 2,
+								"enabled": false,
+								"locked": true,
+								"filename": "go_buildpack-cached-v1.8.20.zip"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("returns all the buildpacks and all warnings", func() {
+				buildpacks, warnings, err := client.GetBuildpacks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{
+						GUID:     "some-buildpack-guid-1",
+						Name:     "ruby_buildpack",
+						Stack:    "cflinuxfs2",
+						Position: 1,
+						Enabled:  true,
+						Filename: "ruby_buildpack-cached-cflinuxfs2-v1.7.18.zip",
+					},
+					{
+						GUID:     "some-buildpack-guid-2",
+						Name:     "go_buildpack",
+						Position: 2,
+						Locked:   true,
+						Filename: "go_buildpack-cached-v1.8.20.zip",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetBuildpacks()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
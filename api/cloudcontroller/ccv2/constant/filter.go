@@ -12,13 +12,23 @@ const (
 	OrganizationGUIDFilter FilterType = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter FilterType = "route_guid"
+	// ServiceBrokerGUIDFilter is the name of the 'service_broker_guid' filter.
+	ServiceBrokerGUIDFilter FilterType = "service_broker_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter FilterType = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter FilterType = "service_instance_guid"
+	// ServicePlanGUIDFilter is the name of the 'service_plan_guid' filter.
+	ServicePlanGUIDFilter FilterType = "service_plan_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
 	SpaceGUIDFilter FilterType = "space_guid"
+	// StackGUIDFilter is the name of the 'stack_guid' filter.
+	StackGUIDFilter FilterType = "stack_guid"
 
 	// NameFilter is the name of the 'name' filter.
 	NameFilter FilterType = "name"
+	// LabelFilter is the name of the 'label' filter.
+	LabelFilter FilterType = "label"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter FilterType = "host"
 	// PathFilter is the name of the 'path' filter.
@@ -0,0 +1,34 @@
+package constant
+
+// OrganizationRole is a role a user can have in an organization. Its value is
+// the name of the organization's relationship to its users with that role.
+type OrganizationRole string
+
+const (
+	// OrgUser is the role of every user of an organization.
+	OrgUser OrganizationRole = "users"
+
+	// OrgManager is the OrgManager role.
+	OrgManager OrganizationRole = "managers"
+
+	// OrgBillingManager is the BillingManager role.
+	OrgBillingManager OrganizationRole = "billing_managers"
+
+	// OrgAuditor is the OrgAuditor role.
+	OrgAuditor OrganizationRole = "auditors"
+)
+
+// SpaceRole is a role a user can have in a space. Its value is the name of
+// the space's relationship to its users with that role.
+type SpaceRole string
+
+const (
+	// SpaceManager is the SpaceManager role.
+	SpaceManager SpaceRole = "managers"
+
+	// SpaceDeveloper is the SpaceDeveloper role.
+	SpaceDeveloper SpaceRole = "developers"
+
+	// SpaceAuditor is the SpaceAuditor role.
+	SpaceAuditor SpaceRole = "auditors"
+)
@@ -9,3 +9,20 @@ const (
 	// ManagedService is a Service Instance that is managed by a service broker.
 	ServiceInstanceTypeManagedService ServiceInstanceType = "managed_service_instance"
 )
+
+// LastOperationState is the state of the last operation performed on a
+// service instance.
+type LastOperationState string
+
+const (
+	// LastOperationInProgress is when the operation is still being performed by
+	// the service broker.
+	LastOperationInProgress LastOperationState = "in progress"
+
+	// LastOperationSucceeded is when the operation completed successfully.
+	LastOperationSucceeded LastOperationState = "succeeded"
+
+	// LastOperationFailed is when the service broker failed to complete the
+	// operation.
+	LastOperationFailed LastOperationState = "failed"
+)
@@ -83,6 +83,8 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 	switch errorResponse.ErrorCode {
 	case "CF-AppStoppedStatsError":
 		return ccerror.ApplicationStoppedStatsError{Message: errorResponse.Description}
+	case "CF-AssociationNotEmpty":
+		return ccerror.AssociationNotEmptyError{Message: errorResponse.Description}
 	case "CF-InstancesError":
 		return ccerror.InstancesError{Message: errorResponse.Description}
 	case "CF-InvalidRelation":
@@ -91,6 +93,10 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.NotStagedError{Message: errorResponse.Description}
 	case "CF-ServiceBindingAppServiceTaken":
 		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
+	case "CF-ServiceFetchBindingParametersNotSupported":
+		return ccerror.ServiceFetchBindingParametersNotSupportedError{Message: errorResponse.Description}
+	case "CF-ServiceInstanceNameTaken":
+		return ccerror.ServiceInstanceNameTakenError{Message: errorResponse.Description}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Description}
 	}
@@ -15,25 +15,34 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteAppRequest                                     = "DeleteApp"
 	DeleteOrganizationRequest                            = "DeleteOrganization"
 	DeleteRouteAppRequest                                = "DeleteRouteApp"
 	DeleteRouteRequest                                   = "DeleteRoute"
 	DeleteSecurityGroupSpaceRequest                      = "DeleteSecurityGroupSpace"
 	DeleteServiceBindingRequest                          = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest                         = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                              = "DeleteServiceKey"
+	DeleteServicePlanVisibilityRequest                   = "DeleteServicePlanVisibility"
 	DeleteSpaceRequest                                   = "DeleteSpace"
 	DeleteSecurityGroupStagingSpaceRequest               = "DeleteSecurityGroupStagingSpace"
+	DeleteUserProvidedServiceInstanceRequest             = "DeleteUserProvidedServiceInstance"
 	GetAppInstancesRequest                               = "GetAppInstances"
 	GetAppRequest                                        = "GetApp"
 	GetAppRoutesRequest                                  = "GetAppRoutes"
 	GetAppsRequest                                       = "GetApps"
 	GetAppStatsRequest                                   = "GetAppStats"
+	GetBuildpacksRequest                                 = "GetBuildpacks"
 	GetConfigFeatureFlagsRequest                         = "GetConfigFeatureFlags"
 	GetInfoRequest                                       = "GetInfo"
 	GetJobRequest                                        = "GetJob"
 	GetOrganizationPrivateDomainsRequest                 = "GetOrganizationPrivateDomains"
 	GetOrganizationQuotaDefinitionRequest                = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest               = "GetOrganizationQuotaDefinitions"
 	GetOrganizationRequest                               = "GetOrganization"
+	GetOrganizationSpaceQuotaDefinitionsRequest          = "GetOrganizationSpaceQuotaDefinitions"
 	GetOrganizationsRequest                              = "GetOrganizations"
+	GetOrganizationUsersByRoleRequest                    = "GetOrganizationUsersByRole"
 	GetPrivateDomainRequest                              = "GetPrivateDomain"
 	GetRouteAppsRequest                                  = "GetRouteApps"
 	GetRouteReservedDeprecatedRequest                    = "GetRouteReservedDeprecated"
@@ -43,38 +52,62 @@ const (
 	GetSecurityGroupSpacesRequest                        = "GetSecurityGroupSpaces"
 	GetSecurityGroupsRequest                             = "GetSecurityGroups"
 	GetSecurityGroupStagingSpacesRequest                 = "GetSecurityGroupStagingSpaces"
+	GetServiceBindingParametersRequest                   = "GetServiceBindingParameters"
 	GetServiceBindingsRequest                            = "GetServiceBindings"
+	GetServiceBrokersRequest                             = "GetServiceBrokers"
 	GetServiceInstanceRequest                            = "GetServiceInstance"
 	GetServiceInstanceServiceBindingsRequest             = "GetServiceInstanceServiceBindings"
+	GetServiceInstanceServiceKeysRequest                 = "GetServiceInstanceServiceKeys"
 	GetServiceInstanceSharedFromRequest                  = "GetServiceInstanceSharedFrom"
 	GetServiceInstanceSharedToRequest                    = "GetServiceInstanceSharedTo"
 	GetServiceInstancesRequest                           = "GetServiceInstances"
+	GetServiceKeyParametersRequest                       = "GetServiceKeyParameters"
 	GetServicePlanRequest                                = "GetServicePlan"
+	GetServicePlanVisibilitiesRequest                    = "GetServicePlanVisibilities"
+	GetServicePlansRequest                               = "GetServicePlans"
 	GetServiceRequest                                    = "GetService"
+	GetServicesRequest                                   = "GetServices"
 	GetSharedDomainRequest                               = "GetSharedDomain"
 	GetSharedDomainsRequest                              = "GetSharedDomains"
 	GetSpaceQuotaDefinitionRequest                       = "GetSpaceQuotaDefinition"
 	GetSpaceRoutesRequest                                = "GetSpaceRoutes"
 	GetSpaceSecurityGroupsRequest                        = "GetSpaceSecurityGroups"
 	GetSpaceServiceInstancesRequest                      = "GetSpaceServiceInstances"
+	GetSpaceServicesRequest                              = "GetSpaceServices"
 	GetSpacesRequest                                     = "GetSpaces"
 	GetSpaceStagingSecurityGroupsRequest                 = "GetSpaceStagingSecurityGroups"
+	GetSpaceUsersByRoleRequest                           = "GetSpaceUsersByRole"
 	GetStackRequest                                      = "GetStack"
 	GetStacksRequest                                     = "GetStacks"
 	GetUserProvidedServiceInstanceServiceBindingsRequest = "GetUserProvidedServiceInstanceServiceBindings"
+	GetUserProvidedServiceInstancesRequest               = "GetUserProvidedServiceInstances"
+	GetUserOrganizationsByRoleRequest                    = "GetUserOrganizationsByRole"
+	GetUserSpacesByRoleRequest                           = "GetUserSpacesByRole"
 	GetUsersRequest                                      = "GetUsers"
 	PostAppRequest                                       = "PostApp"
 	PostAppRestageRequest                                = "PostAppRestage"
+	PostOrganizationRequest                              = "PostOrganization"
+	PostOrganizationUserByRoleRemoveRequest              = "PostOrganizationUserByRoleRemove"
 	PostRouteRequest                                     = "PostRoute"
 	PostServiceBindingRequest                            = "PostServiceBinding"
+	PostServiceKeyRequest                                = "PostServiceKey"
+	PostServicePlanVisibilityRequest                     = "PostServicePlanVisibility"
+	PostSpaceRequest                                     = "PostSpace"
+	PostSpaceUserByRoleRemoveRequest                     = "PostSpaceUserByRoleRemove"
+	PostUserProvidedServiceInstancesRequest              = "PostUserProvidedServiceInstances"
 	PostUserRequest                                      = "PostUser"
 	PutAppBitsRequest                                    = "PutAppBits"
 	PutAppRequest                                        = "PutApp"
 	PutDropletRequest                                    = "PutDroplet"
+	PutOrganizationRequest                               = "PutOrganization"
+	PutOrganizationUserByRoleRequest                     = "PutOrganizationUserByRole"
 	PutResourceMatchRequest                              = "PutResourceMatch"
 	PutRouteAppRequest                                   = "PutRouteApp"
 	PutSecurityGroupSpaceRequest                         = "PutSecurityGroupSpace"
 	PutSecurityGroupStagingSpaceRequest                  = "PutSecurityGroupStagingSpace"
+	PutServicePlanRequest                                = "PutServicePlan"
+	PutSpaceQuotaDefinitionSpaceRequest                  = "PutSpaceQuotaDefinitionSpace"
+	PutSpaceUserByRoleRequest                            = "PutSpaceUserByRole"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -82,6 +115,7 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
@@ -90,14 +124,22 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
 	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodGet, Name: GetOrganizationUsersByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodPut, Name: PutOrganizationUserByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role/remove", Method: http.MethodPost, Name: PostOrganizationUserByRoleRemoveRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotaDefinitionsRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatchRequest},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
@@ -119,24 +161,48 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodGet, Name: GetServiceBindingsRequest},
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
+	{Path: "/v2/service_bindings/:service_binding_guid/parameters", Method: http.MethodGet, Name: GetServiceBindingParametersRequest},
+	{Path: "/v2/service_brokers", Method: http.MethodGet, Name: GetServiceBrokersRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetServiceInstanceServiceBindingsRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/service_keys", Method: http.MethodGet, Name: GetServiceInstanceServiceKeysRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_keys", Method: http.MethodPost, Name: PostServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid/parameters", Method: http.MethodGet, Name: GetServiceKeyParametersRequest},
+	{Path: "/v2/service_plan_visibilities", Method: http.MethodGet, Name: GetServicePlanVisibilitiesRequest},
+	{Path: "/v2/service_plan_visibilities", Method: http.MethodPost, Name: PostServicePlanVisibilityRequest},
+	{Path: "/v2/service_plan_visibilities/:service_plan_visibility_guid", Method: http.MethodDelete, Name: DeleteServicePlanVisibilityRequest},
+	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
 	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
+	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodPut, Name: PutServicePlanRequest},
+	{Path: "/v2/services", Method: http.MethodGet, Name: GetServicesRequest},
 	{Path: "/v2/services/:service_guid", Method: http.MethodGet, Name: GetServiceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionSpaceRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodGet, Name: GetSpaceUsersByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodPut, Name: PutSpaceUserByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role/remove", Method: http.MethodPost, Name: PostSpaceUserByRoleRemoveRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceSecurityGroupsRequest},
+	{Path: "/v2/spaces/:space_guid/services", Method: http.MethodGet, Name: GetSpaceServicesRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
+	{Path: "/v2/user_provided_service_instances", Method: http.MethodGet, Name: GetUserProvidedServiceInstancesRequest},
+	{Path: "/v2/user_provided_service_instances", Method: http.MethodPost, Name: PostUserProvidedServiceInstancesRequest},
+	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid", Method: http.MethodDelete, Name: DeleteUserProvidedServiceInstanceRequest},
 	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetUserProvidedServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
+	{Path: "/v2/users/:user_guid/:role", Method: http.MethodGet, Name: GetUserOrganizationsByRoleRequest},
+	{Path: "/v2/users/:user_guid/:role", Method: http.MethodGet, Name: GetUserSpacesByRoleRequest},
 }
@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
@@ -49,6 +52,36 @@ func (org *Organization) UnmarshalJSON(data []byte) error {
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid.go.template delete_organization.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid_test.go.template delete_organization_test.go
 
+// CreateOrganization creates an organization with the provided name. When
+// quotaGUID is empty, the organization is given the default quota.
+func (client *Client) CreateOrganization(orgName string, quotaGUID string) (Organization, Warnings, error) {
+	body := map[string]string{"name": orgName}
+	if quotaGUID != "" {
+		body["quota_definition_guid"] = quotaGUID
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
 // GetOrganization returns an Organization associated with the provided GUID.
 func (client *Client) GetOrganization(guid string) (Organization, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -97,3 +130,25 @@ func (client *Client) GetOrganizations(filters ...Filter) ([]Organization, Warni
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganizationQuota assigns the quota with the provided GUID to the
+// organization.
+func (client *Client) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{"quota_definition_guid": quotaGUID})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
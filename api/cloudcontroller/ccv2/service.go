@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -20,6 +21,18 @@ type Service struct {
 	DocumentationURL string
 	// Extra is a field with extra data pertaining to the service.
 	Extra ServiceExtra
+	// Tags is a list of keywords describing the service.
+	Tags []string
+	// Bindable is true if instances of the service can be bound to
+	// applications, unless overridden by the service plan.
+	Bindable bool
+	// ServiceBrokerName is the name of the service broker that provides the
+	// service.
+	ServiceBrokerName string
+
+	// ServiceBrokerGUID is the GUID of the service broker that provides the
+	// service.
+	ServiceBrokerGUID string
 }
 
 // ServiceExtra contains extra service related properties.
@@ -34,10 +47,14 @@ func (service *Service) UnmarshalJSON(data []byte) error {
 	var ccService struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Label            string `json:"label"`
-			Description      string `json:"description"`
-			DocumentationURL string `json:"documentation_url"`
-			Extra            string `json:"extra"`
+			Label             string   `json:"label"`
+			Description       string   `json:"description"`
+			DocumentationURL  string   `json:"documentation_url"`
+			Extra             string   `json:"extra"`
+			Tags              []string `json:"tags"`
+			Bindable          bool     `json:"bindable"`
+			ServiceBrokerName string   `json:"service_broker_name"`
+			ServiceBrokerGUID string   `json:"service_broker_guid"`
 		}
 	}
 
@@ -50,6 +67,10 @@ func (service *Service) UnmarshalJSON(data []byte) error {
 	service.Label = ccService.Entity.Label
 	service.Description = ccService.Entity.Description
 	service.DocumentationURL = ccService.Entity.DocumentationURL
+	service.Tags = ccService.Entity.Tags
+	service.Bindable = ccService.Entity.Bindable
+	service.ServiceBrokerName = ccService.Entity.ServiceBrokerName
+	service.ServiceBrokerGUID = ccService.Entity.ServiceBrokerGUID
 
 	// We explicitly unmarshal the Extra field to type string because CC returns
 	// a stringified JSON object ONLY for the 'extra' key (see test stub JSON
@@ -86,3 +107,48 @@ func (client *Client) GetService(serviceGUID string) (Service, Warnings, error)
 	err = client.connection.Make(request, &response)
 	return service, response.Warnings, err
 }
+
+// GetServices returns a list of Services based off of the provided filters.
+func (client *Client) GetServices(filters ...Filter) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicesRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateServices(request)
+}
+
+// GetSpaceServices returns a list of Services that are available in the given
+// space, based off of the provided filters.
+func (client *Client) GetSpaceServices(spaceGUID string, filters ...Filter) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceServicesRequest,
+		URIParams:   Params{"space_guid": spaceGUID},
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateServices(request)
+}
+
+func (client *Client) paginateServices(request *cloudcontroller.Request) ([]Service, Warnings, error) {
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
@@ -19,6 +19,9 @@ type ServiceBinding struct {
 	AppGUID string
 	// ServiceInstanceGUID is the associated service GUID.
 	ServiceInstanceGUID string
+	// Credentials are the credentials the service broker or user provided
+	// for the binding.
+	Credentials map[string]interface{}
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
@@ -26,9 +29,10 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			AppGUID             string `json:"app_guid"`
-			ServiceInstanceGUID string `json:"service_instance_guid"`
-			Name                string `json:"name"`
+			AppGUID             string                 `json:"app_guid"`
+			ServiceInstanceGUID string                 `json:"service_instance_guid"`
+			Name                string                 `json:"name"`
+			Credentials         map[string]interface{} `json:"credentials"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccServiceBinding)
@@ -40,6 +44,7 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
 	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
 	serviceBinding.Name = ccServiceBinding.Entity.Name
+	serviceBinding.Credentials = ccServiceBinding.Entity.Credentials
 	return nil
 }
 
@@ -113,6 +118,28 @@ func (client *Client) GetServiceBindings(filters ...Filter) ([]ServiceBinding, W
 	return fullBindingsList, warnings, err
 }
 
+// GetServiceBindingParameters returns the parameters the service broker
+// stored for the provided service binding. Brokers that do not support
+// fetching binding parameters result in a
+// ServiceFetchBindingParametersNotSupportedError.
+func (client *Client) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBindingParametersRequest,
+		URIParams:   map[string]string{"service_binding_guid": serviceBindingGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // DeleteServiceBinding will destroy the requested Service Binding.
 func (client *Client) DeleteServiceBinding(serviceBindingGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -133,7 +134,10 @@ var _ = Describe("Service Binding", func() {
 						},
 						"entity": {
 							"app_guid":"app-guid-1",
-							"service_instance_guid": "service-instance-guid-1"
+							"service_instance_guid": "service-instance-guid-1",
+							"credentials": {
+								"username": "some-user"
+							}
 						}
 					},
 					{
@@ -193,7 +197,7 @@ var _ = Describe("Service Binding", func() {
 				})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(serviceBindings).To(ConsistOf([]ServiceBinding{
-					{GUID: "service-binding-guid-1", AppGUID: "app-guid-1", ServiceInstanceGUID: "service-instance-guid-1"},
+					{GUID: "service-binding-guid-1", AppGUID: "app-guid-1", ServiceInstanceGUID: "service-instance-guid-1", Credentials: map[string]interface{}{"username": "some-user"}},
 					{GUID: "service-binding-guid-2", AppGUID: "app-guid-2", ServiceInstanceGUID: "service-instance-guid-2"},
 					{GUID: "service-binding-guid-3", AppGUID: "app-guid-3", ServiceInstanceGUID: "service-instance-guid-3"},
 					{GUID: "service-binding-guid-4", AppGUID: "app-guid-4", ServiceInstanceGUID: "service-instance-guid-4"},
@@ -203,6 +207,59 @@ var _ = Describe("Service Binding", func() {
 		})
 	})
 
+	Describe("GetServiceBindingParameters", func() {
+		Context("when the service broker supports fetching binding parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"some-key": "some-value",
+					"some-object": {
+						"nested-key": 1
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid/parameters"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the parameters and warnings", func() {
+				parameters, warnings, err := client.GetServiceBindingParameters("some-service-binding-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{
+					"some-key":    "some-value",
+					"some-object": map[string]interface{}{"nested-key": json.Number("1")},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service broker does not support fetching binding parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 90008,
+					"description": "This service does not support fetching service binding parameters.",
+					"error_code": "CF-ServiceFetchBindingParametersNotSupported"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid/parameters"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceFetchBindingParametersNotSupportedError and warnings", func() {
+				_, warnings, err := client.GetServiceBindingParameters("some-service-binding-guid")
+				Expect(err).To(MatchError(ccerror.ServiceFetchBindingParametersNotSupportedError{
+					Message: "This service does not support fetching service binding parameters.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("DeleteServiceBinding", func() {
 		Context("when the service binding exist", func() {
 			BeforeEach(func() {
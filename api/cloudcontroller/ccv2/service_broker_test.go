@@ -0,0 +1,66 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Broker", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceBrokers", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_brokers?q=name:some-broker&page=2",
+				"resources": [
+					{
+						"metadata": {"guid": "some-broker-guid-1"},
+						"entity": {"name": "some-broker", "broker_url": "https://broker-1.example.com"}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "some-broker-guid-2"},
+						"entity": {"name": "some-broker", "broker_url": "https://broker-2.example.com", "space_guid": "some-space-guid"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_brokers", "q=name:some-broker"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_brokers", "q=name:some-broker&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried service brokers and warnings", func() {
+			brokers, warnings, err := client.GetServiceBrokers(Filter{
+				Type:     constant.NameFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-broker"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(brokers).To(Equal([]ServiceBroker{
+				{GUID: "some-broker-guid-1", Name: "some-broker", BrokerURL: "https://broker-1.example.com"},
+				{GUID: "some-broker-guid-2", Name: "some-broker", BrokerURL: "https://broker-2.example.com", SpaceGUID: "some-space-guid"},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
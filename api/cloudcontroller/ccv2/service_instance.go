@@ -1,6 +1,8 @@
 package ccv2
 
 import (
+	"net/url"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
@@ -49,7 +51,7 @@ type LastOperation struct {
 
 	// State is the status of the last operation or current operation being
 	// performed on the service instance.
-	State string
+	State constant.LastOperationState
 
 	// Description is the service broker-provided description of the operation.
 	Description string
@@ -76,11 +78,11 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 			Tags            []string `json:"tags"`
 			DashboardURL    string   `json:"dashboard_url"`
 			LastOperation   struct {
-				Type        string `json:"type"`
-				State       string `json:"state"`
-				Description string `json:"description"`
-				UpdatedAt   string `json:"updated_at"`
-				CreatedAt   string `json:"created_at"`
+				Type        string                      `json:"type"`
+				State       constant.LastOperationState `json:"state"`
+				Description string                      `json:"description"`
+				UpdatedAt   string                      `json:"updated_at"`
+				CreatedAt   string                      `json:"created_at"`
 			} `json:"last_operation"`
 		}
 	}
@@ -193,3 +195,33 @@ func (client *Client) GetSpaceServiceInstances(spaceGUID string, includeUserProv
 
 	return fullInstancesList, warnings, err
 }
+
+// acceptsIncomplete is the query that allows the service broker to provision,
+// update or deprovision a service instance asynchronously. The progress of an
+// asynchronous operation is reported in the service instance's
+// LastOperation.
+var acceptsIncomplete = url.Values{"accepts_incomplete": {"true"}}
+
+// DeleteServiceInstance deletes the service instance with the given GUID.
+// When the service broker deletes the instance asynchronously, the returned
+// service instance describes the operation in progress; otherwise it is empty.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       acceptsIncomplete,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil || len(response.RawResponse) == 0 {
+		return ServiceInstance{}, response.Warnings, err
+	}
+
+	var serviceInstance ServiceInstance
+	err = cloudcontroller.DecodeJSON(response.RawResponse, &serviceInstance)
+	return serviceInstance, response.Warnings, err
+}
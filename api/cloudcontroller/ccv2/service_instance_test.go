@@ -438,4 +438,63 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+
+	Describe("DeleteServiceInstance", func() {
+		Context("when the service broker deletes the instance synchronously", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an empty service instance and warnings", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service broker deletes the instance asynchronously", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusAccepted, `{"metadata": {"guid": "some-service-instance-guid"}, "entity": {"last_operation": {"type": "delete", "state": "in progress"}}}`),
+					),
+				)
+			})
+
+			It("returns the service instance with the operation in progress", func() {
+				serviceInstance, _, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance.GUID).To(Equal("some-service-instance-guid"))
+				Expect(serviceInstance.LastOperation).To(Equal(LastOperation{Type: "delete", State: "in progress"}))
+			})
+		})
+
+		Context("when the service instance has bindings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10006,
+					"description": "Please delete the service_bindings, service_keys, and routes associations for your service_instances.",
+					"error_code": "CF-AssociationNotEmpty"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusBadRequest, response),
+					),
+				)
+			})
+
+			It("returns an AssociationNotEmptyError", func() {
+				_, _, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.AssociationNotEmptyError{Message: "Please delete the service_bindings, service_keys, and routes associations for your service_instances."}))
+			})
+		})
+	})
 })
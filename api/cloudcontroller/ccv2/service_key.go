@@ -0,0 +1,146 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceKey represents a Cloud Controller Service Key.
+type ServiceKey struct {
+	// GUID is the unique Service Key identifier.
+	GUID string
+	// Name is the name of the service key.
+	Name string
+	// ServiceInstanceGUID is the associated service instance GUID.
+	ServiceInstanceGUID string
+	// Credentials are the credentials returned by the service broker for the
+	// service key.
+	Credentials map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Key response.
+func (serviceKey *ServiceKey) UnmarshalJSON(data []byte) error {
+	var ccServiceKey struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name                string                 `json:"name"`
+			ServiceInstanceGUID string                 `json:"service_instance_guid"`
+			Credentials         map[string]interface{} `json:"credentials"`
+		} `json:"entity"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccServiceKey)
+	if err != nil {
+		return err
+	}
+
+	serviceKey.GUID = ccServiceKey.Metadata.GUID
+	serviceKey.Name = ccServiceKey.Entity.Name
+	serviceKey.ServiceInstanceGUID = ccServiceKey.Entity.ServiceInstanceGUID
+	serviceKey.Credentials = ccServiceKey.Entity.Credentials
+	return nil
+}
+
+// serviceKeyRequestBody represents the body of the service key create
+// request.
+type serviceKeyRequestBody struct {
+	ServiceInstanceGUID string                 `json:"service_instance_guid"`
+	Name                string                 `json:"name"`
+	Parameters          map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// CreateServiceKey creates a service key with the provided name for the
+// service instance. Parameters are passed to the service broker.
+func (client *Client) CreateServiceKey(serviceInstanceGUID string, keyName string, parameters map[string]interface{}) (ServiceKey, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceKeyRequestBody{
+		ServiceInstanceGUID: serviceInstanceGUID,
+		Name:                keyName,
+		Parameters:          parameters,
+	})
+	if err != nil {
+		return ServiceKey{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceKeyRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServiceKey{}, nil, err
+	}
+
+	var serviceKey ServiceKey
+	response := cloudcontroller.Response{
+		Result: &serviceKey,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceKey, response.Warnings, err
+}
+
+// DeleteServiceKey deletes the service key with the given GUID.
+func (client *Client) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceKeyRequest,
+		URIParams:   Params{"service_key_guid": serviceKeyGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetServiceKeyParameters returns the parameters the service broker stored
+// for the provided service key. Brokers that do not support fetching binding
+// parameters result in a ServiceFetchBindingParametersNotSupportedError.
+func (client *Client) GetServiceKeyParameters(serviceKeyGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeyParametersRequest,
+		URIParams:   Params{"service_key_guid": serviceKeyGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
+// GetServiceInstanceServiceKeys returns back a list of Service Keys for the
+// provided service instance GUID based off of the provided filters.
+func (client *Client) GetServiceInstanceServiceKeys(serviceInstanceGUID string, filters ...Filter) ([]ServiceKey, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceServiceKeysRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullKeysList []ServiceKey
+	warnings, err := client.paginate(request, ServiceKey{}, func(item interface{}) error {
+		if key, ok := item.(ServiceKey); ok {
+			fullKeysList = append(fullKeysList, key)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceKey{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullKeysList, warnings, err
+}
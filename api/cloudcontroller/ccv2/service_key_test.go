@@ -0,0 +1,191 @@
+package ccv2_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Key", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateServiceKey", func() {
+		BeforeEach(func() {
+			expectedRequestBody := map[string]interface{}{
+				"service_instance_guid": "some-service-instance-guid",
+				"name":                  "some-key-v2",
+				"parameters": map[string]interface{}{
+					"some-key": "some-value",
+				},
+			}
+			response := `{
+				"metadata": {
+					"guid": "some-service-key-guid"
+				},
+				"entity": {
+					"name": "some-key-v2",
+					"service_instance_guid": "some-service-instance-guid",
+					"credentials": {
+						"password": "secret"
+					}
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/service_keys"),
+					VerifyJSONRepresenting(expectedRequestBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the created service key and warnings", func() {
+			serviceKey, warnings, err := client.CreateServiceKey("some-service-instance-guid", "some-key-v2", map[string]interface{}{"some-key": "some-value"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			Expect(serviceKey).To(Equal(ServiceKey{
+				GUID:                "some-service-key-guid",
+				Name:                "some-key-v2",
+				ServiceInstanceGUID: "some-service-instance-guid",
+				Credentials:         map[string]interface{}{"password": "secret"},
+			}))
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service key", func() {
+			warnings, err := client.DeleteServiceKey("some-service-key-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("GetServiceKeyParameters", func() {
+		Context("when the service broker supports fetching binding parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"some-key": "some-value",
+					"some-object": {
+						"nested-key": 1
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid/parameters"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the parameters and warnings", func() {
+				parameters, warnings, err := client.GetServiceKeyParameters("some-service-key-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{
+					"some-key":    "some-value",
+					"some-object": map[string]interface{}{"nested-key": json.Number("1")},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service broker does not support fetching binding parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 90008,
+					"description": "This service does not support fetching service binding parameters.",
+					"error_code": "CF-ServiceFetchBindingParametersNotSupported"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid/parameters"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceFetchBindingParametersNotSupportedError and warnings", func() {
+				_, warnings, err := client.GetServiceKeyParameters("some-service-key-guid")
+				Expect(err).To(MatchError(ccerror.ServiceFetchBindingParametersNotSupportedError{
+					Message: "This service does not support fetching service binding parameters.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceServiceKeys", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_instances/some-service-instance-guid/service_keys?q=name:some-key&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-key-guid-1"
+						},
+						"entity": {
+							"name": "some-key",
+							"service_instance_guid": "some-service-instance-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-key-guid-2"
+						},
+						"entity": {
+							"name": "some-key",
+							"service_instance_guid": "some-service-instance-guid"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/service_keys", "q=name:some-key"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/service_keys", "q=name:some-key&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the service keys and warnings", func() {
+			serviceKeys, warnings, err := client.GetServiceInstanceServiceKeys("some-service-instance-guid", Filter{
+				Type:     constant.NameFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-key"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			Expect(serviceKeys).To(ConsistOf(
+				ServiceKey{GUID: "some-service-key-guid-1", Name: "some-key", ServiceInstanceGUID: "some-service-instance-guid"},
+				ServiceKey{GUID: "some-service-key-guid-2", Name: "some-key", ServiceInstanceGUID: "some-service-instance-guid"},
+			))
+		})
+	})
+})
@@ -1,8 +1,13 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // ServicePlan represents a predefined set of configurations for a Cloud
@@ -17,6 +22,49 @@ type ServicePlan struct {
 	// ServiceGUID is the unique identifier of the service that the service
 	// plan belongs to.
 	ServiceGUID string
+
+	// Free is true if the service plan does not incur a cost.
+	Free bool
+
+	// Public is true if the service plan is visible to every organization.
+	Public bool
+
+	// Description is a short blurb describing the service plan.
+	Description string
+
+	// Bindable is set when the service plan overrides whether its service
+	// instances can be bound to applications.
+	Bindable types.NullBool
+
+	// Extra is a field with extra data pertaining to the service plan.
+	Extra ServicePlanExtra
+
+	// Schemas are the JSON schemas the service broker provides for the
+	// parameters of the service plan's service instances and bindings.
+	Schemas ServicePlanSchemas
+}
+
+// ServicePlanExtra contains extra service plan related properties.
+type ServicePlanExtra struct {
+	// Costs are the costs of the service plan.
+	Costs []ServicePlanCost `json:"costs"`
+}
+
+// ServicePlanCost is a cost of a service plan, with one amount per currency.
+type ServicePlanCost struct {
+	// Amount maps currency codes to the amount charged per unit.
+	Amount map[string]float64 `json:"amount"`
+
+	// Unit is the unit the amount is charged for, such as MONTHLY.
+	Unit string `json:"unit"`
+}
+
+// ServicePlanSchemas contains the JSON schemas of the parameters accepted when
+// creating or updating a service instance and when creating a service binding.
+type ServicePlanSchemas struct {
+	ServiceInstanceCreateParameters map[string]interface{}
+	ServiceInstanceUpdateParameters map[string]interface{}
+	ServiceBindingCreateParameters  map[string]interface{}
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
@@ -24,8 +72,28 @@ func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
 	var ccServicePlan struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name        string `json:"name"`
-			ServiceGUID string `json:"service_guid"`
+			Name        string         `json:"name"`
+			ServiceGUID string         `json:"service_guid"`
+			Free        bool           `json:"free"`
+			Public      bool           `json:"public"`
+			Description string         `json:"description"`
+			Bindable    types.NullBool `json:"bindable"`
+			Extra       string         `json:"extra"`
+			Schemas     struct {
+				ServiceInstance struct {
+					Create struct {
+						Parameters map[string]interface{} `json:"parameters"`
+					} `json:"create"`
+					Update struct {
+						Parameters map[string]interface{} `json:"parameters"`
+					} `json:"update"`
+				} `json:"service_instance"`
+				ServiceBinding struct {
+					Create struct {
+						Parameters map[string]interface{} `json:"parameters"`
+					} `json:"create"`
+				} `json:"service_binding"`
+			} `json:"schemas"`
 		}
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccServicePlan)
@@ -36,6 +104,25 @@ func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
 	servicePlan.GUID = ccServicePlan.Metadata.GUID
 	servicePlan.Name = ccServicePlan.Entity.Name
 	servicePlan.ServiceGUID = ccServicePlan.Entity.ServiceGUID
+	servicePlan.Free = ccServicePlan.Entity.Free
+	servicePlan.Public = ccServicePlan.Entity.Public
+	servicePlan.Description = ccServicePlan.Entity.Description
+	servicePlan.Bindable = ccServicePlan.Entity.Bindable
+	servicePlan.Schemas = ServicePlanSchemas{
+		ServiceInstanceCreateParameters: ccServicePlan.Entity.Schemas.ServiceInstance.Create.Parameters,
+		ServiceInstanceUpdateParameters: ccServicePlan.Entity.Schemas.ServiceInstance.Update.Parameters,
+		ServiceBindingCreateParameters:  ccServicePlan.Entity.Schemas.ServiceBinding.Create.Parameters,
+	}
+
+	// Like the service's 'extra' field, the plan's 'extra' field is a
+	// stringified JSON object.
+	if len(ccServicePlan.Entity.Extra) != 0 {
+		err = json.Unmarshal([]byte(ccServicePlan.Entity.Extra), &servicePlan.Extra)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -57,3 +144,53 @@ func (client *Client) GetServicePlan(servicePlanGUID string) (ServicePlan, Warni
 	err = client.connection.Make(request, &response)
 	return servicePlan, response.Warnings, err
 }
+
+// GetServicePlans returns a list of Service Plans based off of the provided
+// filters.
+func (client *Client) GetServicePlans(filters ...Filter) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicePlansList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if servicePlan, ok := item.(ServicePlan); ok {
+			fullServicePlansList = append(fullServicePlansList, servicePlan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicePlansList, warnings, err
+}
+
+// UpdateServicePlan makes the service plan with the given GUID visible to
+// every organization when public is true, and only to the organizations it
+// has visibilities for otherwise.
+func (client *Client) UpdateServicePlan(servicePlanGUID string, public bool) (Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]bool{"public": public})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutServicePlanRequest,
+		URIParams:   Params{"service_plan_guid": servicePlanGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
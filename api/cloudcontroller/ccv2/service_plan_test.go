@@ -5,6 +5,8 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -73,4 +75,119 @@ var _ = Describe("Service Plan", func() {
 			})
 		})
 	})
+
+	Describe("GetServicePlans", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "some-plan-guid-1"},
+						"entity": {"name": "small", "service_guid": "some-service-guid", "free": true, "public": true}
+					},
+					{
+						"metadata": {"guid": "some-plan-guid-2"},
+						"entity": {
+							"name": "large",
+							"service_guid": "some-service-guid",
+							"free": false,
+							"description": "a large plan",
+							"bindable": false,
+							"extra": "{\"costs\":[{\"amount\":{\"usd\":99.5},\"unit\":\"MONTHLY\"}]}",
+							"schemas": {
+								"service_instance": {
+									"create": {
+										"parameters": {"type": "object"}
+									},
+									"update": {
+										"parameters": {}
+									}
+								},
+								"service_binding": {
+									"create": {
+										"parameters": {"type": "string"}
+									}
+								}
+							}
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the queried service plans and warnings", func() {
+			servicePlans, warnings, err := client.GetServicePlans(Filter{
+				Type:     constant.ServiceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-service-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(servicePlans).To(Equal([]ServicePlan{
+				{GUID: "some-plan-guid-1", Name: "small", ServiceGUID: "some-service-guid", Free: true, Public: true},
+				{
+					GUID:        "some-plan-guid-2",
+					Name:        "large",
+					ServiceGUID: "some-service-guid",
+					Description: "a large plan",
+					Bindable:    types.NullBool{IsSet: true, Value: false},
+					Extra: ServicePlanExtra{
+						Costs: []ServicePlanCost{{Amount: map[string]float64{"usd": 99.5}, Unit: "MONTHLY"}},
+					},
+					Schemas: ServicePlanSchemas{
+						ServiceInstanceCreateParameters: map[string]interface{}{"type": "object"},
+						ServiceInstanceUpdateParameters: map[string]interface{}{},
+						ServiceBindingCreateParameters:  map[string]interface{}{"type": "string"},
+					},
+				},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("UpdateServicePlan", func() {
+		Context("when the update succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/service_plans/some-plan-guid"),
+						VerifyJSON(`{"public": true}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("updates the plan's visibility and returns warnings", func() {
+				warnings, err := client.UpdateServicePlan("some-plan-guid", true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/service_plans/some-plan-guid"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateServicePlan("some-plan-guid", false)
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
@@ -0,0 +1,114 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServicePlanVisibility represents the visibility of a non-public service
+// plan to an organization.
+type ServicePlanVisibility struct {
+	// GUID is the unique Service Plan Visibility identifier.
+	GUID string
+	// ServicePlanGUID is the GUID of the service plan that is visible.
+	ServicePlanGUID string
+	// OrganizationGUID is the GUID of the organization the service plan is
+	// visible to.
+	OrganizationGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan Visibility
+// response.
+func (visibility *ServicePlanVisibility) UnmarshalJSON(data []byte) error {
+	var ccVisibility struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			ServicePlanGUID  string `json:"service_plan_guid"`
+			OrganizationGUID string `json:"organization_guid"`
+		} `json:"entity"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccVisibility)
+	if err != nil {
+		return err
+	}
+
+	visibility.GUID = ccVisibility.Metadata.GUID
+	visibility.ServicePlanGUID = ccVisibility.Entity.ServicePlanGUID
+	visibility.OrganizationGUID = ccVisibility.Entity.OrganizationGUID
+	return nil
+}
+
+// CreateServicePlanVisibility makes the service plan visible to the
+// organization.
+func (client *Client) CreateServicePlanVisibility(servicePlanGUID string, organizationGUID string) (ServicePlanVisibility, Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{
+		"service_plan_guid": servicePlanGUID,
+		"organization_guid": organizationGUID,
+	})
+	if err != nil {
+		return ServicePlanVisibility{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServicePlanVisibilityRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServicePlanVisibility{}, nil, err
+	}
+
+	var visibility ServicePlanVisibility
+	response := cloudcontroller.Response{
+		Result: &visibility,
+	}
+
+	err = client.connection.Make(request, &response)
+	return visibility, response.Warnings, err
+}
+
+// DeleteServicePlanVisibility deletes the service plan visibility with the
+// given GUID.
+func (client *Client) DeleteServicePlanVisibility(servicePlanVisibilityGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServicePlanVisibilityRequest,
+		URIParams:   Params{"service_plan_visibility_guid": servicePlanVisibilityGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetServicePlanVisibilities returns back a list of Service Plan Visibilities
+// based off of the provided filters.
+func (client *Client) GetServicePlanVisibilities(filters ...Filter) ([]ServicePlanVisibility, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanVisibilitiesRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullVisibilitiesList []ServicePlanVisibility
+	warnings, err := client.paginate(request, ServicePlanVisibility{}, func(item interface{}) error {
+		if visibility, ok := item.(ServicePlanVisibility); ok {
+			fullVisibilitiesList = append(fullVisibilitiesList, visibility)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlanVisibility{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullVisibilitiesList, warnings, err
+}
@@ -0,0 +1,104 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan Visibility", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateServicePlanVisibility", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {"guid": "some-visibility-guid"},
+				"entity": {"service_plan_guid": "some-plan-guid", "organization_guid": "some-org-guid"}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/service_plan_visibilities"),
+					VerifyJSONRepresenting(map[string]string{
+						"service_plan_guid": "some-plan-guid",
+						"organization_guid": "some-org-guid",
+					}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the visibility and returns it with warnings", func() {
+			visibility, warnings, err := client.CreateServicePlanVisibility("some-plan-guid", "some-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(visibility).To(Equal(ServicePlanVisibility{
+				GUID:             "some-visibility-guid",
+				ServicePlanGUID:  "some-plan-guid",
+				OrganizationGUID: "some-org-guid",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("DeleteServicePlanVisibility", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_plan_visibilities/some-visibility-guid"),
+					RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the visibility and returns warnings", func() {
+			warnings, err := client.DeleteServicePlanVisibility("some-visibility-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("GetServicePlanVisibilities", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "some-visibility-guid-1"},
+						"entity": {"service_plan_guid": "some-plan-guid", "organization_guid": "some-org-guid-1"}
+					},
+					{
+						"metadata": {"guid": "some-visibility-guid-2"},
+						"entity": {"service_plan_guid": "some-plan-guid", "organization_guid": "some-org-guid-2"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plan_visibilities", "q=service_plan_guid:some-plan-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the queried visibilities and warnings", func() {
+			visibilities, warnings, err := client.GetServicePlanVisibilities(Filter{
+				Type:     constant.ServicePlanGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-plan-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(visibilities).To(Equal([]ServicePlanVisibility{
+				{GUID: "some-visibility-guid-1", ServicePlanGUID: "some-plan-guid", OrganizationGUID: "some-org-guid-1"},
+				{GUID: "some-visibility-guid-2", ServicePlanGUID: "some-plan-guid", OrganizationGUID: "some-org-guid-2"},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
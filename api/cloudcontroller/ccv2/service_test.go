@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -29,6 +30,10 @@ var _ = Describe("Service", func() {
 							"label": "some-service",
 							"description": "some-description",
 							"documentation_url": "some-url",
+							"tags": ["some-tag"],
+							"bindable": true,
+							"service_broker_name": "some-broker",
+							"service_broker_guid": "some-broker-guid",
 							"extra": "{\"provider\":{\"name\":\"The name\"},\"listing\":{\"imageUrl\":\"http://catgifpage.com/cat.gif\",\"blurb\":\"fake broker that is fake\",\"longDescription\":\"A long time ago, in a galaxy far far away...\"},\"displayName\":\"The Fake Broker\",\"shareable\":true}"
 						}
 					}`
@@ -52,6 +57,10 @@ var _ = Describe("Service", func() {
 						Extra: ServiceExtra{
 							Shareable: true,
 						},
+						Tags:              []string{"some-tag"},
+						Bindable:          true,
+						ServiceBrokerName: "some-broker",
+						ServiceBrokerGUID: "some-broker-guid",
 					}))
 					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				})
@@ -164,4 +173,82 @@ var _ = Describe("Service", func() {
 			})
 		})
 	})
+
+	Describe("GetServices", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/services?q=label:some-service&page=2",
+				"resources": [
+					{
+						"metadata": {"guid": "some-service-guid-1"},
+						"entity": {"label": "some-service", "description": "first"}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "some-service-guid-2"},
+						"entity": {"label": "some-service", "description": "second"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-service"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-service&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried services and warnings", func() {
+			services, warnings, err := client.GetServices(Filter{
+				Type:     constant.LabelFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-service"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal([]Service{
+				{GUID: "some-service-guid-1", Label: "some-service", Description: "first"},
+				{GUID: "some-service-guid-2", Label: "some-service", Description: "second"},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+
+	Describe("GetSpaceServices", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "some-service-guid"},
+						"entity": {"label": "some-service"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/services", "q=label:some-service"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the services available in the space and warnings", func() {
+			services, warnings, err := client.GetSpaceServices("some-space-guid", Filter{
+				Type:     constant.LabelFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-service"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal([]Service{{GUID: "some-service-guid", Label: "some-service"}}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
 })
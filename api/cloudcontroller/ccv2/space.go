@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
@@ -53,6 +56,34 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid.go.template delete_space.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Space codetemplates/delete_async_by_guid_test.go.template delete_space_test.go
 
+// CreateSpace creates a space with the provided name in the organization with
+// the provided GUID.
+func (client *Client) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{
+		"name":              spaceName,
+		"organization_guid": orgGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
+
 // GetSpaces returns a list of Spaces based off of the provided filters.
 func (client *Client) GetSpaces(filters ...Filter) ([]Space, Warnings, error) {
 	params := ConvertFilterParameters(filters)
@@ -2,6 +2,7 @@ package ccv2
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -52,3 +53,45 @@ func (client *Client) GetSpaceQuotaDefinition(guid string) (SpaceQuota, Warnings
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// GetSpaceQuotas returns the Space Quotas defined by the organization with the
+// provided GUID.
+func (client *Client) GetSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotaDefinitionsRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpaceQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if spaceQuota, ok := item.(SpaceQuota); ok {
+			fullSpaceQuotasList = append(fullSpaceQuotasList, spaceQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpaceQuotasList, warnings, err
+}
+
+// SetSpaceQuota assigns the Space Quota with the provided GUID to the space.
+func (client *Client) SetSpaceQuota(spaceGUID string, quotaGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionSpaceRequest,
+		URIParams:   Params{"space_quota_guid": quotaGUID, "space_guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -71,4 +71,103 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "space-quota-guid-1"},
+							"entity": {"name": "space-quota-1"}
+						},
+						{
+							"metadata": {"guid": "space-quota-guid-2"},
+							"entity": {"name": "space-quota-2"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the space quotas of the organization and all warnings", func() {
+				spaceQuotas, warnings, err := client.GetSpaceQuotas("some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaceQuotas).To(Equal([]SpaceQuota{
+					{GUID: "space-quota-guid-1", Name: "space-quota-1"},
+					{GUID: "space-quota-guid-2", Name: "space-quota-2"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the API returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetSpaceQuotas("some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("assigns the space quota to the space and returns all warnings", func() {
+				warnings, err := client.SetSpaceQuota("some-space-guid", "space-quota-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the API returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 310007,
+					"description": "Space Quota Definition could not be found: space-quota-guid",
+					"error_code": "CF-SpaceQuotaDefinitionNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.SetSpaceQuota("some-space-guid", "space-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space Quota Definition could not be found: space-quota-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
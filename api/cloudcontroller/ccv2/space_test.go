@@ -438,4 +438,58 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpace", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {"guid": "some-space-guid"},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "some-org-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSON(`{"name":"some-space","organization_guid":"some-org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("creates and returns the space and all warnings", func() {
+				space, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{
+					GUID:             "some-space-guid",
+					Name:             "some-space",
+					OrganizationGUID: "some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the API returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The app space name is taken: some-space"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -12,6 +14,10 @@ import (
 type User struct {
 	// GUID is the unique user identifier.
 	GUID string
+
+	// Username is the name of the user in UAA. It is empty for users that
+	// are not UAA users, such as clients.
+	Username string
 }
 
 // userRequestBody represents the body of the request.
@@ -23,6 +29,9 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccUser)
 	if err != nil {
@@ -30,6 +39,7 @@ func (user *User) UnmarshalJSON(data []byte) error {
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -63,3 +73,164 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// GetOrganizationUsersByRole returns the users that have the provided role in
+// the organization with the provided GUID.
+func (client *Client) GetOrganizationUsersByRole(role constant.OrganizationRole, orgGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationUsersByRoleRequest,
+		URIParams:   Params{"organization_guid": orgGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// GetSpaceUsersByRole returns the users that have the provided role in the
+// space with the provided GUID.
+func (client *Client) GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceUsersByRoleRequest,
+		URIParams:   Params{"space_guid": spaceGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// userOrganizationRelations are the names of a user's relationships to the
+// organizations it has each organization role in.
+var userOrganizationRelations = map[constant.OrganizationRole]string{
+	constant.OrgUser:           "organizations",
+	constant.OrgManager:        "managed_organizations",
+	constant.OrgBillingManager: "billing_managed_organizations",
+	constant.OrgAuditor:        "audited_organizations",
+}
+
+// userSpaceRelations are the names of a user's relationships to the spaces it
+// has each space role in.
+var userSpaceRelations = map[constant.SpaceRole]string{
+	constant.SpaceManager:   "managed_spaces",
+	constant.SpaceDeveloper: "spaces",
+	constant.SpaceAuditor:   "audited_spaces",
+}
+
+// GetUserOrganizationsByRole returns the organizations in which the user with
+// the provided GUID has the provided role.
+func (client *Client) GetUserOrganizationsByRole(role constant.OrganizationRole, userGUID string) ([]Organization, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetUserOrganizationsByRoleRequest,
+		URIParams:   Params{"user_guid": userGUID, "role": userOrganizationRelations[role]},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullOrgsList []Organization
+	warnings, err := client.paginate(request, Organization{}, func(item interface{}) error {
+		if org, ok := item.(Organization); ok {
+			fullOrgsList = append(fullOrgsList, org)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Organization{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullOrgsList, warnings, err
+}
+
+// GetUserSpacesByRole returns the spaces in which the user with the provided
+// GUID has the provided role.
+func (client *Client) GetUserSpacesByRole(role constant.SpaceRole, userGUID string) ([]Space, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetUserSpacesByRoleRequest,
+		URIParams:   Params{"user_guid": userGUID, "role": userSpaceRelations[role]},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpacesList []Space
+	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+		if space, ok := item.(Space); ok {
+			fullSpacesList = append(fullSpacesList, space)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Space{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpacesList, warnings, err
+}
+
+// UpdateOrganizationUserByRole gives the provided role in the organization
+// with the provided GUID to the user with the provided username.
+func (client *Client) UpdateOrganizationUserByRole(role constant.OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	return client.updateUserByRole(internal.PutOrganizationUserByRoleRequest, Params{"organization_guid": orgGUID, "role": string(role)}, username)
+}
+
+// UpdateSpaceUserByRole gives the provided role in the space with the
+// provided GUID to the user with the provided username. The user must be a
+// user of the space's organization.
+func (client *Client) UpdateSpaceUserByRole(role constant.SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	return client.updateUserByRole(internal.PutSpaceUserByRoleRequest, Params{"space_guid": spaceGUID, "role": string(role)}, username)
+}
+
+// DeleteOrganizationUserByRole removes the provided role in the organization
+// with the provided GUID from the user with the provided username.
+func (client *Client) DeleteOrganizationUserByRole(role constant.OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	return client.updateUserByRole(internal.PostOrganizationUserByRoleRemoveRequest, Params{"organization_guid": orgGUID, "role": string(role)}, username)
+}
+
+// DeleteSpaceUserByRole removes the provided role in the space with the
+// provided GUID from the user with the provided username.
+func (client *Client) DeleteSpaceUserByRole(role constant.SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	return client.updateUserByRole(internal.PostSpaceUserByRoleRemoveRequest, Params{"space_guid": spaceGUID, "role": string(role)}, username)
+}
+
+func (client *Client) updateUserByRole(requestName string, uriParams Params, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(map[string]string{"username": username})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) paginateUsers(request *cloudcontroller.Request) ([]User, Warnings, error) {
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
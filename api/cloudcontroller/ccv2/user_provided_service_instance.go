@@ -0,0 +1,158 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// UserProvidedServiceInstance represents a Cloud Controller User Provided
+// Service Instance.
+type UserProvidedServiceInstance struct {
+	// GUID is the unique user provided service instance identifier.
+	GUID string
+
+	// Name is the name given to the user provided service instance.
+	Name string
+
+	// SpaceGUID is the unique identifier of the space that this user provided
+	// service instance belongs to.
+	SpaceGUID string
+
+	// Credentials are the credentials exposed to bound applications.
+	Credentials map[string]interface{}
+
+	// SyslogDrainURL is the URL to which logs of bound applications are
+	// streamed.
+	SyslogDrainURL string
+
+	// RouteServiceURL is the URL to which requests for bound routes are
+	// forwarded.
+	RouteServiceURL string
+
+	// Tags is a list of all tags for the user provided service instance.
+	Tags []string
+}
+
+// MarshalJSON converts a user provided service instance into a Cloud
+// Controller User Provided Service Instance.
+func (serviceInstance UserProvidedServiceInstance) MarshalJSON() ([]byte, error) {
+	ccServiceInstance := struct {
+		Name            string                 `json:"name"`
+		SpaceGUID       string                 `json:"space_guid"`
+		Credentials     map[string]interface{} `json:"credentials,omitempty"`
+		SyslogDrainURL  string                 `json:"syslog_drain_url,omitempty"`
+		RouteServiceURL string                 `json:"route_service_url,omitempty"`
+		Tags            []string               `json:"tags,omitempty"`
+	}{
+		Name:            serviceInstance.Name,
+		SpaceGUID:       serviceInstance.SpaceGUID,
+		Credentials:     serviceInstance.Credentials,
+		SyslogDrainURL:  serviceInstance.SyslogDrainURL,
+		RouteServiceURL: serviceInstance.RouteServiceURL,
+		Tags:            serviceInstance.Tags,
+	}
+
+	return json.Marshal(ccServiceInstance)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller User Provided Service
+// Instance response.
+func (serviceInstance *UserProvidedServiceInstance) UnmarshalJSON(data []byte) error {
+	var ccServiceInstance struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name            string                 `json:"name"`
+			SpaceGUID       string                 `json:"space_guid"`
+			Credentials     map[string]interface{} `json:"credentials"`
+			SyslogDrainURL  string                 `json:"syslog_drain_url"`
+			RouteServiceURL string                 `json:"route_service_url"`
+			Tags            []string               `json:"tags"`
+		}
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccServiceInstance)
+	if err != nil {
+		return err
+	}
+
+	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
+	serviceInstance.Name = ccServiceInstance.Entity.Name
+	serviceInstance.SpaceGUID = ccServiceInstance.Entity.SpaceGUID
+	serviceInstance.Credentials = ccServiceInstance.Entity.Credentials
+	serviceInstance.SyslogDrainURL = ccServiceInstance.Entity.SyslogDrainURL
+	serviceInstance.RouteServiceURL = ccServiceInstance.Entity.RouteServiceURL
+	serviceInstance.Tags = ccServiceInstance.Entity.Tags
+	return nil
+}
+
+// CreateUserProvidedServiceInstance creates a user provided service instance
+// with the provided name, space, credentials, syslog drain URL, route service
+// URL and tags.
+func (client *Client) CreateUserProvidedServiceInstance(serviceInstance UserProvidedServiceInstance) (UserProvidedServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstance)
+	if err != nil {
+		return UserProvidedServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostUserProvidedServiceInstancesRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return UserProvidedServiceInstance{}, nil, err
+	}
+
+	var createdServiceInstance UserProvidedServiceInstance
+	response := cloudcontroller.Response{
+		Result: &createdServiceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdServiceInstance, response.Warnings, err
+}
+
+// DeleteUserProvidedServiceInstance deletes the user provided service instance
+// with the given GUID.
+func (client *Client) DeleteUserProvidedServiceInstance(userProvidedServiceInstanceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteUserProvidedServiceInstanceRequest,
+		URIParams:   Params{"user_provided_service_instance_guid": userProvidedServiceInstanceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetUserProvidedServiceInstances returns back a list of User Provided Service
+// Instances, including their credentials, based off of the provided filters.
+func (client *Client) GetUserProvidedServiceInstances(filters ...Filter) ([]UserProvidedServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetUserProvidedServiceInstancesRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullInstancesList []UserProvidedServiceInstance
+	warnings, err := client.paginate(request, UserProvidedServiceInstance{}, func(item interface{}) error {
+		if instance, ok := item.(UserProvidedServiceInstance); ok {
+			fullInstancesList = append(fullInstancesList, instance)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   UserProvidedServiceInstance{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullInstancesList, warnings, err
+}
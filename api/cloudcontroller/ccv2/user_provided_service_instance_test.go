@@ -0,0 +1,198 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("User Provided Service Instance", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				expectedRequestBody := map[string]interface{}{
+					"name":       "some-ups-name",
+					"space_guid": "some-space-guid",
+					"credentials": map[string]interface{}{
+						"username": "admin",
+					},
+					"syslog_drain_url":  "syslog://example.com",
+					"route_service_url": "https://route.example.com",
+					"tags":              []string{"tag-1"},
+				}
+				response := `{
+					"metadata": {
+						"guid": "some-ups-guid"
+					},
+					"entity": {
+						"name": "some-ups-name",
+						"space_guid": "some-space-guid",
+						"credentials": {
+							"username": "admin"
+						},
+						"syslog_drain_url": "syslog://example.com",
+						"route_service_url": "https://route.example.com",
+						"tags": ["tag-1"]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/user_provided_service_instances"),
+						VerifyJSONRepresenting(expectedRequestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created user provided service instance and warnings", func() {
+				serviceInstance, warnings, err := client.CreateUserProvidedServiceInstance(UserProvidedServiceInstance{
+					Name:            "some-ups-name",
+					SpaceGUID:       "some-space-guid",
+					Credentials:     map[string]interface{}{"username": "admin"},
+					SyslogDrainURL:  "syslog://example.com",
+					RouteServiceURL: "https://route.example.com",
+					Tags:            []string{"tag-1"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(serviceInstance).To(Equal(UserProvidedServiceInstance{
+					GUID:            "some-ups-guid",
+					Name:            "some-ups-name",
+					SpaceGUID:       "some-space-guid",
+					Credentials:     map[string]interface{}{"username": "admin"},
+					SyslogDrainURL:  "syslog://example.com",
+					RouteServiceURL: "https://route.example.com",
+					Tags:            []string{"tag-1"},
+				}))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60002,
+					"description": "The service instance name is taken: some-ups-name",
+					"error_code": "CF-ServiceInstanceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/user_provided_service_instances"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceInstanceNameTakenError and warnings", func() {
+				_, warnings, err := client.CreateUserProvidedServiceInstance(UserProvidedServiceInstance{
+					Name:      "some-ups-name",
+					SpaceGUID: "some-space-guid",
+				})
+				Expect(err).To(MatchError(ccerror.ServiceInstanceNameTakenError{
+					Message: "The service instance name is taken: some-ups-name",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("DeleteUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/user_provided_service_instances/some-ups-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the user provided service instance", func() {
+			warnings, err := client.DeleteUserProvidedServiceInstance("some-ups-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("GetUserProvidedServiceInstances", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/user_provided_service_instances?q=space_guid:some-space-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-ups-guid-1"
+						},
+						"entity": {
+							"name": "some-ups-name-1",
+							"space_guid": "some-space-guid",
+							"credentials": {
+								"password": "secret"
+							},
+							"syslog_drain_url": "syslog://example.com"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-ups-guid-2"
+						},
+						"entity": {
+							"name": "some-ups-name-2",
+							"space_guid": "some-space-guid",
+							"route_service_url": "https://route.example.com"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/user_provided_service_instances", "q=space_guid:some-space-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/user_provided_service_instances", "q=space_guid:some-space-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried user provided service instances and warnings", func() {
+			serviceInstances, warnings, err := client.GetUserProvidedServiceInstances(Filter{
+				Type:     constant.SpaceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-space-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			Expect(serviceInstances).To(ConsistOf(
+				UserProvidedServiceInstance{
+					GUID:           "some-ups-guid-1",
+					Name:           "some-ups-name-1",
+					SpaceGUID:      "some-space-guid",
+					Credentials:    map[string]interface{}{"password": "secret"},
+					SyslogDrainURL: "syslog://example.com",
+				},
+				UserProvidedServiceInstance{
+					GUID:            "some-ups-guid-2",
+					Name:            "some-ups-name-2",
+					SpaceGUID:       "some-space-guid",
+					RouteServiceURL: "https://route.example.com",
+				},
+			))
+		})
+	})
+})
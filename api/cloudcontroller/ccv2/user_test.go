@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -87,4 +88,278 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		Context("when an error does not occur", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/managers?page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "user-guid-1"},
+							"entity": {"username": "user-1"}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "client-guid"},
+							"entity": {}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the users with the role and all warnings", func() {
+				users, warnings, err := client.GetOrganizationUsersByRole(constant.OrgManager, "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(users).To(Equal([]User{
+					{GUID: "user-guid-1", Username: "user-1"},
+					{GUID: "client-guid"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/auditors"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetOrganizationUsersByRole(constant.OrgAuditor, "some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "user-guid-1"},
+						"entity": {"username": "user-1"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the users with the role and all warnings", func() {
+			users, warnings, err := client.GetSpaceUsersByRole(constant.SpaceDeveloper, "some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(Equal([]User{{GUID: "user-guid-1", Username: "user-1"}}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("GetUserOrganizationsByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "org-guid-1"},
+						"entity": {"name": "org-1"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/users/some-user-guid/billing_managed_organizations"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the organizations the user has the role in and all warnings", func() {
+			orgs, warnings, err := client.GetUserOrganizationsByRole(constant.OrgBillingManager, "some-user-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgs).To(HaveLen(1))
+			Expect(orgs[0].GUID).To(Equal("org-guid-1"))
+			Expect(orgs[0].Name).To(Equal("org-1"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("GetUserSpacesByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "space-guid-1"},
+						"entity": {"name": "space-1", "organization_guid": "org-guid-1"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/users/some-user-guid/spaces"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the spaces the user has the role in and all warnings", func() {
+			spaces, warnings, err := client.GetUserSpacesByRole(constant.SpaceDeveloper, "some-user-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaces).To(HaveLen(1))
+			Expect(spaces[0].GUID).To(Equal("space-guid-1"))
+			Expect(spaces[0].Name).To(Equal("space-1"))
+			Expect(spaces[0].OrganizationGUID).To(Equal("org-guid-1"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("UpdateOrganizationUserByRole", func() {
+		Context("when an error does not occur", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/billing_managers"),
+						VerifyJSON(`{"username":"some-user"}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("gives the role to the user and returns all warnings", func() {
+				warnings, err := client.UpdateOrganizationUserByRole(constant.OrgBillingManager, "some-org-guid", "some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 20003,
+					"description": "The user could not be found: some-user",
+					"error_code": "CF-UserNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/users"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.UpdateOrganizationUserByRole(constant.OrgUser, "some-org-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/managers"),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("gives the role to the user and returns all warnings", func() {
+			warnings, err := client.UpdateSpaceUserByRole(constant.SpaceManager, "some-space-guid", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("DeleteOrganizationUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/organizations/some-org-guid/auditors/remove"),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("removes the role from the user and returns all warnings", func() {
+			warnings, err := client.DeleteOrganizationUserByRole(constant.OrgAuditor, "some-org-guid", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("DeleteSpaceUserByRole", func() {
+		Context("when an error does not occur", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces/some-space-guid/developers/remove"),
+						VerifyJSON(`{"username":"some-user"}`),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("removes the role from the user and returns all warnings", func() {
+				warnings, err := client.DeleteSpaceUserByRole(constant.SpaceDeveloper, "some-space-guid", "some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces/some-space-guid/managers/remove"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeleteSpaceUserByRole(constant.SpaceManager, "some-space-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -0,0 +1,17 @@
+package constant
+
+// DeploymentState is the state of a deployment.
+type DeploymentState string
+
+const (
+	// DeploymentDeploying is a deployment that is replacing the old instances
+	// of the application with new ones.
+	DeploymentDeploying DeploymentState = "DEPLOYING"
+	// DeploymentDeployed is a deployment that has replaced all of the old
+	// instances of the application.
+	DeploymentDeployed DeploymentState = "DEPLOYED"
+	// DeploymentCanceling is a deployment that is being rolled back.
+	DeploymentCanceling DeploymentState = "CANCELING"
+	// DeploymentCanceled is a deployment that has been rolled back.
+	DeploymentCanceled DeploymentState = "CANCELED"
+)
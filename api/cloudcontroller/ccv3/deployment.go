@@ -0,0 +1,97 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Deployment represents a rolling replacement of an application's instances
+// with instances running its current droplet.
+type Deployment struct {
+	// GUID is the unique deployment identifier.
+	GUID string
+	// State is the state of the deployment.
+	State constant.DeploymentState
+	// DropletGUID is the unique identifier of the droplet being deployed.
+	DropletGUID string
+	// CreatedAt is the time with zone when the deployment was created.
+	CreatedAt string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		GUID      string                   `json:"guid"`
+		State     constant.DeploymentState `json:"state"`
+		CreatedAt string                   `json:"created_at"`
+		Droplet   struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
+	if err != nil {
+		return err
+	}
+
+	d.GUID = ccDeployment.GUID
+	d.State = ccDeployment.State
+	d.CreatedAt = ccDeployment.CreatedAt
+	d.DropletGUID = ccDeployment.Droplet.GUID
+
+	return nil
+}
+
+// CreateApplicationDeployment starts a deployment of the application's
+// current droplet.
+func (client *Client) CreateApplicationDeployment(appGUID string) (Deployment, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Relationships Relationships `json:"relationships"`
+	}{
+		Relationships: Relationships{
+			constant.RelationshipTypeApplication: Relationship{GUID: appGUID},
+		},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (client *Client) GetDeployment(deploymentGUID string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   map[string]string{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
@@ -0,0 +1,137 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = client.CreateApplicationDeployment("some-app-guid")
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYING",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"created_at": "2018-04-25T22:42:10Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					State:       constant.DeploymentDeploying,
+					DropletGUID: "some-droplet-guid",
+					CreatedAt:   "2018-04-25T22:42:10Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = client.GetDeployment("some-deployment-guid")
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYED",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					State:       constant.DeploymentDeployed,
+					DropletGUID: "some-droplet-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -1,6 +1,11 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -12,18 +17,49 @@ import (
 type Droplet struct {
 	//Buildpacks are the detected buildpacks from the staging process.
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
+	// Checksum is the checksum of the droplet bits.
+	Checksum Checksum `json:"checksum"`
 	// CreatedAt is the timestamp that the Cloud Controller created the droplet.
 	CreatedAt string `json:"created_at"`
 	// GUID is the unique droplet identifier.
 	GUID string `json:"guid"`
 	// Image is the Docker image name.
 	Image string `json:"image"`
+	// PackageGUID is the unique identifier of the package the droplet was
+	// staged from.
+	PackageGUID string `json:"-"`
+	// ProcessTypes maps the process types detected during staging to their
+	// start commands.
+	ProcessTypes map[string]string `json:"process_types,omitempty"`
 	// Stack is the root filesystem to use with the buildpack.
 	Stack string `json:"stack,omitempty"`
 	// State is the current state of the droplet.
 	State constant.DropletState `json:"state"`
 }
 
+// UnmarshalJSON helps unmarshal a Cloud Controller Droplet response.
+func (d *Droplet) UnmarshalJSON(data []byte) error {
+	type alias Droplet
+	var ccDroplet struct {
+		alias
+		Links struct {
+			Package APILink `json:"package"`
+		} `json:"links"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccDroplet)
+	if err != nil {
+		return err
+	}
+
+	*d = Droplet(ccDroplet.alias)
+	if href := ccDroplet.Links.Package.HREF; href != "" {
+		d.PackageGUID = path.Base(href)
+	}
+
+	return nil
+}
+
 // DropletBuildpack is the name and output of a buildpack used to create a
 // droplet.
 type DropletBuildpack struct {
@@ -31,6 +67,104 @@ type DropletBuildpack struct {
 	Name string `json:"name"`
 	//DetectOutput is the output during buildpack detect process.
 	DetectOutput string `json:"detect_output"`
+	// BuildpackName is the name reported by the buildpack itself.
+	BuildpackName string `json:"buildpack_name"`
+	// Version is the version reported by the buildpack.
+	Version string `json:"version"`
+}
+
+// Checksum is the hash of the bits of a droplet or package, along with the
+// algorithm used to compute it.
+type Checksum struct {
+	// Type is the algorithm of the checksum, such as sha256.
+	Type string `json:"type"`
+	// Value is the hex encoded checksum.
+	Value string `json:"value"`
+}
+
+// CopyDroplet copies the droplet with the given GUID to the given
+// application. The copy is processed asynchronously and starts in the COPYING
+// state.
+func (client *Client) CopyDroplet(dropletGUID string, appGUID string) (Droplet, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Relationships Relationships `json:"relationships"`
+	}{
+		Relationships: Relationships{
+			constant.RelationshipTypeApplication: Relationship{GUID: appGUID},
+		},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		Query:       []Query{{Key: SourceGUID, Values: []string{dropletGUID}}},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+// CreateApplicationDroplet creates a droplet without a package for the given
+// application, so that its bits can be uploaded with UploadDropletBits.
+func (client *Client) CreateApplicationDroplet(appGUID string) (Droplet, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Relationships Relationships `json:"relationships"`
+	}{
+		Relationships: Relationships{
+			constant.RelationshipTypeApplication: Relationship{GUID: appGUID},
+		},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+// DownloadDroplet writes the bits of the droplet with the given GUID to
+// destination. When provided, wrapDownload wraps the bits as they are read,
+// along with their size if it is known, or -1.
+func (client *Client) DownloadDroplet(dropletGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletDownloadRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		DownloadDestination: destination,
+		WrapDownload:        wrapDownload,
+	}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
 }
 
 // GetApplicationDropletCurrent returns the current droplet for a given
@@ -96,3 +230,29 @@ func (client *Client) GetDroplets(query ...Query) ([]Droplet, Warnings, error) {
 
 	return responseDroplets, warnings, err
 }
+
+// UploadDropletBits uploads the file at the given path as the bits of the
+// droplet with the given GUID. Returns back a resulting job URL to poll.
+// Note: the file is read entirely into memory prior to sending data to CC.
+func (client *Client) UploadDropletBits(dropletGUID string, pathToDroplet string) (JobURL, Warnings, error) {
+	body, contentType, err := client.createUploadStream(pathToDroplet, "bits")
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletBitsRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+		Body:        body,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
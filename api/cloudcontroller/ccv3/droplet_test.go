@@ -1,8 +1,12 @@
 package ccv3_test
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -19,6 +23,282 @@ var _ = Describe("Droplet", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CopyDroplet", func() {
+		var (
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = client.CopyDroplet("some-source-droplet-guid", "some-app-guid")
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				response := `{
+					"guid": "some-droplet-guid",
+					"state": "COPYING"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets", "source_guid=some-source-droplet-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the copied droplet and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{
+					GUID:  "some-droplet-guid",
+					State: constant.DropletCopying,
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets", "source_guid=some-source-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationDroplet", func() {
+		var (
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = client.CreateApplicationDroplet("some-app-guid")
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				response := `{
+					"guid": "some-droplet-guid",
+					"state": "AWAITING_UPLOAD"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the created droplet and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{
+					GUID:  "some-droplet-guid",
+					State: "AWAITING_UPLOAD",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: App must exist",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: App must exist"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		var (
+			destination *bytes.Buffer
+			wrappedSize int64
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			destination = new(bytes.Buffer)
+			wrappedSize = 0
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.DownloadDroplet("some-droplet-guid", destination, func(body io.Reader, sizeOfBody int64) io.Reader {
+				wrappedSize = sizeOfBody
+				return body
+			})
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("writes the droplet bits to the destination and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(destination.String()).To(Equal("some-droplet-bits"))
+				Expect(wrappedSize).To(BeEquivalentTo(len("some-droplet-bits")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings without writing to the destination", func() {
+				Expect(executeErr).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(destination.Len()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("UploadDropletBits", func() {
+		var (
+			pathToDroplet string
+
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			tempFile, err := ioutil.TempFile("", "droplet-test-")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tempFile.WriteString("some-droplet-bits")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tempFile.Close()).To(Succeed())
+			pathToDroplet = tempFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(pathToDroplet)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.UploadDropletBits("some-droplet-guid", pathToDroplet)
+		})
+
+		Context("when the upload succeeds", func() {
+			BeforeEach(func() {
+				verifyBits := func(_ http.ResponseWriter, request *http.Request) {
+					Expect(request.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data"))
+					file, _, err := request.FormFile("bits")
+					Expect(err).ToNot(HaveOccurred())
+					contents, err := ioutil.ReadAll(file)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-droplet-bits"))
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets/some-droplet-guid/upload"),
+						verifyBits,
+						RespondWith(http.StatusAccepted, "{}", http.Header{
+							"X-Cf-Warnings": {"warning-1"},
+							"Location":      {"/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("/v3/jobs/some-job-guid")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the droplet file does not exist", func() {
+			BeforeEach(func() {
+				Expect(os.RemoveAll(pathToDroplet)).To(Succeed())
+			})
+
+			It("returns the error", func() {
+				_, ok := executeErr.(*os.PathError)
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("GetApplicationDropletCurrent", func() {
 		var (
 			droplet    Droplet
@@ -49,7 +329,12 @@ var _ = Describe("Droplet", func() {
 					"image": "docker/some-image",
 					"stack": "some-stack",
 					"created_at": "2016-03-28T23:39:34Z",
-					"updated_at": "2016-03-28T23:39:47Z"
+					"updated_at": "2016-03-28T23:39:47Z",
+					"links": {
+						"package": {
+							"href": "https://api.example.com/v3/packages/some-package-guid"
+						}
+					}
 				}`
 				server.AppendHandlers(
 					CombineHandlers(
@@ -72,8 +357,9 @@ var _ = Describe("Droplet", func() {
 							DetectOutput: "detected-buildpack",
 						},
 					},
-					Image:     "docker/some-image",
-					CreatedAt: "2016-03-28T23:39:34Z",
+					Image:       "docker/some-image",
+					CreatedAt:   "2016-03-28T23:39:34Z",
+					PackageGUID: "some-package-guid",
 				}))
 				Expect(warnings).To(ConsistOf("warning-1"))
 			})
@@ -129,9 +415,18 @@ var _ = Describe("Droplet", func() {
 					"buildpacks": [
 						{
 							"name": "some-buildpack",
-							"detect_output": "detected-buildpack"
+							"detect_output": "detected-buildpack",
+							"buildpack_name": "some-buildpack-name",
+							"version": "1.2.3"
 						}
 					],
+					"checksum": {
+						"type": "sha256",
+						"value": "some-checksum"
+					},
+					"process_types": {
+						"web": "bundle exec rackup"
+					},
 					"image": "docker/some-image",
 					"stack": "some-stack",
 					"created_at": "2016-03-28T23:39:34Z",
@@ -154,12 +449,16 @@ var _ = Describe("Droplet", func() {
 					State: constant.DropletStaged,
 					Buildpacks: []DropletBuildpack{
 						{
-							Name:         "some-buildpack",
-							DetectOutput: "detected-buildpack",
+							Name:          "some-buildpack",
+							DetectOutput:  "detected-buildpack",
+							BuildpackName: "some-buildpack-name",
+							Version:       "1.2.3",
 						},
 					},
-					Image:     "docker/some-image",
-					CreatedAt: "2016-03-28T23:39:34Z",
+					Checksum:     Checksum{Type: "sha256", Value: "some-checksum"},
+					ProcessTypes: map[string]string{"web": "bundle exec rackup"},
+					Image:        "docker/some-image",
+					CreatedAt:    "2016-03-28T23:39:34Z",
 				}))
 				Expect(warnings).To(ConsistOf("warning-1"))
 			})
@@ -224,7 +523,9 @@ var _ = Describe("Droplet", func() {
 							"state": "STAGED",
 							"created_at": "2017-08-16T00:18:24Z",
 							"links": {
-								"package": "https://api.com/v3/packages/some-package-guid"
+								"package": {
+									"href": "https://api.com/v3/packages/some-package-guid"
+								}
 							}
 						},
 						{
@@ -284,7 +585,8 @@ var _ = Describe("Droplet", func() {
 							DetectOutput: "detected-buildpack-1",
 						},
 					},
-					CreatedAt: "2017-08-16T00:18:24Z",
+					CreatedAt:   "2017-08-16T00:18:24Z",
+					PackageGUID: "some-package-guid",
 				}))
 				Expect(droplets[1]).To(Equal(Droplet{
 					GUID:  "some-guid-2",
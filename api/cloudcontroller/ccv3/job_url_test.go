@@ -67,6 +67,106 @@ var _ = Describe("Job URL", func() {
 		})
 	})
 
+	Describe("DeleteDroplet", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeleteDroplet("some-droplet-guid")
+		})
+
+		Context("when the droplet is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when deleting the droplet returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusBadRequest, `{}`, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{ResponseCode: 400}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeletePackage("some-package-guid")
+		})
+
+		Context("when the package is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when deleting the package returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusBadRequest, `{}`, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{ResponseCode: 400}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplicationApplyManifest", func() {
 		var (
 			manifestBody []byte
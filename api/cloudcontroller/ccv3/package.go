@@ -16,6 +16,7 @@ import (
 
 type Package struct {
 	GUID           string
+	Checksum       Checksum
 	CreatedAt      string
 	Links          APILinks
 	Relationships  Relationships
@@ -68,9 +69,10 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 		State         constant.PackageState `json:"state,omitempty"`
 		Type          constant.PackageType  `json:"type,omitempty"`
 		Data          struct {
-			Image    string `json:"image"`
-			Username string `json:"username"`
-			Password string `json:"password"`
+			Checksum Checksum `json:"checksum"`
+			Image    string   `json:"image"`
+			Username string   `json:"username"`
+			Password string   `json:"password"`
 		} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccPackage)
@@ -79,6 +81,7 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 	}
 
 	p.GUID = ccPackage.GUID
+	p.Checksum = ccPackage.Data.Checksum
 	p.CreatedAt = ccPackage.CreatedAt
 	p.Links = ccPackage.Links
 	p.Relationships = ccPackage.Relationships
@@ -110,6 +113,27 @@ func (client *Client) GetPackage(packageGUID string) (Package, Warnings, error)
 	return responsePackage, response.Warnings, err
 }
 
+// DownloadPackage writes the bits of the package with the given GUID to
+// destination. When provided, wrapDownload wraps the bits as they are read,
+// along with their size if it is known, or -1.
+func (client *Client) DownloadPackage(packageGUID string, destination io.Writer, wrapDownload func(io.Reader, int64) io.Reader) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetPackageDownloadRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		DownloadDestination: destination,
+		WrapDownload:        wrapDownload,
+	}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
 // CreatePackage creates a package with the given settings, Type and the
 // ApplicationRelationship must be set.
 func (client *Client) CreatePackage(pkg Package) (Package, Warnings, error) {
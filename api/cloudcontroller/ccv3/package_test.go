@@ -1,6 +1,7 @@
 package ccv3_test
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -29,6 +30,12 @@ var _ = Describe("Package", func() {
 				response := `{
   "guid": "some-pkg-guid",
   "state": "PROCESSING_UPLOAD",
+  "data": {
+    "checksum": {
+      "type": "sha256",
+      "value": "some-checksum"
+    }
+  },
 	"links": {
     "upload": {
       "href": "some-package-upload-url",
@@ -49,8 +56,9 @@ var _ = Describe("Package", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedPackage := Package{
-					GUID:  "some-pkg-guid",
-					State: constant.PackageProcessingUpload,
+					GUID:     "some-pkg-guid",
+					Checksum: Checksum{Type: "sha256", Value: "some-checksum"},
+					State:    constant.PackageProcessingUpload,
 					Links: map[string]APILink{
 						"upload": APILink{HREF: "some-package-upload-url", Method: http.MethodPost},
 					},
@@ -108,6 +116,66 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("DownloadPackage", func() {
+		var (
+			destination *bytes.Buffer
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			destination = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.DownloadPackage("some-pkg-guid", destination, nil)
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusOK, "some-package-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("writes the package bits to the destination and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(destination.String()).To(Equal("some-package-bits"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Package not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(destination.Len()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("CreatePackage", func() {
 		Context("when the package successfully is created", func() {
 			Context("when creating a docker package", func() {
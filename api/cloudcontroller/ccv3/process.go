@@ -14,8 +14,10 @@ import (
 type Process struct {
 	GUID                string           `json:"guid"`
 	Type                string           `json:"type"`
+	Command             string           `json:"command"`
 	HealthCheckType     string           `json:"-"`
 	HealthCheckEndpoint string           `json:"-"`
+	HealthCheckTimeout  int              `json:"-"`
 	Instances           types.NullInt    `json:"instances"`
 	MemoryInMB          types.NullUint64 `json:"memory_in_mb"`
 	DiskInMB            types.NullUint64 `json:"disk_in_mb"`
@@ -47,6 +49,7 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 			Type string `json:"type"`
 			Data struct {
 				Endpoint string `json:"endpoint"`
+				Timeout  int    `json:"timeout"`
 			} `json:"data"`
 		} `json:"health_check"`
 	}
@@ -59,6 +62,7 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 
 	p.HealthCheckEndpoint = ccProcess.HealthCheck.Data.Endpoint
 	p.HealthCheckType = ccProcess.HealthCheck.Type
+	p.HealthCheckTimeout = ccProcess.HealthCheck.Data.Timeout
 	return nil
 }
 
@@ -136,6 +140,54 @@ func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, pro
 	return responceProcess, response.Warnings, err
 }
 
+// UpdateProcess updates the command and health check of the process with the
+// provided GUID. Empty and zero values are left unchanged.
+func (client *Client) UpdateProcess(process Process) (Process, Warnings, error) {
+	ccProcess := map[string]interface{}{}
+	if process.Command != "" {
+		ccProcess["command"] = process.Command
+	}
+
+	healthCheck := map[string]interface{}{}
+	if process.HealthCheckType != "" {
+		healthCheck["type"] = process.HealthCheckType
+	}
+	healthCheckData := map[string]interface{}{}
+	if process.HealthCheckEndpoint != "" {
+		healthCheckData["endpoint"] = process.HealthCheckEndpoint
+	}
+	if process.HealthCheckTimeout != 0 {
+		healthCheckData["timeout"] = process.HealthCheckTimeout
+	}
+	if len(healthCheckData) > 0 {
+		healthCheck["data"] = healthCheckData
+	}
+	if len(healthCheck) > 0 {
+		ccProcess["health_check"] = healthCheck
+	}
+
+	body, err := json.Marshal(ccProcess)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchProcessRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"process_guid": process.GUID},
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	var updatedProcess Process
+	response := cloudcontroller.Response{
+		Result: &updatedProcess,
+	}
+	err = client.connection.Make(request, &response)
+	return updatedProcess, response.Warnings, err
+}
+
 // CreateApplicationProcessScale updates process instances count, memory or disk
 func (client *Client) CreateApplicationProcessScale(appGUID string, process Process) (Process, Warnings, error) {
 	ccProcessScale := struct {
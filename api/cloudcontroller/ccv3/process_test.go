@@ -124,6 +124,21 @@ var _ = Describe("Process", func() {
 				}))
 			})
 		})
+
+		Context("when a command and health check timeout are provided", func() {
+			BeforeEach(func() {
+				processBytes = []byte(`{"type":"worker","command":"some-command","health_check":{"type":"process", "data": {"endpoint": null, "timeout": 90}}}`)
+			})
+
+			It("sets the command and health check timeout", func() {
+				Expect(process).To(Equal(Process{
+					Type:               "worker",
+					Command:            "some-command",
+					HealthCheckType:    "process",
+					HealthCheckTimeout: 90,
+				}))
+			})
+		})
 	})
 
 	Describe("GetApplicationProcesses", func() {
@@ -214,12 +229,14 @@ var _ = Describe("Process", func() {
 						MemoryInMB:          types.NullUint64{Value: 64, IsSet: true},
 						HealthCheckType:     "http",
 						HealthCheckEndpoint: "/health",
+						HealthCheckTimeout:  60,
 					},
 					Process{
-						GUID:            "process-3-guid",
-						Type:            "console",
-						MemoryInMB:      types.NullUint64{Value: 128, IsSet: true},
-						HealthCheckType: "process",
+						GUID:               "process-3-guid",
+						Type:               "console",
+						MemoryInMB:         types.NullUint64{Value: 128, IsSet: true},
+						HealthCheckType:    "process",
+						HealthCheckTimeout: 90,
 					},
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
@@ -294,6 +311,7 @@ var _ = Describe("Process", func() {
 					MemoryInMB:          types.NullUint64{Value: 32, IsSet: true},
 					HealthCheckType:     "http",
 					HealthCheckEndpoint: "/health",
+					HealthCheckTimeout:  90,
 				}))
 			})
 		})
@@ -534,6 +552,119 @@ var _ = Describe("Process", func() {
 		})
 	})
 
+	Describe("UpdateProcess", func() {
+		var (
+			inputProcess Process
+
+			process  Process
+			warnings []string
+			err      error
+		)
+
+		BeforeEach(func() {
+			inputProcess = Process{GUID: "some-process-guid"}
+		})
+
+		JustBeforeEach(func() {
+			process, warnings, err = client.UpdateProcess(inputProcess)
+		})
+
+		Context("when all settings are provided", func() {
+			BeforeEach(func() {
+				inputProcess.Command = "some-command"
+				inputProcess.HealthCheckType = "http"
+				inputProcess.HealthCheckEndpoint = "/health"
+				inputProcess.HealthCheckTimeout = 90
+
+				expectedBody := `{
+					"command": "some-command",
+					"health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/health",
+							"timeout": 90
+						}
+					}
+				}`
+				responseBody := `{
+					"guid": "some-process-guid",
+					"command": "some-command",
+					"health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/health",
+							"timeout": 90
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, responseBody, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("patches the process and returns it with all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(process).To(Equal(Process{
+					GUID:                "some-process-guid",
+					Command:             "some-command",
+					HealthCheckType:     "http",
+					HealthCheckEndpoint: "/health",
+					HealthCheckTimeout:  90,
+				}))
+			})
+		})
+
+		Context("when only the command is provided", func() {
+			BeforeEach(func() {
+				inputProcess.Command = "some-command"
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"command": "some-command"}`),
+						RespondWith(http.StatusOK, `{"guid": "some-process-guid", "command": "some-command"}`, nil),
+					),
+				)
+			})
+
+			It("only patches the command", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(process.Command).To(Equal("some-command"))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ProcessNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ProcessNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationProcessScale", func() {
 		var passedProcess Process
 
@@ -26,6 +26,8 @@ const (
 	OrderBy QueryKey = "order_by"
 	// PerPage is a query parameter for specifying the number of results per page.
 	PerPage QueryKey = "per_page"
+	// SourceGUID is a query parameter for specifying the object to copy.
+	SourceGUID QueryKey = "source_guid"
 
 	// NameOrder is a query value for ordering by name. This value is used in
 	// conjunction with the OrderBy QueryKey.
@@ -16,4 +16,5 @@ const (
 	MinVersionRunTaskV3          = "3.0.0"
 	MinVersionIsolationSegmentV3 = "3.11.0"
 	MinVersionShareServiceV3     = "3.36.0"
+	MinVersionDeploymentsV3      = "3.55.0"
 )
@@ -3,6 +3,7 @@ package cloudcontroller
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -114,6 +115,17 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 }
 
 func (*CloudControllerConnection) handleStatusCodes(response *http.Response, passedResponse *Response) error {
+	if passedResponse.DownloadDestination != nil && response.StatusCode < 400 {
+		defer response.Body.Close()
+
+		var body io.Reader = response.Body
+		if passedResponse.WrapDownload != nil {
+			body = passedResponse.WrapDownload(body, response.ContentLength)
+		}
+		_, err := io.Copy(passedResponse.DownloadDestination, body)
+		return err
+	}
+
 	if response.StatusCode == http.StatusNoContent {
 		passedResponse.RawResponse = []byte("{}")
 	} else {
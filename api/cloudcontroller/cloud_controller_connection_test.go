@@ -1,7 +1,9 @@
 package cloudcontroller_test
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"runtime"
 	"strings"
@@ -111,6 +113,44 @@ var _ = Describe("Cloud Controller Connection", func() {
 			})
 		})
 
+		Describe("Downloads", func() {
+			var request *Request
+
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-guid/download", ""),
+						RespondWith(http.StatusOK, "some-droplet-bits"),
+					),
+				)
+
+				req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v3/droplets/some-guid/download", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+				request = &Request{Request: req}
+			})
+
+			It("writes the body to the download destination through the wrapper", func() {
+				var (
+					destination bytes.Buffer
+					wrappedSize int64
+				)
+				response := Response{
+					DownloadDestination: &destination,
+					WrapDownload: func(body io.Reader, sizeOfBody int64) io.Reader {
+						wrappedSize = sizeOfBody
+						return body
+					},
+				}
+
+				err := connection.Make(request, &response)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(destination.String()).To(Equal("some-droplet-bits"))
+				Expect(wrappedSize).To(BeEquivalentTo(len("some-droplet-bits")))
+				Expect(response.RawResponse).To(BeEmpty())
+			})
+		})
+
 		Describe("Response Headers", func() {
 			Describe("Location", func() {
 				BeforeEach(func() {
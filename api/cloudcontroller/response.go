@@ -1,6 +1,9 @@
 package cloudcontroller
 
-import "net/http"
+import (
+	"io"
+	"net/http"
+)
 
 // Response represents a Cloud Controller response object.
 type Response struct {
@@ -20,6 +23,15 @@ type Response struct {
 
 	// ResourceLocationURL represents the Location header value
 	ResourceLocationURL string
+
+	// DownloadDestination, when set, receives the body of a successful response
+	// instead of RawResponse, so that large files are not held in memory.
+	DownloadDestination io.Writer
+
+	// WrapDownload, when set, wraps the body copied to DownloadDestination,
+	// along with the size of the body from the Content-Length header, or -1 if
+	// it is not known.
+	WrapDownload func(body io.Reader, sizeOfBody int64) io.Reader
 }
 
 func (r *Response) reset() {
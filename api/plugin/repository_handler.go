@@ -0,0 +1,83 @@
+package plugin
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// RepositoryBinaryPathPrefix is the URL path under which a RepositoryHandler
+// serves plugin binaries.
+const RepositoryBinaryPathPrefix = "/binaries/"
+
+// RepositoryHandler serves a plugin repository in the format read by
+// GetPluginRepository, along with the plugin binaries it lists.
+type RepositoryHandler struct {
+	repository PluginRepository
+	binaryDir  string
+	binaries   map[string]bool
+}
+
+// NewRepositoryHandler returns a RepositoryHandler for repository. The URL of
+// every binary in repository must be the name of a file in binaryDir; it is
+// rewritten to an absolute URL on the serving host when the repository is
+// listed.
+func NewRepositoryHandler(repository PluginRepository, binaryDir string) *RepositoryHandler {
+	binaries := map[string]bool{}
+	for _, plugin := range repository.Plugins {
+		for _, binary := range plugin.Binaries {
+			binaries[binary.URL] = true
+		}
+	}
+
+	return &RepositoryHandler{
+		repository: repository,
+		binaryDir:  binaryDir,
+		binaries:   binaries,
+	}
+}
+
+func (handler *RepositoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/list":
+		handler.serveList(w, r)
+	case strings.HasPrefix(r.URL.Path, RepositoryBinaryPathPrefix):
+		name := strings.TrimPrefix(r.URL.Path, RepositoryBinaryPathPrefix)
+		if !handler.binaries[name] {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeFile(w, r, filepath.Join(handler.binaryDir, name))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (handler *RepositoryHandler) serveList(w http.ResponseWriter, r *http.Request) {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	listed := PluginRepository{Plugins: make([]Plugin, 0, len(handler.repository.Plugins))}
+	for _, plugin := range handler.repository.Plugins {
+		binaries := make([]PluginBinary, 0, len(plugin.Binaries))
+		for _, binary := range plugin.Binaries {
+			binary.URL = scheme + "://" + r.Host + path.Join(RepositoryBinaryPathPrefix, binary.URL)
+			binaries = append(binaries, binary)
+		}
+		plugin.Binaries = binaries
+		listed.Plugins = append(listed.Plugins, plugin)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(listed)
+}
@@ -0,0 +1,101 @@
+package plugin_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/api/plugin"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RepositoryHandler", func() {
+	var (
+		dir    string
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ioutil.WriteFile(filepath.Join(dir, "some-plugin_linux64"), []byte("some-binary"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "unlisted"), []byte("secret"), 0600)).To(Succeed())
+
+		server = httptest.NewServer(NewRepositoryHandler(PluginRepository{
+			Plugins: []Plugin{
+				{
+					Name:    "some-plugin",
+					Version: "1.0.0",
+					Binaries: []PluginBinary{
+						{Platform: "linux64", URL: "some-plugin_linux64", Checksum: "some-checksum"},
+					},
+				},
+			},
+		}, dir))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("lists the repository with absolute binary URLs that GetPluginRepository can read", func() {
+		repository, err := NewTestClient().GetPluginRepository(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repository).To(Equal(PluginRepository{
+			Plugins: []Plugin{
+				{
+					Name:    "some-plugin",
+					Version: "1.0.0",
+					Binaries: []PluginBinary{
+						{Platform: "linux64", URL: server.URL + "/binaries/some-plugin_linux64", Checksum: "some-checksum"},
+					},
+				},
+			},
+		}))
+	})
+
+	It("serves the listed binaries", func() {
+		response, err := http.Get(server.URL + "/binaries/some-plugin_linux64")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("some-binary"))
+	})
+
+	It("does not serve files that are not listed", func() {
+		for _, path := range []string{"/binaries/unlisted", "/binaries/../unlisted", "/unlisted"} {
+			response, err := http.Get(server.URL + path)
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound), path)
+		}
+	})
+
+	It("only allows reads", func() {
+		response, err := http.Post(server.URL+"/list", "application/json", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("returns JSON", func() {
+		response, err := http.Get(server.URL + "/list")
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+		var repository PluginRepository
+		Expect(json.NewDecoder(response.Body).Decode(&repository)).To(Succeed())
+		Expect(repository.Plugins).To(HaveLen(1))
+	})
+})
@@ -19,6 +19,18 @@ type ServicePlanEntity struct {
 	Description         string                  `json:"description"`
 	ServiceOfferingGUID string                  `json:"service_guid"`
 	ServiceOffering     ServiceOfferingResource `json:"service"`
+	Schemas             ServicePlanSchemas      `json:"schemas"`
+}
+
+type ServicePlanSchemas struct {
+	ServiceInstance struct {
+		Create struct {
+			Parameters map[string]interface{} `json:"parameters"`
+		} `json:"create"`
+		Update struct {
+			Parameters map[string]interface{} `json:"parameters"`
+		} `json:"update"`
+	} `json:"service_instance"`
 }
 
 type ServicePlanDescription struct {
@@ -35,6 +47,10 @@ func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields)
 	fields.Public = resource.Entity.Public
 	fields.Active = resource.Entity.Active
 	fields.ServiceOfferingGUID = resource.Entity.ServiceOfferingGUID
+	fields.Schemas = models.ServicePlanSchemas{
+		ServiceInstanceCreateParameters: resource.Entity.Schemas.ServiceInstance.Create.Parameters,
+		ServiceInstanceUpdateParameters: resource.Entity.Schemas.ServiceInstance.Update.Parameters,
+	}
 	return
 }
 
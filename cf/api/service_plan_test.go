@@ -60,6 +60,10 @@ var _ = Describe("Service Plan Repository", func() {
 				Expect(servicePlansFields[0].Free).To(BeTrue())
 				Expect(servicePlansFields[0].Public).To(BeTrue())
 				Expect(servicePlansFields[0].Active).To(BeTrue())
+				Expect(servicePlansFields[0].Schemas).To(Equal(models.ServicePlanSchemas{
+					ServiceInstanceCreateParameters: map[string]interface{}{"type": "object"},
+					ServiceInstanceUpdateParameters: map[string]interface{}{"required": []interface{}{"size"}},
+				}))
 				Expect(servicePlansFields[1].Name).To(Equal("The small second"))
 				Expect(servicePlansFields[1].GUID).To(Equal("the-small-second"))
 				Expect(servicePlansFields[1].Free).To(BeTrue())
@@ -158,7 +162,17 @@ var firstPlanRequest = apifakes.NewCloudControllerTestRequest(testnet.TestReques
         "name": "The big one",
         "free": true,
         "public": true,
-        "active": true
+        "active": true,
+        "schemas": {
+          "service_instance": {
+            "create": {
+              "parameters": { "type": "object" }
+            },
+            "update": {
+              "parameters": { "required": ["size"] }
+            }
+          }
+        }
       }
     }
   ]
@@ -20,8 +20,11 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	pluginshared "code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/spellcheck"
+	"code.cloudfoundry.org/cli/util/ui"
 
 	netrpc "net/rpc"
 )
@@ -130,6 +133,8 @@ func Main(traceEnv string, args []string) {
 			os.Exit(1)
 		}
 
+		runPostPluginHook(meta.Name)
+
 		os.Exit(0)
 	}
 
@@ -222,3 +227,25 @@ func handleVerbose(args []string) ([]string, bool) {
 
 	return args, verbose
 }
+
+// runPostPluginHook runs the post plugin hooks of a command handed off from
+// the refactored code base, whose pre hooks were run before the hand-off. The
+// config is loaded again so that the hooks see the target the command set.
+func runPostPluginHook(commandName string) {
+	events, hasHooks := pluginshared.CommandHooks[commandName]
+	if !hasHooks {
+		return
+	}
+
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return
+	}
+
+	_ = pluginshared.NewHookRunner(config, commandUI).Run(pluginshared.NewHookEvent(events.Post, config))
+}
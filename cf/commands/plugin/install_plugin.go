@@ -285,6 +285,7 @@ func (cmd *PluginInstall) installPlugin(pluginMetadata *plugin.PluginMetadata, p
 		Location: pluginDestinationFilepath,
 		Version:  pluginMetadata.Version,
 		Commands: pluginMetadata.Commands,
+		Hooks:    pluginMetadata.Hooks,
 	}
 
 	cmd.pluginConfig.SetPlugin(pluginMetadata.Name, configMetadata)
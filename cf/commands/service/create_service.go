@@ -2,6 +2,8 @@ package service
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder"
 	"code.cloudfoundry.org/cli/cf/api"
@@ -15,6 +17,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/uihelpers"
 	"code.cloudfoundry.org/cli/util/json"
+	"code.cloudfoundry.org/cli/util/jsonschema"
 )
 
 type CreateService struct {
@@ -22,6 +25,8 @@ type CreateService struct {
 	config         coreconfig.Reader
 	serviceRepo    api.ServiceRepository
 	serviceBuilder servicebuilder.ServiceBuilder
+
+	PollingInterval time.Duration
 }
 
 func init() {
@@ -32,8 +37,11 @@ func (cmd *CreateService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["skip-schema-validation"] = &flags.BoolFlag{Name: "skip-schema-validation", Usage: T("Do not validate the configuration parameters against the JSON schema published by the service plan")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the service instance to be created")}
+	fs["wait-timeout"] = &flags.IntFlag{Name: "wait-timeout", Usage: T("Maximum number of minutes to wait with --wait (Default: 60)")}
 
-	baseUsage := T("CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	baseUsage := T("CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON [--skip-schema-validation]] [-t TAGS] [--wait [--wait-timeout MINUTES]]")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line:
 
    CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{"name":"value","name":"value"}'
@@ -49,9 +57,14 @@ func (cmd *CreateService) MetaData() commandregistry.CommandMetadata {
          "count": 5,
          "memory_mb": 1024
       }
-   }`)
+   }
+
+   Configuration parameters are validated against the JSON schema published by the service plan, if any.
+   Use --skip-schema-validation to send them to the service broker as they are.`)
 	tipsUsage := T(`TIP:
-   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps`)
+   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps
+
+   Use --wait to wait for the service broker to finish creating the service instance`)
 	return commandregistry.CommandMetadata{
 		Name:        "create-service",
 		ShortName:   "cs",
@@ -87,6 +100,11 @@ func (cmd *CreateService) Requirements(requirementsFactory requirements.Factory,
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 3)
 	}
 
+	err := validateWaitFlags(cmd.ui, fc)
+	if err != nil {
+		return nil, err
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewTargetedSpaceRequirement(),
@@ -100,6 +118,7 @@ func (cmd *CreateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 	cmd.serviceBuilder = deps.ServiceBuilder
+	cmd.PollingInterval = DefaultServiceInstancePollingInterval
 	return cmd
 }
 
@@ -125,11 +144,15 @@ func (cmd *CreateService) Execute(c flags.FlagContext) error {
 			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	plan, err := cmd.CreateService(serviceName, planName, serviceInstanceName, paramsMap, tagsList)
+	plan, err := cmd.CreateService(serviceName, planName, serviceInstanceName, paramsMap, tagsList, c.Bool("skip-schema-validation"))
 
 	switch err.(type) {
 	case nil:
-		err := printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
+		if c.Bool("wait") {
+			err = waitForServiceInstanceOperation(serviceInstanceName, cmd.serviceRepo, cmd.ui, cmd.PollingInterval, waitTimeout(c))
+		} else {
+			err = printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
+		}
 		if err != nil {
 			return err
 		}
@@ -153,7 +176,7 @@ func (cmd *CreateService) Execute(c flags.FlagContext) error {
 	return nil
 }
 
-func (cmd CreateService) CreateService(serviceName, planName, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error) {
+func (cmd CreateService) CreateService(serviceName, planName, serviceInstanceName string, params map[string]interface{}, tags []string, skipSchemaValidation bool) (models.ServicePlanFields, error) {
 	offerings, apiErr := cmd.serviceBuilder.GetServicesByNameForSpaceWithPlans(cmd.config.SpaceFields().GUID, serviceName)
 	if apiErr != nil {
 		return models.ServicePlanFields{}, apiErr
@@ -164,6 +187,13 @@ func (cmd CreateService) CreateService(serviceName, planName, serviceInstanceNam
 		return plan, apiErr
 	}
 
+	if params != nil && !skipSchemaValidation {
+		apiErr = validateServiceParameters(plan.Schemas.ServiceInstanceCreateParameters, params)
+		if apiErr != nil {
+			return plan, apiErr
+		}
+	}
+
 	apiErr = cmd.serviceRepo.CreateServiceInstance(serviceInstanceName, plan.GUID, params, tags)
 	return plan, apiErr
 }
@@ -182,3 +212,24 @@ func findPlanFromOfferings(offerings models.ServiceOfferings, name string) (plan
 	))
 	return
 }
+
+// validateServiceParameters validates params against a JSON schema published
+// by a service plan. Plans without a schema accept any parameters.
+func validateServiceParameters(schema map[string]interface{}, params map[string]interface{}) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	validationErrors := jsonschema.Validate(schema, params)
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	var errs []string
+	for _, validationError := range validationErrors {
+		errs = append(errs, fmt.Sprintf("   %s: %s", validationError.Path, validationError.Message))
+	}
+
+	return errors.New(T("The configuration parameters do not match the schema published by the service plan:\n{{.Errors}}\nUse --skip-schema-validation to send them to the service broker anyway.",
+		map[string]interface{}{"Errors": strings.Join(errs, "\n")}))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder/servicebuilderfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -18,6 +19,7 @@ import (
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/service"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -43,7 +45,9 @@ var _ = Describe("create-service command", func() {
 		deps.Config = config
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.ServiceBuilder = serviceBuilder
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("create-service").SetDependency(deps, pluginCall))
+		cmd := commandregistry.Commands.FindCommand("create-service").SetDependency(deps, pluginCall)
+		cmd.(*service.CreateService).PollingInterval = time.Millisecond
+		commandregistry.Commands.SetCommand(cmd)
 	}
 
 	BeforeEach(func() {
@@ -91,6 +95,16 @@ var _ = Describe("create-service command", func() {
 			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeted"})
 			Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})).To(BeFalse())
 		})
+
+		It("fails when --wait-timeout is provided without --wait", func() {
+			Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "--wait-timeout", "5"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"--wait-timeout can only be used with --wait"}))
+		})
+
+		It("fails when --wait-timeout is not a positive number of minutes", func() {
+			Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "--wait", "--wait-timeout", "0"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"--wait-timeout must be a positive number of minutes"}))
+		})
 	})
 
 	It("successfully creates a service", func() {
@@ -134,6 +148,38 @@ var _ = Describe("create-service command", func() {
 				Expect(params).To(Equal(map[string]interface{}{"foo": "bar"}))
 			})
 
+			Context("when the plan publishes a schema for the params", func() {
+				BeforeEach(func() {
+					offering1.Plans[0].Schemas.ServiceInstanceCreateParameters = map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"size"},
+					}
+					serviceBuilder.GetServicesByNameForSpaceWithPlansReturns(models.ServiceOfferings([]models.ServiceOffering{offering1, offering2}), nil)
+				})
+
+				It("does not create the service when the params do not match the schema", func() {
+					Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `{"foo": "bar"}`})).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"The configuration parameters do not match the schema published by the service plan:"},
+						[]string{"(root): size is required"},
+						[]string{"Use --skip-schema-validation to send them to the service broker anyway."},
+					))
+					Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(0))
+				})
+
+				It("creates the service when the params match the schema", func() {
+					Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `{"size": "small"}`})).To(BeTrue())
+					Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+				})
+
+				It("creates the service without validating the params when --skip-schema-validation is provided", func() {
+					Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `{"foo": "bar"}`, "--skip-schema-validation"})).To(BeTrue())
+					Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("that are not valid json", func() {
 				It("returns an error to the UI", func() {
 					callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `bad-json`})
@@ -234,6 +280,53 @@ var _ = Describe("create-service command", func() {
 			Expect(planGUID).To(Equal("cleardb-spark-guid"))
 		})
 
+		Context("when --wait is provided", func() {
+			It("waits for the service broker to finish creating the service instance", func() {
+				succeeded := serviceInstance
+				succeeded.LastOperation.State = "succeeded"
+				succeeded.LastOperation.Description = ""
+				serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+					if serviceRepo.FindInstanceByNameCallCount() > 1 {
+						return succeeded, nil
+					}
+					return serviceInstance, nil
+				}
+
+				Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "--wait"})).To(BeTrue())
+
+				Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(2))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Creating service instance", "my-cleardb-service"},
+					[]string{"Waiting for the operation to complete..."},
+					[]string{"create in progress: fake service instance description"},
+					[]string{"create succeeded"},
+					[]string{"OK"},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"to check operation status"}))
+			})
+
+			It("fails with the service broker's description when the creation fails", func() {
+				failed := serviceInstance
+				failed.LastOperation.State = "failed"
+				failed.LastOperation.Description = "the broker is out of databases"
+				serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+					if serviceRepo.FindInstanceByNameCallCount() > 1 {
+						return failed, nil
+					}
+					return serviceInstance, nil
+				}
+
+				Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "--wait"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Waiting for the operation to complete..."},
+					[]string{"create failed: the broker is out of databases"},
+					[]string{"FAILED"},
+					[]string{"Create of service instance my-cleardb-service failed: the broker is out of databases"},
+				))
+			})
+		})
+
 		It("fails when service instance could is created but cannot be found", func() {
 			serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, errors.New("Error finding instance"))
 			callCreateService([]string{"cleardb", "spark", "fake-service-instance-name"})
@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -18,6 +19,8 @@ type DeleteService struct {
 	config             coreconfig.Reader
 	serviceRepo        api.ServiceRepository
 	serviceInstanceReq requirements.ServiceInstanceRequirement
+
+	PollingInterval time.Duration
 }
 
 func init() {
@@ -27,13 +30,15 @@ func init() {
 func (cmd *DeleteService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the service instance to be deleted")}
+	fs["wait-timeout"] = &flags.IntFlag{Name: "wait-timeout", Usage: T("Maximum number of minutes to wait with --wait (Default: 60)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "delete-service",
 		ShortName:   "ds",
 		Description: T("Delete a service instance"),
 		Usage: []string{
-			T("CF_NAME delete-service SERVICE_INSTANCE [-f]"),
+			T("CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait [--wait-timeout MINUTES]]"),
 		},
 		Flags: fs,
 	}
@@ -45,6 +50,11 @@ func (cmd *DeleteService) Requirements(requirementsFactory requirements.Factory,
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	err := validateWaitFlags(cmd.ui, fc)
+	if err != nil {
+		return nil, err
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 	}
@@ -56,6 +66,7 @@ func (cmd *DeleteService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.PollingInterval = DefaultServiceInstancePollingInterval
 	return cmd
 }
 
@@ -93,6 +104,15 @@ func (cmd *DeleteService) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	if c.Bool("wait") {
+		err = waitForServiceInstanceOperation(serviceName, cmd.serviceRepo, cmd.ui, cmd.PollingInterval, waitTimeout(c))
+		if _, ok := err.(*errors.ModelNotFoundError); ok {
+			cmd.ui.Ok()
+			return nil
+		}
+		return err
+	}
+
 	err = printSuccessMessageForServiceInstance(serviceName, cmd.serviceRepo, cmd.ui)
 	if err != nil {
 		cmd.ui.Ok()
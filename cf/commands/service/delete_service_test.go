@@ -1,8 +1,11 @@
 package service_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/service"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -31,7 +34,9 @@ var _ = Describe("delete-service command", func() {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.Config = configRepo
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("delete-service").SetDependency(deps, pluginCall))
+		cmd := commandregistry.Commands.FindCommand("delete-service").SetDependency(deps, pluginCall)
+		cmd.(*service.DeleteService).PollingInterval = time.Millisecond
+		commandregistry.Commands.SetCommand(cmd)
 	}
 
 	BeforeEach(func() {
@@ -109,6 +114,48 @@ var _ = Describe("delete-service command", func() {
 						[]string{"Delete in progress. Use 'cf services' or 'cf service foo.com' to check operation status."},
 					))
 				})
+
+				Context("when --wait is provided", func() {
+					It("waits until the service instance is gone", func() {
+						serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+							if serviceRepo.FindInstanceByNameCallCount() > 2 {
+								return models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", "my-service")
+							}
+							return serviceInstance, nil
+						}
+
+						Expect(runCommand("-f", "my-service", "--wait")).To(BeTrue())
+
+						Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(3))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Deleting service", "my-service"},
+							[]string{"Waiting for the operation to complete..."},
+							[]string{"delete in progress: delete"},
+							[]string{"OK"},
+						))
+						Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"to check operation status"}))
+					})
+
+					It("fails with the service broker's description when the deletion fails", func() {
+						failed := serviceInstance
+						failed.LastOperation.State = "failed"
+						failed.LastOperation.Description = "the instance is still in use"
+						serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+							if serviceRepo.FindInstanceByNameCallCount() > 2 {
+								return failed, nil
+							}
+							return serviceInstance, nil
+						}
+
+						Expect(runCommand("-f", "my-service", "--wait")).To(BeFalse())
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"delete failed: the instance is still in use"},
+							[]string{"FAILED"},
+							[]string{"Delete of service instance my-service failed: the instance is still in use"},
+						))
+					})
+				})
 			})
 
 			Context("and the service deletion is synchronous", func() {
@@ -143,6 +190,21 @@ var _ = Describe("delete-service command", func() {
 						[]string{"OK"},
 					))
 				})
+
+				It("does not wait when the service instance is already gone", func() {
+					serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+						if serviceRepo.FindInstanceByNameCallCount() > 1 {
+							return models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", "my-service")
+						}
+						return serviceInstance, nil
+					}
+
+					Expect(runCommand("-f", "my-service", "--wait")).To(BeTrue())
+
+					Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(2))
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Waiting for the operation to complete..."}))
+				})
 			})
 		})
 
@@ -1,15 +1,16 @@
 package service
 
 import (
-	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/actors/planbuilder"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -19,11 +20,26 @@ import (
 	"code.cloudfoundry.org/cli/util/json"
 )
 
+const (
+	// DefaultServiceInstancePollingInterval is the time --wait waits before
+	// checking on an asynchronous service instance operation for the first
+	// time. The time between checks doubles up to
+	// maxServiceInstancePollingInterval.
+	DefaultServiceInstancePollingInterval = 2 * time.Second
+	maxServiceInstancePollingInterval     = 30 * time.Second
+
+	// DefaultWaitTimeoutMinutes is how long --wait waits when --wait-timeout
+	// is not provided.
+	DefaultWaitTimeoutMinutes = 60
+)
+
 type UpdateService struct {
 	ui          terminal.UI
 	config      coreconfig.Reader
 	serviceRepo api.ServiceRepository
 	planBuilder planbuilder.PlanBuilder
+
+	PollingInterval time.Duration
 }
 
 func init() {
@@ -31,7 +47,7 @@ func init() {
 }
 
 func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
-	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON [--skip-schema-validation]] [-t TAGS] [--wait [--wait-timeout MINUTES]]")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line.
    CF_NAME update-service -c '{"name":"value","name":"value"}'
 
@@ -45,13 +61,20 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
          "count": 5,
          "memory_mb": 1024
       }
-   }`)
+   }
+
+   Configuration parameters are validated against the JSON schema published by the service plan, if any.
+   Use --skip-schema-validation to send them to the service broker as they are.`)
 	tagsUsage := T(`   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.`)
+	waitUsage := T(`   Optionally use --wait to wait for the service broker to finish updating the service instance.`)
 
 	fs := make(map[string]flags.FlagSet)
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Change service plan for a service instance")}
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["skip-schema-validation"] = &flags.BoolFlag{Name: "skip-schema-validation", Usage: T("Do not validate the configuration parameters against the JSON schema published by the service plan")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the service instance to be updated")}
+	fs["wait-timeout"] = &flags.IntFlag{Name: "wait-timeout", Usage: T("Maximum number of minutes to wait with --wait (Default: 60)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "update-service",
@@ -62,6 +85,8 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 			paramsUsage,
 			"\n\n",
 			tagsUsage,
+			"\n\n",
+			waitUsage,
 		},
 		Examples: []string{
 			`CF_NAME update-service mydb -p gold`,
@@ -79,6 +104,11 @@ func (cmd *UpdateService) Requirements(requirementsFactory requirements.Factory,
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	err := validateWaitFlags(cmd.ui, fc)
+	if err != nil {
+		return nil, err
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewTargetedSpaceRequirement(),
@@ -96,6 +126,7 @@ func (cmd *UpdateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 	cmd.planBuilder = deps.PlanBuilder
+	cmd.PollingInterval = DefaultServiceInstancePollingInterval
 	return cmd
 }
 
@@ -135,10 +166,27 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 
 	cmd.printUpdatingServiceInstanceMessage(serviceInstanceName)
 
+	if paramsMap != nil && !c.Bool("skip-schema-validation") {
+		schemaPlan := serviceInstance.ServicePlan
+		if planName != "" {
+			schemaPlan = plan
+		}
+
+		err = validateServiceParameters(schemaPlan.Schemas.ServiceInstanceUpdateParameters, paramsMap)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = cmd.serviceRepo.UpdateServiceInstance(serviceInstance.GUID, plan.GUID, paramsMap, tags)
 	if err != nil {
 		return err
 	}
+
+	if c.Bool("wait") {
+		return waitForServiceInstanceOperation(serviceInstanceName, cmd.serviceRepo, cmd.ui, cmd.PollingInterval, waitTimeout(c))
+	}
+
 	err = printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
 	if err != nil {
 		return err
@@ -192,3 +240,109 @@ func printSuccessMessageForServiceInstance(serviceInstanceName string, serviceRe
 
 	return nil
 }
+
+func validateWaitFlags(ui terminal.UI, fc flags.FlagContext) error {
+	if !fc.IsSet("wait-timeout") {
+		return nil
+	}
+
+	if !fc.Bool("wait") {
+		ui.Failed(T("Incorrect Usage: --wait-timeout can only be used with --wait"))
+		return fmt.Errorf("Incorrect usage: --wait-timeout can only be used with --wait")
+	}
+
+	if fc.Int("wait-timeout") <= 0 {
+		ui.Failed(T("Incorrect Usage: --wait-timeout must be a positive number of minutes"))
+		return fmt.Errorf("Incorrect usage: --wait-timeout must be a positive number of minutes")
+	}
+
+	return nil
+}
+
+func waitTimeout(fc flags.FlagContext) time.Duration {
+	if fc.IsSet("wait-timeout") {
+		return time.Duration(fc.Int("wait-timeout")) * time.Minute
+	}
+	return DefaultWaitTimeoutMinutes * time.Minute
+}
+
+// waitForServiceInstanceOperation polls the service instance until its last
+// operation is no longer in progress, displaying every change of the
+// operation's state. The time between checks starts at pollingInterval and
+// doubles up to maxServiceInstancePollingInterval. A service instance that
+// can no longer be found while it is being deleted has been deleted.
+func waitForServiceInstanceOperation(serviceInstanceName string, serviceRepo api.ServiceRepository, ui terminal.UI, pollingInterval time.Duration, timeout time.Duration) error {
+	instance, err := serviceRepo.FindInstanceByName(serviceInstanceName)
+	if err != nil {
+		return err
+	}
+
+	lastOperation := instance.ServiceInstanceFields.LastOperation
+	if lastOperation.State == "in progress" {
+		ui.Say(T("Waiting for the operation to complete..."))
+		sayLastOperation(lastOperation, ui)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for lastOperation.State == "in progress" {
+		if !time.Now().Before(deadline) {
+			return errors.New(T("Timed out after {{.Timeout}} minutes waiting for the {{.Operation}} of service instance {{.ServiceInstanceName}} to finish. Use '{{.ServiceCommand}}' to check operation status.",
+				map[string]interface{}{
+					"Timeout":             int(timeout.Minutes()),
+					"Operation":           lastOperation.Type,
+					"ServiceInstanceName": serviceInstanceName,
+					"ServiceCommand":      terminal.CommandColor(fmt.Sprintf("cf service %s", serviceInstanceName)),
+				}))
+		}
+
+		time.Sleep(pollingInterval)
+		pollingInterval *= 2
+		if pollingInterval > maxServiceInstancePollingInterval {
+			pollingInterval = maxServiceInstancePollingInterval
+		}
+
+		instance, err = serviceRepo.FindInstanceByName(serviceInstanceName)
+		if _, ok := err.(*errors.ModelNotFoundError); ok && lastOperation.Type == "delete" {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		current := instance.ServiceInstanceFields.LastOperation
+		if current.State != lastOperation.State || current.Description != lastOperation.Description {
+			sayLastOperation(current, ui)
+		}
+		lastOperation = current
+	}
+
+	if lastOperation.State == "failed" {
+		return errors.New(T("{{.Operation}} of service instance {{.ServiceInstanceName}} failed: {{.Description}}",
+			map[string]interface{}{
+				"Operation":           strings.Title(lastOperation.Type),
+				"ServiceInstanceName": serviceInstanceName,
+				"Description":         lastOperation.Description,
+			}))
+	}
+
+	ui.Ok()
+	return nil
+}
+
+func sayLastOperation(lastOperation models.LastOperationFields, ui terminal.UI) {
+	if lastOperation.Description == "" {
+		ui.Say(T("{{.Operation}} {{.State}}",
+			map[string]interface{}{
+				"Operation": lastOperation.Type,
+				"State":     lastOperation.State,
+			}))
+		return
+	}
+
+	ui.Say(T("{{.Operation}} {{.State}}: {{.Description}}",
+		map[string]interface{}{
+			"Operation":   lastOperation.Type,
+			"State":       lastOperation.State,
+			"Description": lastOperation.Description,
+		}))
+}
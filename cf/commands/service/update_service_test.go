@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"time"
 
 	planbuilderfakes "code.cloudfoundry.org/cli/cf/actors/planbuilder/planbuilderfakes"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
@@ -39,7 +40,9 @@ var _ = Describe("update-service command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.Config = config
 		deps.PlanBuilder = planBuilder
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall))
+		cmd := commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall)
+		cmd.(*service.UpdateService).PollingInterval = time.Millisecond
+		commandregistry.Commands.SetCommand(cmd)
 	}
 
 	BeforeEach(func() {
@@ -135,8 +138,13 @@ var _ = Describe("update-service command", func() {
 	})
 
 	Context("when passing arbitrary params", func() {
+		var (
+			serviceInstance models.ServiceInstance
+			servicePlans    []models.ServicePlanFields
+		)
+
 		BeforeEach(func() {
-			serviceInstance := models.ServiceInstance{
+			serviceInstance = models.ServiceInstance{
 				ServiceInstanceFields: models.ServiceInstanceFields{
 					Name: "my-service-instance",
 					GUID: "my-service-instance-guid",
@@ -152,7 +160,7 @@ var _ = Describe("update-service command", func() {
 				},
 			}
 
-			servicePlans := []models.ServicePlanFields{{
+			servicePlans = []models.ServicePlanFields{{
 				Name: "spark",
 				GUID: "murkydb-spark-guid",
 			}, {
@@ -164,6 +172,47 @@ var _ = Describe("update-service command", func() {
 			planBuilder.GetPlansForServiceForOrgReturns(servicePlans, nil)
 		})
 
+		Context("when the plans publish schemas for the params", func() {
+			BeforeEach(func() {
+				serviceInstance.ServicePlan = models.ServicePlanFields{
+					Name: "spark",
+					GUID: "murkydb-spark-guid",
+					Schemas: models.ServicePlanSchemas{
+						ServiceInstanceUpdateParameters: map[string]interface{}{"required": []interface{}{"size"}},
+					},
+				}
+				servicePlans[1].Schemas.ServiceInstanceUpdateParameters = map[string]interface{}{"required": []interface{}{"nodes"}}
+				serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+				planBuilder.GetPlansForServiceForOrgReturns(servicePlans, nil)
+			})
+
+			It("validates the params against the schema of the current plan", func() {
+				Expect(callUpdateService([]string{"-c", `{"foo": "bar"}`, "my-service-instance"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"The configuration parameters do not match the schema published by the service plan:"},
+					[]string{"(root): size is required"},
+				))
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			It("validates the params against the schema of the new plan when the plan is changed", func() {
+				Expect(callUpdateService([]string{"-p", "flare", "-c", `{"size": "small"}`, "my-service-instance"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"(root): nodes is required"},
+				))
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			It("updates the service without validating the params when --skip-schema-validation is provided", func() {
+				Expect(callUpdateService([]string{"-c", `{"foo": "bar"}`, "--skip-schema-validation", "my-service-instance"})).To(BeTrue())
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("as a json string", func() {
 			It("successfully updates a service", func() {
 				callUpdateService([]string{"-p", "flare", "-c", `{"foo": "bar"}`, "my-service-instance"})
@@ -290,8 +339,10 @@ var _ = Describe("update-service command", func() {
 
 	Context("when service update is asynchronous", func() {
 		Context("when the plan flag is passed", func() {
+			var serviceInstance models.ServiceInstance
+
 			BeforeEach(func() {
-				serviceInstance := models.ServiceInstance{
+				serviceInstance = models.ServiceInstance{
 					ServiceInstanceFields: models.ServiceInstanceFields{
 						Name: "my-service-instance",
 						GUID: "my-service-instance-guid",
@@ -319,6 +370,32 @@ var _ = Describe("update-service command", func() {
 				planBuilder.GetPlansForServiceForOrgReturns(servicePlans, nil)
 			})
 
+			Context("when --wait is provided", func() {
+				It("waits for the service broker to finish updating the service instance", func() {
+					succeeded := serviceInstance
+					succeeded.LastOperation.State = "succeeded"
+					succeeded.LastOperation.Description = ""
+					serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+						if serviceRepo.FindInstanceByNameCallCount() > 2 {
+							return succeeded, nil
+						}
+						return serviceInstance, nil
+					}
+
+					Expect(callUpdateService([]string{"-p", "flare", "my-service-instance", "--wait"})).To(BeTrue())
+
+					Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(3))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Updating service", "my-service", "as", "my-user", "..."},
+						[]string{"Waiting for the operation to complete..."},
+						[]string{"update in progress: fake service instance description"},
+						[]string{"update succeeded"},
+						[]string{"OK"},
+					))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"to check operation status"}))
+				})
+			})
+
 			It("successfully updates a service", func() {
 				callUpdateService([]string{"-p", "flare", "my-service-instance"})
 
@@ -42,6 +42,14 @@ type Data struct {
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UserAliases              []UserAlias `json:",omitempty"`
+}
+
+// UserAlias is a user-defined command alias. It is only managed by the
+// refactored commands; it is kept here so that it survives config writes.
+type UserAlias struct {
+	Name  string
+	Steps []string
 }
 
 func NewData() *Data {
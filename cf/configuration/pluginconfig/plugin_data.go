@@ -14,6 +14,7 @@ type PluginMetadata struct {
 	Location string
 	Version  plugin.VersionType
 	Commands []plugin.Command
+	Hooks    []string `json:",omitempty"`
 }
 
 func NewData() *PluginData {
@@ -9,6 +9,12 @@ type ServicePlanFields struct {
 	Active              bool
 	ServiceOfferingGUID string
 	OrgNames            []string
+	Schemas             ServicePlanSchemas
+}
+
+type ServicePlanSchemas struct {
+	ServiceInstanceCreateParameters map[string]interface{}
+	ServiceInstanceUpdateParameters map[string]interface{}
 }
 
 type ServicePlan struct {
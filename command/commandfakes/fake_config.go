@@ -234,6 +234,11 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemoveUserAliasStub        func(name string)
+	removeUserAliasMutex       sync.RWMutex
+	removeUserAliasArgsForCall []struct {
+		name string
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct{}
@@ -300,6 +305,12 @@ type FakeConfig struct {
 	setUAAGrantTypeArgsForCall []struct {
 		uaaGrantType string
 	}
+	SetUserAliasStub        func(name string, steps []string)
+	setUserAliasMutex       sync.RWMutex
+	setUserAliasArgsForCall []struct {
+		name  string
+		steps []string
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct{}
@@ -408,10 +419,19 @@ type FakeConfig struct {
 	UnsetUserInformationStub                        func()
 	unsetUserInformationMutex                       sync.RWMutex
 	unsetUserInformationArgsForCall                 []struct{}
-	VerboseStub                                     func() (bool, []string)
-	verboseMutex                                    sync.RWMutex
-	verboseArgsForCall                              []struct{}
-	verboseReturns                                  struct {
+	UserAliasesStub                                 func() []configv3.UserAlias
+	userAliasesMutex                                sync.RWMutex
+	userAliasesArgsForCall                          []struct{}
+	userAliasesReturns                              struct {
+		result1 []configv3.UserAlias
+	}
+	userAliasesReturnsOnCall map[int]struct {
+		result1 []configv3.UserAlias
+	}
+	VerboseStub        func() (bool, []string)
+	verboseMutex       sync.RWMutex
+	verboseArgsForCall []struct{}
+	verboseReturns     struct {
 		result1 bool
 		result2 []string
 	}
@@ -1410,6 +1430,30 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemoveUserAlias(name string) {
+	fake.removeUserAliasMutex.Lock()
+	fake.removeUserAliasArgsForCall = append(fake.removeUserAliasArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveUserAlias", []interface{}{name})
+	fake.removeUserAliasMutex.Unlock()
+	if fake.RemoveUserAliasStub != nil {
+		fake.RemoveUserAliasStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveUserAliasCallCount() int {
+	fake.removeUserAliasMutex.RLock()
+	defer fake.removeUserAliasMutex.RUnlock()
+	return len(fake.removeUserAliasArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveUserAliasArgsForCall(i int) string {
+	fake.removeUserAliasMutex.RLock()
+	defer fake.removeUserAliasMutex.RUnlock()
+	return fake.removeUserAliasArgsForCall[i].name
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -1678,6 +1722,36 @@ func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
 	return fake.setUAAGrantTypeArgsForCall[i].uaaGrantType
 }
 
+func (fake *FakeConfig) SetUserAlias(name string, steps []string) {
+	var stepsCopy []string
+	if steps != nil {
+		stepsCopy = make([]string, len(steps))
+		copy(stepsCopy, steps)
+	}
+	fake.setUserAliasMutex.Lock()
+	fake.setUserAliasArgsForCall = append(fake.setUserAliasArgsForCall, struct {
+		name  string
+		steps []string
+	}{name, stepsCopy})
+	fake.recordInvocation("SetUserAlias", []interface{}{name, stepsCopy})
+	fake.setUserAliasMutex.Unlock()
+	if fake.SetUserAliasStub != nil {
+		fake.SetUserAliasStub(name, steps)
+	}
+}
+
+func (fake *FakeConfig) SetUserAliasCallCount() int {
+	fake.setUserAliasMutex.RLock()
+	defer fake.setUserAliasMutex.RUnlock()
+	return len(fake.setUserAliasArgsForCall)
+}
+
+func (fake *FakeConfig) SetUserAliasArgsForCall(i int) (string, []string) {
+	fake.setUserAliasMutex.RLock()
+	defer fake.setUserAliasMutex.RUnlock()
+	return fake.setUserAliasArgsForCall[i].name, fake.setUserAliasArgsForCall[i].steps
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -2166,6 +2240,46 @@ func (fake *FakeConfig) UnsetUserInformationCallCount() int {
 	return len(fake.unsetUserInformationArgsForCall)
 }
 
+func (fake *FakeConfig) UserAliases() []configv3.UserAlias {
+	fake.userAliasesMutex.Lock()
+	ret, specificReturn := fake.userAliasesReturnsOnCall[len(fake.userAliasesArgsForCall)]
+	fake.userAliasesArgsForCall = append(fake.userAliasesArgsForCall, struct{}{})
+	fake.recordInvocation("UserAliases", []interface{}{})
+	fake.userAliasesMutex.Unlock()
+	if fake.UserAliasesStub != nil {
+		return fake.UserAliasesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.userAliasesReturns.result1
+}
+
+func (fake *FakeConfig) UserAliasesCallCount() int {
+	fake.userAliasesMutex.RLock()
+	defer fake.userAliasesMutex.RUnlock()
+	return len(fake.userAliasesArgsForCall)
+}
+
+func (fake *FakeConfig) UserAliasesReturns(result1 []configv3.UserAlias) {
+	fake.UserAliasesStub = nil
+	fake.userAliasesReturns = struct {
+		result1 []configv3.UserAlias
+	}{result1}
+}
+
+func (fake *FakeConfig) UserAliasesReturnsOnCall(i int, result1 []configv3.UserAlias) {
+	fake.UserAliasesStub = nil
+	if fake.userAliasesReturnsOnCall == nil {
+		fake.userAliasesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.UserAlias
+		})
+	}
+	fake.userAliasesReturnsOnCall[i] = struct {
+		result1 []configv3.UserAlias
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -2302,6 +2416,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removeUserAliasMutex.RLock()
+	defer fake.removeUserAliasMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
@@ -2322,6 +2438,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.setUAAGrantTypeMutex.RLock()
 	defer fake.setUAAGrantTypeMutex.RUnlock()
+	fake.setUserAliasMutex.RLock()
+	defer fake.setUserAliasMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
@@ -2350,6 +2468,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetUserInformationMutex.RLock()
 	defer fake.unsetUserInformationMutex.RUnlock()
+	fake.userAliasesMutex.RLock()
+	defer fake.userAliasesMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . AliasActor
+
+// AliasActor handles the business logic of the alias command
+type AliasActor interface {
+	DeleteUserAlias(name string) error
+	SetUserAlias(commandList sharedaction.CommandList, name string, steps []string) error
+}
+
+type AliasCommand struct {
+	RequiredArgs    flag.AliasArgs `positional-args:"yes"`
+	usage           interface{}    `usage:"CF_NAME alias set NAME COMMAND...\n   CF_NAME alias delete NAME\n   CF_NAME alias list\n\nEXAMPLES:\n   CF_NAME alias set deploy \"push -f manifest-prod.yml --vars-file prod.yml\"\n   CF_NAME alias set bounce \"stop my-app\" \"start my-app\"\n   CF_NAME deploy --no-start\n\nTIP:\n   Each COMMAND is a CLI command line without CF_NAME; an alias with several COMMANDs runs them in order and stops at the first one that fails. Arguments given when running an alias are appended to its last COMMAND."`
+	relatedCommands interface{}    `related_commands:"help, plugins"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  AliasActor
+}
+
+func (cmd *AliasCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config)
+
+	return nil
+}
+
+func (cmd AliasCommand) Execute(args []string) error {
+	switch cmd.RequiredArgs.Action.Action {
+	case "set":
+		return cmd.setAlias()
+	case "delete":
+		return cmd.deleteAlias()
+	default:
+		return cmd.listAliases()
+	}
+}
+
+func (cmd AliasCommand) setAlias() error {
+	if cmd.RequiredArgs.Name == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "NAME"}
+	}
+	if len(cmd.RequiredArgs.Steps) == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "COMMAND"}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting alias {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Actor.SetUserAlias(Commands, cmd.RequiredArgs.Name, cmd.RequiredArgs.Steps)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd AliasCommand) deleteAlias() error {
+	if cmd.RequiredArgs.Name == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "NAME"}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting alias {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Actor.DeleteUserAlias(cmd.RequiredArgs.Name)
+	if _, ok := err.(actionerror.UserAliasNotFoundError); ok {
+		cmd.UI.DisplayWarning("Alias {{.Name}} does not exist.", map[string]interface{}{
+			"Name": cmd.RequiredArgs.Name,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd AliasCommand) listAliases() error {
+	aliases := cmd.Config.UserAliases()
+	if len(aliases) == 0 {
+		cmd.UI.DisplayText("No aliases found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("alias"),
+			cmd.UI.TranslateText("command"),
+		},
+	}
+	for _, alias := range aliases {
+		table = append(table, []string{alias.Name, strings.Join(alias.Steps, "; ")})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,180 @@
+package common_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("alias Command", func() {
+	var (
+		cmd        AliasCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeAliasActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeAliasActor)
+
+		cmd = AliasCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("set", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.AliasAction{Action: "set"}
+			cmd.RequiredArgs.Name = "bounce"
+			cmd.RequiredArgs.Steps = []string{"stop my-app", "start my-app"}
+		})
+
+		It("saves the alias", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Setting alias bounce..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.SetUserAliasCallCount()).To(Equal(1))
+			commandList, name, steps := fakeActor.SetUserAliasArgsForCall(0)
+			Expect(commandList).To(Equal(Commands))
+			Expect(name).To(Equal("bounce"))
+			Expect(steps).To(Equal([]string{"stop my-app", "start my-app"}))
+		})
+
+		Context("when no name is given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Name = ""
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "NAME"}))
+				Expect(fakeActor.SetUserAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no command is given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Steps = nil
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "COMMAND"}))
+				Expect(fakeActor.SetUserAliasCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the actor returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = actionerror.UserAliasConflictError{Name: "bounce"}
+				fakeActor.SetUserAliasReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Describe("delete", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.AliasAction{Action: "delete"}
+			cmd.RequiredArgs.Name = "bounce"
+		})
+
+		It("deletes the alias", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Deleting alias bounce..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.DeleteUserAliasCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteUserAliasArgsForCall(0)).To(Equal("bounce"))
+		})
+
+		Context("when no name is given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Name = ""
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "NAME"}))
+			})
+		})
+
+		Context("when the alias does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserAliasReturns(actionerror.UserAliasNotFoundError{Name: "bounce"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Alias bounce does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the actor returns any other error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.DeleteUserAliasReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("list", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.AliasAction{Action: "list"}
+		})
+
+		Context("when there are no aliases", func() {
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No aliases found."))
+			})
+		})
+
+		Context("when there are aliases", func() {
+			BeforeEach(func() {
+				fakeConfig.UserAliasesReturns([]configv3.UserAlias{
+					{Name: "bounce", Steps: []string{"stop my-app", "start my-app"}},
+					{Name: "deploy", Steps: []string{"push -f manifest.yml"}},
+				})
+			})
+
+			It("displays them in a table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`alias\s+command`))
+				Expect(testUI.Out).To(Say(`bounce\s+stop my-app; start my-app`))
+				Expect(testUI.Out).To(Say(`deploy\s+push -f manifest.yml`))
+			})
+		})
+	})
+})
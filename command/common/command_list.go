@@ -38,8 +38,10 @@ type commandList struct {
 
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
+	Alias                              AliasCommand                                 `command:"alias" description:"Set, delete or list user-defined command aliases"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	ApplyRoles                         v2.ApplyRolesCommand                         `command:"apply-roles" description:"Grant and revoke org and space roles listed in a file"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for an app"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
@@ -48,8 +50,14 @@ type commandList struct {
 	BindSecurityGroup                  v2.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
 	BindService                        v2.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
+	Binding                            v2.BindingCommand                            `command:"binding" description:"Show the parameters and credentials of a service binding"`
+	Bootstrap                          v2.BootstrapCommand                          `command:"bootstrap" description:"Create or update an org, its spaces, quotas, roles and security groups from a template file"`
+	BuildInfo                          v3.BuildInfoCommand                          `command:"build-info" description:"Show the buildpacks that staged an app's current droplet and whether newer versions are available"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	CleanupApp                         v3.CleanupAppCommand                         `command:"cleanup-app" description:"Delete old droplets and packages of an app or of all apps in a space"`
+	Complete                           v2.CompleteCommand                           `command:"__complete" hidden:"true" description:"List resource names for shell completion"`
+	Completion                         CompletionCommand                            `command:"completion" description:"Print a shell completion script"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -65,6 +73,7 @@ type commandList struct {
 	CreateServiceKey                   v2.CreateServiceKeyCommand                   `command:"create-service-key" alias:"csk" description:"Create key for a service instance"`
 	CreateService                      v2.CreateServiceCommand                      `command:"create-service" alias:"cs" description:"Create a service instance"`
 	CreateSharedDomain                 v2.CreateSharedDomainCommand                 `command:"create-shared-domain" description:"Create a domain that can be used by all orgs (admin-only)"`
+	CreateSpaceManifest                v2.CreateSpaceManifestCommand                `command:"create-space-manifest" description:"Create a manifest for all apps in the target space"`
 	CreateSpaceQuota                   v2.CreateSpaceQuotaCommand                   `command:"create-space-quota" description:"Define a new space resource quota"`
 	CreateSpace                        v2.CreateSpaceCommand                        `command:"create-space" description:"Create a space"`
 	CreateUserProvidedService          v2.CreateUserProvidedServiceCommand          `command:"create-user-provided-service" alias:"cups" description:"Make a user-provided service instance available to CF apps"`
@@ -93,17 +102,21 @@ type commandList struct {
 	DisableSSH                         v2.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
 	DisallowSpaceSSH                   v2.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
 	Domains                            v2.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
+	DownloadDroplet                    v3.DownloadDropletCommand                    `command:"download-droplet" description:"Download the droplet of an app to a file"`
+	DownloadPackage                    v3.DownloadPackageCommand                    `command:"download-package" description:"Download the package of an app to a file"`
 	EnableFeatureFlag                  v2.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v3.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableServiceAccess                v2.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service or service plan for one or all orgs"`
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportUserProvidedServices         v2.ExportUserProvidedServicesCommand         `command:"export-user-provided-services" alias:"export-ups" description:"Export user-provided service instances to a file"`
 	FeatureFlags                       v2.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	FeatureFlag                        v2.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	ImportUserProvidedServices         v2.ImportUserProvidedServicesCommand         `command:"import-user-provided-services" alias:"import-ups" description:"Create user-provided service instances from a file"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
@@ -114,12 +127,16 @@ type commandList struct {
 	MapRoute                           v2.MapRouteCommand                           `command:"map-route" description:"Add a url route to an app"`
 	Marketplace                        v2.MarketplaceCommand                        `command:"marketplace" alias:"m" description:"List available offerings in the marketplace"`
 	MigrateServiceInstances            v2.MigrateServiceInstancesCommand            `command:"migrate-service-instances" description:"Migrate service instances from one service plan to another"`
+	MoveService                        v2.MoveServiceCommand                        `command:"move-service" description:"Move a user-provided service instance to another space"`
 	OauthToken                         v2.OauthTokenCommand                         `command:"oauth-token" description:"Retrieve and display the OAuth token for the current session"`
 	Orgs                               v2.OrgsCommand                               `command:"orgs" alias:"o" description:"List all orgs"`
 	OrgUsers                           v2.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
+	PluginRepoMirror                   plugin.PluginRepoMirrorCommand               `command:"plugin-repo-mirror" description:"Copy the plugins of a plugin repository into a local directory"`
+	PluginRepoServe                    plugin.PluginRepoServeCommand                `command:"plugin-repo-serve" description:"Serve a directory of plugin binaries as a plugin repository"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	Promote                            v3.PromoteCommand                            `command:"promote" description:"Copy an app's droplet and settings to an app in another space or foundation and start it"`
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v2.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v2.V2PushCommand                             `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -137,8 +154,12 @@ type commandList struct {
 	ResetOrgDefaultIsolationSegment    v3.ResetOrgDefaultIsolationSegmentCommand    `command:"reset-org-default-isolation-segment" description:"Reset the default isolation segment used for apps in spaces of an org"`
 	ResetSpaceIsolationSegment         v3.ResetSpaceIsolationSegmentCommand         `command:"reset-space-isolation-segment" description:"Reset the space's isolation segment to the org default"`
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
+	RestageFleet                       v2.RestageFleetCommand                       `command:"restage-fleet" description:"Restage the apps staged with a buildpack or running on a stack"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate, then restart an app instance"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This causes downtime."`
+	Roles                              v2.RolesCommand                              `command:"roles" description:"List all roles held in an org and its spaces"`
+	Rollback                           v3.RollbackCommand                           `command:"rollback" description:"Set an app's droplet to a previous droplet and restart it, reverting if it fails to start"`
+	RotateServiceKey                   v2.RotateServiceKeyCommand                   `command:"rotate-service-key" description:"Replace a service key with a new one and re-bind apps to the service instance"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -205,6 +226,8 @@ type commandList struct {
 	UpdateService                      v2.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v2.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v2.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UserRoles                          v2.UserRolesCommand                          `command:"user-roles" description:"List the org and space roles held by a user"`
+	ValidateManifest                   v2.ValidateManifestCommand                   `command:"validate-manifest" description:"Report every problem in an app manifest"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/common/internal"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+//go:generate counterfeiter . CompletionActor
+
+// CompletionActor handles the business logic of the completion command
+type CompletionActor interface {
+	// CompletionCommands returns the completion details of all visible commands
+	CompletionCommands(commandList interface{}) []sharedaction.CompletionCommand
+}
+
+type CompletionCommand struct {
+	RequiredArgs    flag.CompletionShellArg `positional-args:"yes"`
+	usage           interface{}             `usage:"CF_NAME completion SHELL\n\nEXAMPLES:\n   source <(CF_NAME completion bash)\n   source <(CF_NAME completion zsh)\n   CF_NAME completion fish > ~/.config/fish/completions/CF_NAME.fish\n\nTIP:\n   Add the 'source' line for your shell to ~/.bashrc or ~/.zshrc to enable completion in every new shell. Regenerate the script after installing or uninstalling plugins to complete their commands."`
+	relatedCommands interface{}             `related_commands:"help, plugins"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  CompletionActor
+}
+
+func (cmd *CompletionCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config)
+
+	return nil
+}
+
+func (cmd CompletionCommand) Execute(args []string) error {
+	commands := cmd.Actor.CompletionCommands(Commands)
+	for _, plugin := range cmd.Config.Plugins() {
+		for _, pluginCommand := range plugin.Commands {
+			commands = append(commands, internal.ConvertPluginToCompletionCommand(pluginCommand))
+		}
+	}
+	sort.Slice(commands, func(i int, j int) bool {
+		return commands[i].Name < commands[j].Name
+	})
+
+	script, err := internal.CompletionScript(cmd.RequiredArgs.Shell.Shell, cmd.Config.BinaryName(), commands)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(cmd.UI.Writer(), script)
+	return err
+}
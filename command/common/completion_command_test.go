@@ -0,0 +1,126 @@
+package common_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("completion Command", func() {
+	var (
+		cmd        CompletionCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *commonfakes.FakeCompletionActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(commonfakes.FakeCompletionActor)
+		fakeActor.CompletionCommandsReturns([]sharedaction.CompletionCommand{
+			{
+				Name:        "target",
+				Alias:       "t",
+				Description: "Set or view the targeted org or space",
+				Flags: []sharedaction.CompletionFlag{
+					{Short: "o", Description: "Organization", TakesValue: true, Resource: sharedaction.OrgCompletion},
+				},
+			},
+			{
+				Name:        "bind-service",
+				Description: "Bind a service instance to an app",
+				Flags: []sharedaction.CompletionFlag{
+					{Short: "c", Description: "Valid JSON object", TakesValue: true},
+				},
+				Arguments: []sharedaction.CompletionResource{sharedaction.AppCompletion, sharedaction.ServiceInstanceCompletion},
+			},
+		})
+		fakeConfig.PluginsReturns([]configv3.Plugin{
+			{
+				Name: "some-plugin",
+				Commands: []configv3.PluginCommand{
+					{
+						Name:     "some-plugin-command",
+						Alias:    "spc",
+						HelpText: "It's a plugin's command",
+						UsageDetails: configv3.PluginUsageDetails{
+							Options: map[string]string{"force": "Do it", "-q": "Quietly"},
+						},
+					},
+				},
+			},
+		})
+
+		cmd = CompletionCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+		cmd.RequiredArgs.Shell = flag.CompletionShell{Shell: "bash"}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("gets the completion details of the CLI's commands", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeActor.CompletionCommandsCallCount()).To(Equal(1))
+		Expect(fakeActor.CompletionCommandsArgsForCall(0)).To(Equal(Commands))
+	})
+
+	Context("when generating a bash script", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Shell = flag.CompletionShell{Shell: "bash"}
+		})
+
+		It("completes commands, plugin commands, flags and resource names", func() {
+			Expect(testUI.Out).To(Say("# bash completion for faceman"))
+			Expect(testUI.Out).To(Say(`compgen -W "bind-service some-plugin-command target"`))
+			Expect(testUI.Out).To(Say(`bind-service\) flags="-c"; values="-c"; flagresources=""; args="app service";;`))
+			Expect(testUI.Out).To(Say(`some-plugin-command\|spc\) flags="-q --force"; values=""; flagresources=""; args="";;`))
+			Expect(testUI.Out).To(Say(`target\|t\) flags="-o"; values="-o"; flagresources="-o:org"; args="";;`))
+			Expect(testUI.Out).To(Say(`faceman __complete "\$\{resource\}"`))
+			Expect(testUI.Out).To(Say("complete -F _faceman_complete faceman"))
+		})
+	})
+
+	Context("when generating a zsh script", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Shell = flag.CompletionShell{Shell: "zsh"}
+		})
+
+		It("loads the bash script through bashcompinit", func() {
+			Expect(testUI.Out).To(Say("# zsh completion for faceman"))
+			Expect(testUI.Out).To(Say("bashcompinit"))
+			Expect(testUI.Out).To(Say("complete -F _faceman_complete faceman"))
+		})
+	})
+
+	Context("when generating a fish script", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Shell = flag.CompletionShell{Shell: "fish"}
+		})
+
+		It("completes commands, plugin commands, flags and resource names", func() {
+			Expect(testUI.Out).To(Say("# fish completion for faceman"))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '__fish_use_subcommand' -f -a 'bind-service' -d 'Bind a service instance to an app'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '__fish_seen_subcommand_from bind-service' -s c -r -d 'Valid JSON object'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '__fish_seen_subcommand_from bind-service' -f -a '\(_faceman_positional_resource app service\)'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '__fish_use_subcommand' -f -a 'some-plugin-command' -d 'It\\'s a plugin\\'s command'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '__fish_seen_subcommand_from some-plugin-command spc' -l force -d 'Do it'`))
+			Expect(testUI.Out).To(Say(`complete -c faceman -n '__fish_seen_subcommand_from target t' -s o -x -a '\(faceman __complete org 2>/dev/null\)' -d 'Organization'`))
+		})
+	})
+})
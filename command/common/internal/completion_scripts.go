@@ -0,0 +1,272 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// CompletionShells are the shells a completion script can be generated for.
+var CompletionShells = []string{"bash", "fish", "zsh"}
+
+// CompleteCommandName is the name of the hidden command that the completion
+// scripts run to list resource names from the current target.
+const CompleteCommandName = "__complete"
+
+func ConvertPluginToCompletionCommand(plugin configv3.PluginCommand) sharedaction.CompletionCommand {
+	command := sharedaction.CompletionCommand{
+		Name:        plugin.Name,
+		Alias:       plugin.Alias,
+		Description: plugin.HelpText,
+	}
+
+	flagNames := make([]string, 0, len(plugin.UsageDetails.Options))
+	for flag := range plugin.UsageDetails.Options {
+		flagNames = append(flagNames, flag)
+	}
+	sort.Slice(flagNames, sorting.SortAlphabeticFunc(flagNames))
+
+	for _, flag := range flagNames {
+		strippedFlag := strings.Trim(flag, "-")
+		completionFlag := sharedaction.CompletionFlag{Description: plugin.UsageDetails.Options[flag]}
+		if len(strippedFlag) == 1 {
+			completionFlag.Short = strippedFlag
+		} else {
+			completionFlag.Long = strippedFlag
+		}
+		command.Flags = append(command.Flags, completionFlag)
+	}
+
+	return command
+}
+
+// CompletionScript returns the completion script for shell, which must be
+// one of CompletionShells.
+func CompletionScript(shell string, binaryName string, commands []sharedaction.CompletionCommand) (string, error) {
+	var scriptTemplate *template.Template
+	switch shell {
+	case "bash":
+		scriptTemplate = bashCompletionTemplate
+	case "zsh":
+		scriptTemplate = zshCompletionTemplate
+	case "fish":
+		scriptTemplate = fishCompletionTemplate
+	default:
+		return "", fmt.Errorf("unsupported shell %q", shell)
+	}
+
+	var script bytes.Buffer
+	err := scriptTemplate.Execute(&script, map[string]interface{}{
+		"BinaryName":      binaryName,
+		"FunctionName":    "_" + nonIdentifierCharacters.ReplaceAllString(binaryName, "_"),
+		"Commands":        commands,
+		"CompleteCommand": CompleteCommandName,
+	})
+	return script.String(), err
+}
+
+var nonIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+var completionTemplateFuncs = template.FuncMap{
+	"names": func(command sharedaction.CompletionCommand) string {
+		if command.Alias != "" {
+			return command.Name + "|" + command.Alias
+		}
+		return command.Name
+	},
+	"commandWords": func(commands []sharedaction.CompletionCommand) string {
+		words := make([]string, 0, len(commands))
+		for _, command := range commands {
+			words = append(words, command.Name)
+		}
+		return strings.Join(words, " ")
+	},
+	"flagWords": func(flags []sharedaction.CompletionFlag) string {
+		var words []string
+		for _, flag := range flags {
+			words = append(words, flagForms(flag)...)
+		}
+		return strings.Join(words, " ")
+	},
+	"valueFlagWords": func(flags []sharedaction.CompletionFlag) string {
+		var words []string
+		for _, flag := range flags {
+			if flag.TakesValue {
+				words = append(words, flagForms(flag)...)
+			}
+		}
+		return strings.Join(words, " ")
+	},
+	"flagResourceWords": func(flags []sharedaction.CompletionFlag) string {
+		var words []string
+		for _, flag := range flags {
+			if flag.Resource == sharedaction.NoCompletion {
+				continue
+			}
+			for _, form := range flagForms(flag) {
+				words = append(words, form+":"+string(flag.Resource))
+			}
+		}
+		return strings.Join(words, " ")
+	},
+	"argumentWords": func(arguments []sharedaction.CompletionResource) string {
+		words := make([]string, 0, len(arguments))
+		for _, argument := range arguments {
+			if argument == sharedaction.NoCompletion {
+				words = append(words, "-")
+			} else {
+				words = append(words, string(argument))
+			}
+		}
+		return strings.Join(words, " ")
+	},
+	"hasResourceArguments": func(arguments []sharedaction.CompletionResource) bool {
+		for _, argument := range arguments {
+			if argument != sharedaction.NoCompletion {
+				return true
+			}
+		}
+		return false
+	},
+	"fishQuote": func(text string) string {
+		return "'" + strings.Replace(strings.Replace(text, `\`, `\\`, -1), `'`, `\'`, -1) + "'"
+	},
+}
+
+func flagForms(flag sharedaction.CompletionFlag) []string {
+	var forms []string
+	if flag.Long != "" {
+		forms = append(forms, "--"+flag.Long)
+	}
+	if flag.Short != "" {
+		forms = append(forms, "-"+flag.Short)
+	}
+	return forms
+}
+
+const bashCompletionScript = `# bash completion for {{.BinaryName}}
+#
+# Load it in the current shell with:
+#   source <({{.BinaryName}} completion bash)
+
+{{.FunctionName}}_complete() {
+    local cur prev cmd flags values flagresources args resource
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+
+    if [[ ${COMP_CWORD} -eq 1 ]]; then
+        COMPREPLY=( $(compgen -W "{{commandWords .Commands}}" -- "${cur}") )
+        return 0
+    fi
+
+    cmd="${COMP_WORDS[1]}"
+    case "${cmd}" in
+{{- range .Commands}}
+        {{names .}}) flags="{{flagWords .Flags}}"; values="{{valueFlagWords .Flags}}"; flagresources="{{flagResourceWords .Flags}}"; args="{{argumentWords .Arguments}}";;
+{{- end}}
+        *) flags=""; values=""; flagresources=""; args="";;
+    esac
+
+    resource=""
+    for pair in ${flagresources}; do
+        if [[ "${prev}" == "${pair%%:*}" ]]; then
+            resource="${pair#*:}"
+        fi
+    done
+
+    if [[ -z "${resource}" ]]; then
+        if [[ " ${values} " == *" ${prev} "* ]]; then
+            COMPREPLY=( $(compgen -f -- "${cur}") )
+            return 0
+        fi
+
+        if [[ "${cur}" == -* ]]; then
+            COMPREPLY=( $(compgen -W "${flags}" -- "${cur}") )
+            return 0
+        fi
+
+        local i word position=0 skip=0
+        for (( i=2; i<COMP_CWORD; i++ )); do
+            word="${COMP_WORDS[i]}"
+            if [[ ${skip} -eq 1 ]]; then
+                skip=0
+                continue
+            fi
+            if [[ "${word}" == -* ]]; then
+                if [[ " ${values} " == *" ${word} "* ]]; then
+                    skip=1
+                fi
+                continue
+            fi
+            position=$((position+1))
+        done
+
+        local positional=( ${args} )
+        resource="${positional[position]}"
+    fi
+
+    if [[ -n "${resource}" && "${resource}" != "-" ]]; then
+        local IFS=$'\n'
+        COMPREPLY=( $(compgen -W "$({{.BinaryName}} {{.CompleteCommand}} "${resource}" 2>/dev/null)" -- "${cur}") )
+    else
+        COMPREPLY=( $(compgen -f -- "${cur}") )
+    fi
+}
+
+complete -F {{.FunctionName}}_complete {{.BinaryName}}
+`
+
+const zshCompletionScript = `# zsh completion for {{.BinaryName}}
+#
+# Load it in the current shell with:
+#   source <({{.BinaryName}} completion zsh)
+
+autoload -U +X compinit && compinit
+autoload -U +X bashcompinit && bashcompinit
+
+` + bashCompletionScript
+
+const fishCompletionScript = `# fish completion for {{.BinaryName}}
+#
+# Load it in the current shell with:
+#   {{.BinaryName}} completion fish | source
+
+function {{.FunctionName}}_positional_resource
+    set -l tokens (commandline -opc)
+    set -l position 0
+    for token in $tokens[3..-1]
+        if not string match -q -- '-*' $token
+            set position (math $position + 1)
+        end
+    end
+    set -l resource $argv[(math $position + 1)]
+    if test -n "$resource"; and test "$resource" != "-"
+        {{.BinaryName}} {{.CompleteCommand}} $resource 2>/dev/null
+    end
+end
+
+complete -c {{.BinaryName}} -n '__fish_use_subcommand' -f
+{{- range .Commands}}
+complete -c {{$.BinaryName}} -n '__fish_use_subcommand' -f -a {{fishQuote .Name}} -d {{fishQuote .Description}}
+{{- $command := .}}
+{{- range .Flags}}
+complete -c {{$.BinaryName}} -n '__fish_seen_subcommand_from {{$command.Name}}{{if $command.Alias}} {{$command.Alias}}{{end}}'{{if .Short}} -s {{.Short}}{{end}}{{if .Long}} -l {{.Long}}{{end}}{{if .Resource}} -x -a '({{$.BinaryName}} {{$.CompleteCommand}} {{.Resource}} 2>/dev/null)'{{else if .TakesValue}} -r{{end}} -d {{fishQuote .Description}}
+{{- end}}
+{{- if hasResourceArguments .Arguments}}
+complete -c {{$.BinaryName}} -n '__fish_seen_subcommand_from {{.Name}}{{if .Alias}} {{.Alias}}{{end}}' -f -a '({{$.FunctionName}}_positional_resource {{argumentWords .Arguments}})'
+{{- end}}
+{{- end}}
+`
+
+var (
+	bashCompletionTemplate = template.Must(template.New("bash").Funcs(completionTemplateFuncs).Parse(bashCompletionScript))
+	zshCompletionTemplate  = template.Must(template.New("zsh").Funcs(completionTemplateFuncs).Parse(zshCompletionScript))
+	fishCompletionTemplate = template.Must(template.New("fish").Funcs(completionTemplateFuncs).Parse(fishCompletionScript))
+)
@@ -13,12 +13,12 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"apps", "app"},
 			{"push", "scale", "delete", "rename"},
-			{"start", "stop", "restart", "restage", "restart-app-instance"},
+			{"start", "stop", "restart", "restage", "restage-fleet", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "create-space-manifest", "validate-manifest"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -27,10 +27,11 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"marketplace", "services", "service"},
 			{"create-service", "update-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
+			{"bind-service", "unbind-service", "binding"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
+			{"move-service", "export-user-provided-services", "import-user-provided-services"},
 		},
 	},
 	{
@@ -79,6 +80,7 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"user-roles", "roles", "apply-roles"},
 		},
 	},
 	{
@@ -87,6 +89,7 @@ var HelpCategoryList = []HelpCategory{
 			{"quotas", "quota", "set-quota"},
 			{"create-quota", "delete-quota", "update-quota"},
 			{"share-private-domain", "unshare-private-domain"},
+			{"bootstrap"},
 		},
 	},
 	{
@@ -136,12 +139,14 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
 			{"curl", "config", "oauth-token", "ssh-code"},
+			{"alias", "completion"},
 		},
 	},
 	{
 		CategoryName: "ADD/REMOVE PLUGIN REPOSITORY:",
 		CommandList: [][]string{
 			{"add-plugin-repo", "remove-plugin-repo", "list-plugin-repos", "repo-plugins"},
+			{"plugin-repo-serve", "plugin-repo-mirror"},
 		},
 	},
 	{
@@ -159,10 +164,11 @@ var ExperimentalHelpCategoryList = []HelpCategory{
 			{"v3-apps", "v3-app", "v3-create-app"},
 			{"v3-push", "v3-scale", "v3-delete"},
 			{"v3-start", "v3-stop", "v3-restart", "v3-stage", "v3-restart-app-instance"},
-			{"v3-droplets", "v3-set-droplet"},
+			{"build-info"},
+			{"v3-droplets", "v3-set-droplet", "download-droplet", "promote", "rollback"},
 			{"v3-set-env", "v3-unset-env"},
 			{"v3-get-health-check", "v3-set-health-check"},
-			{"v3-packages", "v3-create-package"},
+			{"v3-packages", "v3-create-package", "download-package", "cleanup-app"},
 		},
 	},
 	{
@@ -0,0 +1,154 @@
+package common
+
+import (
+	"reflect"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/spellcheck"
+)
+
+//go:generate counterfeiter . NotFoundSuggestionsActor
+
+type NotFoundSuggestionsActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+// AddNotFoundSuggestions returns err with the names of similarly spelled
+// resources when it reports that an app or service instance in the targeted
+// space, a space in the targeted org, or an org was not found. Because the
+// not found errors do not say where the lookup was made, err is returned
+// unchanged when cmd was given an org or space flag, since the lookup may
+// then have been made outside the targeted org and space. newActor is only
+// called when err is one of those errors. Any other error, or one for which
+// no similar names are found, is returned unchanged.
+func AddNotFoundSuggestions(err error, cmd interface{}, config command.Config, newActor func() (NotFoundSuggestionsActor, error)) error {
+	if err == nil || config.Target() == "" || config.AccessToken() == "" || overridesTarget(cmd) {
+		return err
+	}
+
+	var (
+		name      string
+		scopeGUID string
+	)
+	switch e := err.(type) {
+	case actionerror.ApplicationNotFoundError:
+		name, scopeGUID = e.Name, config.TargetedSpace().GUID
+	case actionerror.ServiceInstanceNotFoundError:
+		name, scopeGUID = e.Name, config.TargetedSpace().GUID
+	case actionerror.SpaceNotFoundError:
+		name, scopeGUID = e.Name, config.TargetedOrganization().GUID
+	case actionerror.OrganizationNotFoundError:
+		name, scopeGUID = e.Name, "-"
+	default:
+		return err
+	}
+	if name == "" || scopeGUID == "" {
+		return err
+	}
+
+	actor, actorErr := newActor()
+	if actorErr != nil {
+		return err
+	}
+
+	names, listErr := listNames(actor, err, scopeGUID)
+	if listErr != nil {
+		return err
+	}
+
+	suggestions := spellcheck.NewNameSuggester(names).Recommend(name)
+	if len(suggestions) == 0 {
+		return err
+	}
+
+	switch e := err.(type) {
+	case actionerror.ApplicationNotFoundError:
+		return translatableerror.ApplicationNotFoundError{Name: e.Name, Suggestions: suggestions}
+	case actionerror.ServiceInstanceNotFoundError:
+		return translatableerror.ServiceInstanceNotFoundError{Name: e.Name, Suggestions: suggestions}
+	case actionerror.SpaceNotFoundError:
+		return translatableerror.SpaceNotFoundError{Name: e.Name, Suggestions: suggestions}
+	default:
+		return translatableerror.OrganizationNotFoundError{Name: name, Suggestions: suggestions}
+	}
+}
+
+func listNames(actor NotFoundSuggestionsActor, err error, scopeGUID string) ([]string, error) {
+	var names []string
+	switch err.(type) {
+	case actionerror.ApplicationNotFoundError:
+		apps, _, listErr := actor.GetApplicationsBySpace(scopeGUID)
+		if listErr != nil {
+			return nil, listErr
+		}
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+	case actionerror.ServiceInstanceNotFoundError:
+		instances, _, listErr := actor.GetServiceInstancesBySpace(scopeGUID)
+		if listErr != nil {
+			return nil, listErr
+		}
+		for _, instance := range instances {
+			names = append(names, instance.Name)
+		}
+	case actionerror.SpaceNotFoundError:
+		spaces, _, listErr := actor.GetOrganizationSpaces(scopeGUID)
+		if listErr != nil {
+			return nil, listErr
+		}
+		for _, space := range spaces {
+			names = append(names, space.Name)
+		}
+	case actionerror.OrganizationNotFoundError:
+		orgs, _, listErr := actor.GetOrganizations()
+		if listErr != nil {
+			return nil, listErr
+		}
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+	}
+	return names, nil
+}
+
+// overridesTarget returns true when one of cmd's org or space flags is set.
+func overridesTarget(cmd interface{}) bool {
+	value := reflect.Indirect(reflect.ValueOf(cmd))
+	if value.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !isTargetFlag(field) {
+			continue
+		}
+		if !reflect.DeepEqual(value.Field(i).Interface(), reflect.Zero(field.Type).Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTargetFlag returns true for the -o and -s flags and the flags that name a
+// destination space or login. Flags that reuse -o or -s for something else,
+// such as the stack of push, are treated the same way, which only costs the
+// suggestions.
+func isTargetFlag(field reflect.StructField) bool {
+	switch field.Tag.Get("short") {
+	case "o", "s":
+		return true
+	}
+	switch field.Tag.Get("long") {
+	case "to-space", "to-target":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package common_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddNotFoundSuggestions", func() {
+	var (
+		fakeConfig     *commandfakes.FakeConfig
+		fakeActor      *commonfakes.FakeNotFoundSuggestionsActor
+		cmd            interface{}
+		newActorCalled bool
+		inputErr       error
+		returnedErr    error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.AccessTokenReturns("some-access-token")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+
+		fakeActor = new(commonfakes.FakeNotFoundSuggestionsActor)
+		fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{{Name: "my-app-v2"}, {Name: "other-app"}}, nil, nil)
+		fakeActor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{{Name: "my-db"}}, nil, nil)
+		fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{Name: "staging"}}, nil, nil)
+		fakeActor.GetOrganizationsReturns([]v2action.Organization{{Name: "my-org"}}, nil, nil)
+		cmd = v2.AppCommand{}
+		newActorCalled = false
+	})
+
+	JustBeforeEach(func() {
+		returnedErr = AddNotFoundSuggestions(inputErr, cmd, fakeConfig, func() (NotFoundSuggestionsActor, error) {
+			newActorCalled = true
+			return fakeActor, nil
+		})
+	})
+
+	Context("when an app is not found", func() {
+		BeforeEach(func() {
+			inputErr = actionerror.ApplicationNotFoundError{Name: "my-ap-v2"}
+		})
+
+		It("suggests apps in the targeted space", func() {
+			Expect(returnedErr).To(Equal(translatableerror.ApplicationNotFoundError{Name: "my-ap-v2", Suggestions: []string{"my-app-v2"}}))
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+
+		Context("when the command was given a space", func() {
+			BeforeEach(func() {
+				cmd = v2.CopySourceCommand{Space: "other-space"}
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+				Expect(newActorCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the command was given a destination space", func() {
+			BeforeEach(func() {
+				cmd = &v3.PromoteCommand{ToSpace: "production"}
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+				Expect(newActorCalled).To(BeFalse())
+			})
+		})
+
+		Context("when no space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{})
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+				Expect(newActorCalled).To(BeFalse())
+			})
+		})
+
+		Context("when listing the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(nil, nil, errors.New("some-error"))
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+			})
+		})
+
+		Context("when no app is spelled similarly", func() {
+			BeforeEach(func() {
+				inputErr = actionerror.ApplicationNotFoundError{Name: "completely-different"}
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+			})
+		})
+
+		Context("when the app was looked up by GUID", func() {
+			BeforeEach(func() {
+				inputErr = actionerror.ApplicationNotFoundError{GUID: "some-app-guid"}
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+				Expect(newActorCalled).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when a service instance is not found", func() {
+		BeforeEach(func() {
+			inputErr = actionerror.ServiceInstanceNotFoundError{Name: "my-dv"}
+		})
+
+		It("suggests service instances in the targeted space", func() {
+			Expect(returnedErr).To(Equal(translatableerror.ServiceInstanceNotFoundError{Name: "my-dv", Suggestions: []string{"my-db"}}))
+			Expect(fakeActor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when a space is not found", func() {
+		BeforeEach(func() {
+			inputErr = actionerror.SpaceNotFoundError{Name: "stagign"}
+		})
+
+		It("suggests spaces in the targeted org", func() {
+			Expect(returnedErr).To(Equal(translatableerror.SpaceNotFoundError{Name: "stagign", Suggestions: []string{"staging"}}))
+			Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+
+		Context("when the command was given an org", func() {
+			BeforeEach(func() {
+				cmd = v2.TargetCommand{Organization: "other-org", Space: "stagign"}
+			})
+
+			It("returns the error unchanged", func() {
+				Expect(returnedErr).To(Equal(inputErr))
+				Expect(newActorCalled).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when an org is not found", func() {
+		BeforeEach(func() {
+			inputErr = actionerror.OrganizationNotFoundError{Name: "my-orgg"}
+		})
+
+		It("suggests orgs", func() {
+			Expect(returnedErr).To(Equal(translatableerror.OrganizationNotFoundError{Name: "my-orgg", Suggestions: []string{"my-org"}}))
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("")
+			inputErr = actionerror.OrganizationNotFoundError{Name: "my-orgg"}
+		})
+
+		It("returns the error unchanged", func() {
+			Expect(returnedErr).To(Equal(inputErr))
+			Expect(newActorCalled).To(BeFalse())
+		})
+	})
+
+	Context("when the error is not a not found error", func() {
+		BeforeEach(func() {
+			inputErr = errors.New("some-error")
+		})
+
+		It("returns the error unchanged", func() {
+			Expect(returnedErr).To(Equal(inputErr))
+			Expect(newActorCalled).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+)
+
+// ExecuteWithPluginHooks executes cmd, running the pre and post plugin hooks
+// subscribed to commandName around it. A pre hook veto stops cmd from being
+// executed, and post hooks only run when cmd succeeds. Commands that are
+// handed off to the legacy code base exit from it, so their post hooks are
+// run by the legacy code base once the command succeeds.
+func ExecuteWithPluginHooks(cmd command.ExtendedCommander, args []string, commandName string, config command.Config, hookRunner command.PluginHookRunner) error {
+	events, hasHooks := shared.CommandHooks[commandName]
+	if !hasHooks {
+		return cmd.Execute(args)
+	}
+
+	err := hookRunner.Run(shared.NewHookEvent(events.Pre, config))
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Execute(args)
+	if err != nil {
+		return err
+	}
+
+	return hookRunner.Run(shared.NewHookEvent(events.Post, config))
+}
@@ -0,0 +1,108 @@
+package common_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type hookedCommand struct {
+	executed *bool
+	err      error
+}
+
+func (cmd hookedCommand) Setup(command.Config, command.UI) error {
+	return nil
+}
+
+func (cmd hookedCommand) Execute(args []string) error {
+	*cmd.executed = true
+	return cmd.err
+}
+
+var _ = Describe("ExecuteWithPluginHooks", func() {
+	var (
+		cmd            hookedCommand
+		executed       bool
+		commandName    string
+		fakeConfig     *commandfakes.FakeConfig
+		fakeHookRunner *commandfakes.FakePluginHookRunner
+		executeErr     error
+	)
+
+	BeforeEach(func() {
+		executed = false
+		cmd = hookedCommand{executed: &executed}
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeHookRunner = new(commandfakes.FakePluginHookRunner)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = ExecuteWithPluginHooks(cmd, nil, commandName, fakeConfig, fakeHookRunner)
+	})
+
+	Context("when the command has no hooks", func() {
+		BeforeEach(func() {
+			commandName = "apps"
+		})
+
+		It("only executes the command", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(executed).To(BeTrue())
+			Expect(fakeHookRunner.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the command has hooks", func() {
+		BeforeEach(func() {
+			commandName = "target"
+		})
+
+		It("runs the pre and post hooks around the command", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(executed).To(BeTrue())
+
+			Expect(fakeHookRunner.RunCallCount()).To(Equal(2))
+			preEvent := fakeHookRunner.RunArgsForCall(0)
+			Expect(preEvent.Name).To(Equal(plugin.PreTargetHook))
+			Expect(preEvent.Target.Organization).To(Equal("some-org"))
+			Expect(fakeHookRunner.RunArgsForCall(1).Name).To(Equal(plugin.PostTargetHook))
+		})
+
+		Context("when a pre hook vetoes the command", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("vetoed")
+				fakeHookRunner.RunReturnsOnCall(0, expectedErr)
+			})
+
+			It("does not execute the command", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(executed).To(BeFalse())
+				Expect(fakeHookRunner.RunCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the command fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("command failed")
+				cmd.err = expectedErr
+			})
+
+			It("does not run the post hooks", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeHookRunner.RunCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
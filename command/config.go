@@ -35,6 +35,7 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RemoveUserAlias(name string)
 	RequestRetryCount() int
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
@@ -45,6 +46,7 @@ type Config interface {
 	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
 	SetUAAGrantType(uaaGrantType string)
+	SetUserAlias(name string, steps []string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
@@ -59,6 +61,7 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UserAliases() []configv3.UserAlias
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 }
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type AliasAction struct {
+	Action string
+}
+
+func (AliasAction) Complete(prefix string) []flags.Completion {
+	return completions([]string{"delete", "list", "set"}, prefix, false)
+}
+
+func (a *AliasAction) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "set", "delete", "list":
+		a.Action = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ACTION must be "set", "delete", or "list"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AliasAction", func() {
+	var action AliasAction
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := action.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'set' when passed 's'", "s",
+				[]flags.Completion{{Item: "set"}}),
+			Entry("completes to 'delete' when passed 'De'", "De",
+				[]flags.Completion{{Item: "delete"}}),
+			Entry("completes to all actions when passed nothing", "",
+				[]flags.Completion{{Item: "delete"}, {Item: "list"}, {Item: "set"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			action = AliasAction{}
+		})
+
+		DescribeTable("downcases and sets action",
+			func(input string, expected string) {
+				err := action.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(action.Action).To(Equal(expected))
+			},
+			Entry("sets 'set' when passed 'SET'", "SET", "set"),
+			Entry("sets 'delete' when passed 'delete'", "delete", "delete"),
+			Entry("sets 'list' when passed 'List'", "List", "list"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := action.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `ACTION must be "set", "delete", or "list"`,
+				}))
+				Expect(action.Action).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -172,6 +172,22 @@ type CreateServiceArgs struct {
 	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
 }
 
+type ExportUserProvidedServicesArgs struct {
+	ServiceInstances []string `positional-arg-name:"SERVICE_INSTANCE" description:"The user-provided service instances to export"`
+}
+
+type ImportUserProvidedServicesArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The file written by export-user-provided-services"`
+}
+
+type BootstrapArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The org template file"`
+}
+
+type ApplyRolesArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The roles file"`
+}
+
 type RenameServiceArgs struct {
 	ServiceInstance        string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance to rename"`
 	NewServiceInstanceName string `positional-arg-name:"NEW_SERVICE_INSTANCE" required:"true" description:"The new name of the service instance"`
@@ -282,6 +298,29 @@ type AddPluginRepoArgs struct {
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
 }
 
+type CompletionShellArg struct {
+	Shell CompletionShell `positional-arg-name:"SHELL" required:"true" description:"The shell to generate the completion script for: bash, fish or zsh"`
+}
+
+type CompleteResourceArg struct {
+	Resource string `positional-arg-name:"RESOURCE" required:"true" description:"The kind of resource to list: app, service, org or space"`
+}
+
+type AliasArgs struct {
+	Action AliasAction `positional-arg-name:"ACTION" required:"true" description:"set, delete or list"`
+	Name   string      `positional-arg-name:"NAME" description:"The alias name"`
+	Steps  []string    `positional-arg-name:"COMMAND" description:"The command lines the alias runs, in order"`
+}
+
+type PluginRepoServeArgs struct {
+	Directory PathWithExistenceCheck `positional-arg-name:"DIR" required:"true" description:"The directory containing the plugin binaries"`
+}
+
+type PluginRepoMirrorArgs struct {
+	PluginRepo string `positional-arg-name:"REPO_NAME" required:"true" description:"The name or URL of the plugin repo to mirror"`
+	Directory  Path   `positional-arg-name:"DIR" required:"true" description:"The directory to write the mirror to"`
+}
+
 type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type CompletionShell struct {
+	Shell string
+}
+
+func (CompletionShell) Complete(prefix string) []flags.Completion {
+	return completions([]string{"bash", "fish", "zsh"}, prefix, false)
+}
+
+func (s *CompletionShell) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "bash", "fish", "zsh":
+		s.Shell = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SHELL must be "bash", "fish", or "zsh"`,
+		}
+	}
+	return nil
+}
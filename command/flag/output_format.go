@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// OutputFormat is the format a command displays its results in. An empty
+// Format is the default human readable table, which can also be requested
+// explicitly with "table". Commands reject the formats they do not support.
+type OutputFormat struct {
+	Format string
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"csv", "json", "table"}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "csv", "json":
+		o.Format = valLower
+	case "table":
+		o.Format = ""
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT_FORMAT must be "csv", "json" or "table"`,
+		}
+	}
+	return nil
+}
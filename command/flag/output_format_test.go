@@ -0,0 +1,54 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	BeforeEach(func() {
+		outputFormat = OutputFormat{}
+	})
+
+	Describe("Complete", func() {
+		It("completes to the supported formats", func() {
+			Expect(outputFormat.Complete("J")).To(Equal([]flags.Completion{{Item: "json"}}))
+			Expect(outputFormat.Complete("c")).To(Equal([]flags.Completion{{Item: "csv"}}))
+			Expect(outputFormat.Complete("T")).To(Equal([]flags.Completion{{Item: "table"}}))
+			Expect(outputFormat.Complete("x")).To(BeEmpty())
+		})
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("downcases and sets the format", func() {
+			Expect(outputFormat.UnmarshalFlag("JSON")).To(Succeed())
+			Expect(outputFormat.Format).To(Equal("json"))
+
+			Expect(outputFormat.UnmarshalFlag("Csv")).To(Succeed())
+			Expect(outputFormat.Format).To(Equal("csv"))
+		})
+
+		Context("when passed 'table'", func() {
+			It("sets the default format", func() {
+				outputFormat.Format = "json"
+				Expect(outputFormat.UnmarshalFlag("table")).To(Succeed())
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := outputFormat.UnmarshalFlag("yaml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `OUTPUT_FORMAT must be "csv", "json" or "table"`,
+				}))
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
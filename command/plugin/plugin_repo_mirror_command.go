@@ -0,0 +1,72 @@
+package plugin
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . PluginRepoMirrorActor
+
+type PluginRepoMirrorActor interface {
+	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	MirrorPluginRepository(repositoryURL string, dir string, platforms []string, proxyReader plugin.ProxyReader) (plugin.PluginRepository, error)
+}
+
+type PluginRepoMirrorCommand struct {
+	RequiredArgs      flag.PluginRepoMirrorArgs `positional-args:"yes"`
+	Platforms         []string                  `long:"platform" description:"Only mirror binaries for this platform (linux32, linux64, osx, win32, win64). Can be specified multiple times."`
+	SkipSSLValidation bool                      `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	usage             interface{}               `usage:"CF_NAME plugin-repo-mirror REPO_NAME|URL DIR [--platform PLATFORM]...\n\nEXAMPLES:\n   CF_NAME plugin-repo-mirror CF-Community ~/plugin-mirror\n   CF_NAME plugin-repo-mirror https://plugins.example.com ~/plugin-mirror --platform linux64 --platform win64\n\nTIP:\n   Copy DIR to the isolated network and run 'CF_NAME plugin-repo-serve DIR' there to serve the mirrored plugins."`
+	relatedCommands   interface{}               `related_commands:"add-plugin-repo, plugin-repo-serve, repo-plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             PluginRepoMirrorActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *PluginRepoMirrorCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+	return nil
+}
+
+func (cmd PluginRepoMirrorCommand) Execute([]string) error {
+	repositoryURL := cmd.RequiredArgs.PluginRepo
+	repository, err := cmd.Actor.GetPluginRepository(cmd.RequiredArgs.PluginRepo)
+	switch err.(type) {
+	case nil:
+		repositoryURL = repository.URL
+	case actionerror.RepositoryNotRegisteredError:
+		if !strings.Contains(repositoryURL, ".") && !strings.Contains(repositoryURL, "://") {
+			return err
+		}
+	default:
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Mirroring plugin repository {{.RepositoryURL}} to {{.Directory}}...", map[string]interface{}{
+		"RepositoryURL": repositoryURL,
+		"Directory":     cmd.RequiredArgs.Directory.String(),
+	})
+
+	mirrored, err := cmd.Actor.MirrorPluginRepository(repositoryURL, cmd.RequiredArgs.Directory.String(), cmd.Platforms, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	displayRepositoryPlugins(cmd.UI, mirrored)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("plugin-repo-mirror command", func() {
+	var (
+		cmd        PluginRepoMirrorCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakePluginRepoMirrorActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakePluginRepoMirrorActor)
+		cmd = PluginRepoMirrorCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.Directory = "some-dir"
+		cmd.Platforms = []string{"linux64"}
+
+		fakeActor.MirrorPluginRepositoryReturns(plugin.PluginRepository{
+			Plugins: []plugin.Plugin{
+				{
+					Name:     "some-plugin",
+					Version:  "1.2.3",
+					Binaries: []plugin.PluginBinary{{Platform: "linux64"}},
+				},
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the repository is registered", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PluginRepo = "some-repo"
+			fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "some-repo", URL: "https://some-repo.example.com"}, nil)
+		})
+
+		It("mirrors the registered repository's URL", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("some-repo"))
+			Expect(fakeActor.MirrorPluginRepositoryCallCount()).To(Equal(1))
+			repositoryURL, dir, platforms, _ := fakeActor.MirrorPluginRepositoryArgsForCall(0)
+			Expect(repositoryURL).To(Equal("https://some-repo.example.com"))
+			Expect(dir).To(Equal("some-dir"))
+			Expect(platforms).To(Equal([]string{"linux64"}))
+
+			Expect(testUI.Out).To(Say("Mirroring plugin repository https://some-repo.example.com to some-dir..."))
+			Expect(testUI.Out).To(Say(`some-plugin\s+1\.2\.3\s+linux64`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the argument is an unregistered URL", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PluginRepo = "some-repo.example.com"
+			fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, actionerror.RepositoryNotRegisteredError{Name: "some-repo.example.com"})
+		})
+
+		It("mirrors the URL", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			repositoryURL, _, _, _ := fakeActor.MirrorPluginRepositoryArgsForCall(0)
+			Expect(repositoryURL).To(Equal("some-repo.example.com"))
+		})
+	})
+
+	Context("when the argument is an unregistered name", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PluginRepo = "some-repo"
+			fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, actionerror.RepositoryNotRegisteredError{Name: "some-repo"})
+		})
+
+		It("returns the RepositoryNotRegisteredError", func() {
+			Expect(executeErr).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "some-repo"}))
+			Expect(fakeActor.MirrorPluginRepositoryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when mirroring fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PluginRepo = "some-repo"
+			fakeActor.MirrorPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . PluginRepoServeActor
+
+type PluginRepoServeActor interface {
+	IndexPluginRepositoryDirectory(pluginMetadata pluginaction.PluginMetadata, dir string, tempPluginDir string) (plugin.PluginRepository, []string, error)
+}
+
+type PluginRepoServeCommand struct {
+	RequiredArgs    flag.PluginRepoServeArgs `positional-args:"yes"`
+	Port            int                      `long:"port" default:"8080" description:"Port to serve the repository on"`
+	usage           interface{}              `usage:"CF_NAME plugin-repo-serve DIR [--port PORT]\n\nEXAMPLES:\n   CF_NAME plugin-repo-serve ~/plugins\n   CF_NAME plugin-repo-serve ~/plugins --port 9090\n\nTIP:\n   Plugins are identified by running each binary built for this platform. Binaries for other platforms are matched to a plugin by file name, ignoring platform suffixes such as '_linux64' or '-win64.exe'.\n\n   Use 'CF_NAME add-plugin-repo' with the printed URL to install plugins from the repository."`
+	relatedCommands interface{}              `related_commands:"add-plugin-repo, plugin-repo-mirror, repo-plugins"`
+	UI              command.UI
+	Config          command.Config
+	Actor           PluginRepoServeActor
+	PluginMetadata  pluginaction.PluginMetadata
+	ListenAndServe  func(addr string, handler http.Handler) error
+}
+
+func (cmd *PluginRepoServeCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	cmd.ListenAndServe = http.ListenAndServe
+
+	rpcService, err := shared.NewRPCService(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.PluginMetadata = rpcService
+
+	return nil
+}
+
+func (cmd PluginRepoServeCommand) Execute([]string) error {
+	dir := string(cmd.RequiredArgs.Directory)
+	cmd.UI.DisplayTextWithFlavor("Indexing plugins in {{.Directory}}...", map[string]interface{}{
+		"Directory": dir,
+	})
+
+	err := os.MkdirAll(cmd.Config.PluginHome(), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	repository, warnings, err := cmd.Actor.IndexPluginRepositoryDirectory(cmd.PluginMetadata, dir, tempPluginDir)
+	os.RemoveAll(tempPluginDir)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	displayRepositoryPlugins(cmd.UI, repository)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Serving plugin repository on port {{.Port}}. Press Ctrl-C to stop.", map[string]interface{}{
+		"Port": cmd.Port,
+	})
+	cmd.UI.DisplayText("Repository URL: http://localhost:{{.Port}}", map[string]interface{}{
+		"Port": cmd.Port,
+	})
+
+	return cmd.ListenAndServe(fmt.Sprintf(":%d", cmd.Port), plugin.NewRepositoryHandler(repository, dir))
+}
+
+func displayRepositoryPlugins(commandUI command.UI, repository plugin.PluginRepository) {
+	if len(repository.Plugins) == 0 {
+		commandUI.DisplayText("No plugins found.")
+		return
+	}
+
+	table := [][]string{{"plugin", "version", "platforms"}}
+	for _, repositoryPlugin := range repository.Plugins {
+		var platforms []string
+		for _, binary := range repositoryPlugin.Binaries {
+			platforms = append(platforms, binary.Platform)
+		}
+		sort.Strings(platforms)
+		table = append(table, []string{repositoryPlugin.Name, repositoryPlugin.Version, strings.Join(platforms, ", ")})
+	}
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
@@ -0,0 +1,141 @@
+package plugin_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("plugin-repo-serve command", func() {
+	var (
+		cmd                PluginRepoServeCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeActor          *pluginfakes.FakePluginRepoServeActor
+		fakePluginMetadata *pluginactionfakes.FakePluginMetadata
+		pluginHome         string
+		servedAddr         string
+		servedHandler      http.Handler
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakePluginRepoServeActor)
+		fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "plugin-home")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.PluginHomeReturns(pluginHome)
+
+		servedAddr = ""
+		servedHandler = nil
+		cmd = PluginRepoServeCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			Actor:          fakeActor,
+			PluginMetadata: fakePluginMetadata,
+			Port:           8080,
+			ListenAndServe: func(addr string, handler http.Handler) error {
+				servedAddr = addr
+				servedHandler = handler
+				return nil
+			},
+		}
+		cmd.RequiredArgs.Directory = "some-dir"
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pluginHome)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when indexing the directory succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.IndexPluginRepositoryDirectoryReturns(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{
+					{
+						Name:    "some-plugin",
+						Version: "1.2.3",
+						Binaries: []plugin.PluginBinary{
+							{Platform: "win64", URL: "some-plugin.exe"},
+							{Platform: "linux64", URL: "some-plugin"},
+						},
+					},
+				},
+			}, []string{"some-warning"}, nil)
+			cmd.Port = 9090
+		})
+
+		It("indexes the directory with the plugin metadata service", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.IndexPluginRepositoryDirectoryCallCount()).To(Equal(1))
+			pluginMetadata, dir, tempPluginDir := fakeActor.IndexPluginRepositoryDirectoryArgsForCall(0)
+			Expect(pluginMetadata).To(Equal(fakePluginMetadata))
+			Expect(dir).To(Equal("some-dir"))
+			Expect(tempPluginDir).To(HavePrefix(pluginHome))
+			Expect(tempPluginDir).ToNot(BeAnExistingFile())
+		})
+
+		It("displays the indexed plugins and warnings, then serves the repository", func() {
+			Expect(testUI.Out).To(Say("Indexing plugins in some-dir..."))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+platforms`))
+			Expect(testUI.Out).To(Say(`some-plugin\s+1\.2\.3\s+linux64, win64`))
+			Expect(testUI.Out).To(Say("Serving plugin repository on port 9090. Press Ctrl-C to stop."))
+			Expect(testUI.Out).To(Say("Repository URL: http://localhost:9090"))
+
+			Expect(servedAddr).To(Equal(":9090"))
+			Expect(servedHandler).To(BeAssignableToTypeOf(&plugin.RepositoryHandler{}))
+		})
+	})
+
+	Context("when the directory contains no plugins", func() {
+		It("says so and still serves the repository", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No plugins found."))
+			Expect(servedAddr).To(Equal(":8080"))
+		})
+	})
+
+	Context("when indexing the directory fails", func() {
+		BeforeEach(func() {
+			fakeActor.IndexPluginRepositoryDirectoryReturns(plugin.PluginRepository{}, []string{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("displays the warnings and returns the error without serving", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(servedHandler).To(BeNil())
+		})
+	})
+
+	Context("when serving fails", func() {
+		BeforeEach(func() {
+			cmd.ListenAndServe = func(string, http.Handler) error {
+				return errors.New("address in use")
+			}
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("address in use"))
+		})
+	})
+})
@@ -0,0 +1,17 @@
+package shared
+
+import "code.cloudfoundry.org/cli/plugin"
+
+// CommandHookEvents are the lifecycle events run before and after a command.
+type CommandHookEvents struct {
+	Pre  string
+	Post string
+}
+
+// CommandHooks maps command names to the lifecycle events run around them.
+// push and v3-push are not listed as they run their own hooks, which describe
+// the applications being pushed.
+var CommandHooks = map[string]CommandHookEvents{
+	"login":  {Pre: plugin.PreLoginHook, Post: plugin.PostLoginHook},
+	"target": {Pre: plugin.PreTargetHook, Post: plugin.PostTargetHook},
+}
@@ -0,0 +1,101 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . HookConfig
+
+type HookConfig interface {
+	Config
+	Plugins() []configv3.Plugin
+}
+
+//go:generate counterfeiter . HookRPCService
+
+type HookRPCService interface {
+	RunHook(path string, event plugin.HookEvent) (plugin.HookResult, error)
+}
+
+// HookRunner runs the plugin hooks subscribed to a lifecycle event.
+type HookRunner struct {
+	Config HookConfig
+	UI     command.UI
+
+	// NewRPCService is used to start a fresh RPC service for every plugin the
+	// event is sent to.
+	NewRPCService func() (HookRPCService, error)
+}
+
+func NewHookRunner(config command.Config, ui command.UI) *HookRunner {
+	return &HookRunner{
+		Config: config,
+		UI:     ui,
+		NewRPCService: func() (HookRPCService, error) {
+			return NewRPCService(config, ui)
+		},
+	}
+}
+
+// Run sends the event to every installed plugin subscribed to it, in plugin
+// name order. For "pre-" events the first plugin that vetoes or fails to run
+// stops the operation and an error is returned; for all other events failures
+// are displayed as warnings.
+func (runner HookRunner) Run(event plugin.HookEvent) error {
+	canVeto := strings.HasPrefix(event.Name, "pre-")
+
+	for _, installedPlugin := range runner.Config.Plugins() {
+		if !installedPlugin.SubscribesTo(event.Name) {
+			continue
+		}
+
+		result, err := runner.runHook(installedPlugin, event)
+		switch {
+		case err != nil && canVeto:
+			return translatableerror.PluginHookFailedError{PluginName: installedPlugin.Name, Event: event.Name, Err: err}
+		case err != nil:
+			runner.UI.DisplayWarning("Plugin {{.PluginName}} failed to run its {{.Event}} hook: {{.Error}}", map[string]interface{}{
+				"PluginName": installedPlugin.Name,
+				"Event":      event.Name,
+				"Error":      err,
+			})
+		case result.Veto && canVeto:
+			return translatableerror.PluginHookVetoedError{PluginName: installedPlugin.Name, Event: event.Name, Message: result.Message}
+		}
+	}
+
+	return nil
+}
+
+func (runner HookRunner) runHook(installedPlugin configv3.Plugin, event plugin.HookEvent) (plugin.HookResult, error) {
+	rpcService, err := runner.NewRPCService()
+	if err != nil {
+		return plugin.HookResult{}, err
+	}
+
+	return rpcService.RunHook(installedPlugin.Location, event)
+}
+
+// NewHookEvent returns an event with the given name describing the currently
+// targeted API, org, space and user.
+func NewHookEvent(name string, config command.Config) plugin.HookEvent {
+	event := plugin.HookEvent{
+		Name: name,
+		Target: plugin.HookTarget{
+			API:          config.Target(),
+			Organization: config.TargetedOrganization().Name,
+			Space:        config.TargetedSpace().Name,
+		},
+	}
+
+	if user, err := config.CurrentUser(); err == nil {
+		event.Target.Username = user.Name
+	}
+
+	return event
+}
@@ -0,0 +1,159 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/plugin/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("HookRunner", func() {
+	var (
+		runner         HookRunner
+		testUI         *ui.UI
+		fakeConfig     *sharedfakes.FakeHookConfig
+		fakeRPCService *sharedfakes.FakeHookRPCService
+		event          plugin.HookEvent
+		runErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(sharedfakes.FakeHookConfig)
+		fakeRPCService = new(sharedfakes.FakeHookRPCService)
+		runner = HookRunner{
+			Config: fakeConfig,
+			UI:     testUI,
+			NewRPCService: func() (HookRPCService, error) {
+				return fakeRPCService, nil
+			},
+		}
+
+		fakeConfig.PluginsReturns([]configv3.Plugin{
+			{Name: "audit", Location: "/plugins/audit", Hooks: []string{"post-push"}},
+			{Name: "ticket-check", Location: "/plugins/ticket-check", Hooks: []string{"pre-push", "post-push"}},
+			{Name: "unrelated", Location: "/plugins/unrelated"},
+		})
+	})
+
+	JustBeforeEach(func() {
+		runErr = runner.Run(event)
+	})
+
+	Context("when a pre- event is run", func() {
+		BeforeEach(func() {
+			event = plugin.HookEvent{Name: "pre-push"}
+		})
+
+		It("only runs the plugins subscribed to the event", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(fakeRPCService.RunHookCallCount()).To(Equal(1))
+			path, passedEvent := fakeRPCService.RunHookArgsForCall(0)
+			Expect(path).To(Equal("/plugins/ticket-check"))
+			Expect(passedEvent).To(Equal(event))
+		})
+
+		Context("when the plugin vetoes the event", func() {
+			BeforeEach(func() {
+				fakeRPCService.RunHookReturns(plugin.HookResult{Veto: true, Message: "missing ticket"}, nil)
+			})
+
+			It("returns a PluginHookVetoedError", func() {
+				Expect(runErr).To(MatchError(translatableerror.PluginHookVetoedError{
+					PluginName: "ticket-check",
+					Event:      "pre-push",
+					Message:    "missing ticket",
+				}))
+			})
+		})
+
+		Context("when the plugin cannot be run", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("exit status 1")
+				fakeRPCService.RunHookReturns(plugin.HookResult{}, expectedErr)
+			})
+
+			It("returns a PluginHookFailedError", func() {
+				Expect(runErr).To(MatchError(translatableerror.PluginHookFailedError{
+					PluginName: "ticket-check",
+					Event:      "pre-push",
+					Err:        expectedErr,
+				}))
+			})
+		})
+	})
+
+	Context("when a post- event is run", func() {
+		BeforeEach(func() {
+			event = plugin.HookEvent{Name: "post-push"}
+		})
+
+		It("runs every plugin subscribed to the event", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(fakeRPCService.RunHookCallCount()).To(Equal(2))
+			path, _ := fakeRPCService.RunHookArgsForCall(0)
+			Expect(path).To(Equal("/plugins/audit"))
+			path, _ = fakeRPCService.RunHookArgsForCall(1)
+			Expect(path).To(Equal("/plugins/ticket-check"))
+		})
+
+		Context("when a plugin vetoes the event", func() {
+			BeforeEach(func() {
+				fakeRPCService.RunHookReturns(plugin.HookResult{Veto: true}, nil)
+			})
+
+			It("ignores the veto", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeRPCService.RunHookCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a plugin cannot be run", func() {
+			BeforeEach(func() {
+				fakeRPCService.RunHookReturnsOnCall(0, plugin.HookResult{}, errors.New("exit status 1"))
+			})
+
+			It("displays a warning and runs the remaining plugins", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Plugin audit failed to run its post-push hook: exit status 1"))
+				Expect(fakeRPCService.RunHookCallCount()).To(Equal(2))
+			})
+		})
+	})
+})
+
+var _ = Describe("NewHookEvent", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	It("describes the current target", func() {
+		Expect(NewHookEvent("pre-target", fakeConfig)).To(Equal(plugin.HookEvent{
+			Name: "pre-target",
+			Target: plugin.HookTarget{
+				API:          "https://api.example.com",
+				Organization: "some-org",
+				Space:        "some-space",
+				Username:     "some-user",
+			},
+		}))
+	})
+})
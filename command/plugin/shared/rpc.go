@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/plugin/rpc"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
@@ -64,6 +65,20 @@ func (r RPCService) Run(path string, command string) error {
 	return cmd.Run()
 }
 
+// RunHook runs the plugin at path for the given event and returns the result
+// the plugin reported back.
+func (r RPCService) RunHook(path string, event plugin.HookEvent) (plugin.HookResult, error) {
+	r.rpcService.RpcCmd.HookEvent = &event
+	r.rpcService.RpcCmd.HookResult = &plugin.HookResult{}
+
+	err := r.Run(path, "RunHook")
+	if err != nil {
+		return plugin.HookResult{}, err
+	}
+
+	return *r.rpcService.RpcCmd.HookResult, nil
+}
+
 func (r RPCService) GetMetadata(path string) (configv3.Plugin, error) {
 	err := r.Run(path, "SendMetadata")
 	if err != nil {
@@ -79,6 +94,7 @@ func (r RPCService) GetMetadata(path string) (configv3.Plugin, error) {
 			Build: metadata.Version.Build,
 		},
 		Commands: make([]configv3.PluginCommand, len(metadata.Commands)),
+		Hooks:    metadata.Hooks,
 	}
 
 	for i, command := range metadata.Commands {
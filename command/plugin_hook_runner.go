@@ -0,0 +1,11 @@
+package command
+
+import "code.cloudfoundry.org/cli/plugin"
+
+//go:generate counterfeiter . PluginHookRunner
+
+// PluginHookRunner runs the installed plugins that subscribe to a lifecycle
+// event.
+type PluginHookRunner interface {
+	Run(event plugin.HookEvent) error
+}
@@ -1,8 +1,9 @@
 package translatableerror
 
 type ApplicationNotFoundError struct {
-	GUID string
-	Name string
+	GUID        string
+	Name        string
+	Suggestions []string
 }
 
 func (e ApplicationNotFoundError) Error() string {
@@ -13,8 +14,8 @@ func (e ApplicationNotFoundError) Error() string {
 }
 
 func (e ApplicationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
-	return translate(e.Error(), map[string]interface{}{
+	return translateWithSuggestions(translate, e.Error(), map[string]interface{}{
 		"GUID":    e.GUID,
 		"AppName": e.Name,
-	})
+	}, e.Suggestions)
 }
@@ -0,0 +1,48 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"text/template"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApplicationNotFoundError", func() {
+	Describe("Translate()", func() {
+		var translateFunc func(string, ...interface{}) string
+
+		BeforeEach(func() {
+			translateFunc = func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				err := t.Execute(buffer, subs[0])
+				Expect(err).NotTo(HaveOccurred())
+				return buffer.String()
+			}
+		})
+
+		Context("when there are no suggestions", func() {
+			It("prints the error", func() {
+				err := ApplicationNotFoundError{Name: "my-ap"}
+				Expect(err.Translate(translateFunc)).To(Equal("App my-ap not found"))
+			})
+		})
+
+		Context("when there is one suggestion", func() {
+			It("asks whether the user meant it", func() {
+				err := ApplicationNotFoundError{Name: "my-ap", Suggestions: []string{"my-app"}}
+				Expect(err.Translate(translateFunc)).To(Equal("App my-ap not found\nDid you mean 'my-app'?"))
+			})
+		})
+
+		Context("when there are several suggestions", func() {
+			It("lists them", func() {
+				err := ApplicationNotFoundError{Name: "my-ap", Suggestions: []string{"my-app", "my-apx", "my-ape"}}
+				Expect(err.Translate(translateFunc)).To(Equal("App my-ap not found\nDid you mean 'my-app', 'my-apx' or 'my-ape'?"))
+			})
+		})
+	})
+})
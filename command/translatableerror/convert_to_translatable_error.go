@@ -22,13 +22,15 @@ func ConvertToTranslatableError(err error) error {
 	case actionerror.AddPluginRepositoryError:
 		return AddPluginRepositoryError(e)
 	case actionerror.ApplicationNotFoundError:
-		return ApplicationNotFoundError(e)
+		return ApplicationNotFoundError{GUID: e.GUID, Name: e.Name}
 	case actionerror.ApplicationNotStartedError:
 		return ApplicationNotStartedError(e)
 	case actionerror.AppNotFoundInManifestError:
 		return AppNotFoundInManifestError(e)
 	case actionerror.AssignDropletError:
 		return AssignDropletError(e)
+	case actionerror.ChecksumMismatchError:
+		return DownloadChecksumMismatchError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
 	case actionerror.DockerPasswordNotSetError:
@@ -47,6 +49,8 @@ func ConvertToTranslatableError(err error) error {
 		return HTTPHealthCheckInvalidError{}
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
+	case actionerror.InvalidUserAliasError:
+		return InvalidUserAliasError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
 	case actionerror.InvalidTCPRouteSettings:
@@ -61,6 +65,8 @@ func ConvertToTranslatableError(err error) error {
 		return NoDomainsFoundError{}
 	case actionerror.NoHostnameAndSharedDomainError:
 		return NoHostnameAndSharedDomainError{}
+	case actionerror.NoPreviousDropletError:
+		return NoPreviousDropletError(e)
 	case actionerror.NoMatchingDomainError:
 		return NoMatchingDomainError(e)
 	case actionerror.NonexistentAppPathError:
@@ -72,9 +78,11 @@ func ConvertToTranslatableError(err error) error {
 	case actionerror.NotLoggedInError:
 		return NotLoggedInError(e)
 	case actionerror.OrganizationNotFoundError:
-		return OrganizationNotFoundError(e)
+		return OrganizationNotFoundError{GUID: e.GUID, Name: e.Name}
 	case actionerror.PasswordGrantTypeLogoutRequiredError:
 		return PasswordGrantTypeLogoutRequiredError(e)
+	case actionerror.PluginChecksumMismatchError:
+		return InvalidChecksumError{}
 	case actionerror.PluginCommandsConflictError:
 		return PluginCommandsConflictError(e)
 	case actionerror.PluginInvalidError:
@@ -99,8 +107,14 @@ func ConvertToTranslatableError(err error) error {
 		return RoutePathWithTCPDomainError(e)
 	case actionerror.SecurityGroupNotFoundError:
 		return SecurityGroupNotFoundError(e)
+	case actionerror.ServiceBindingRecreateFailedError:
+		return ServiceBindingRecreateFailedError(e)
+	case actionerror.ServiceInstanceHasAssociationsError:
+		return ServiceInstanceHasAssociationsError(e)
+	case actionerror.ServiceInstanceMoveIncompleteError:
+		return ServiceInstanceMoveIncompleteError(e)
 	case actionerror.ServiceInstanceNotFoundError:
-		return ServiceInstanceNotFoundError(e)
+		return ServiceInstanceNotFoundError{GUID: e.GUID, Name: e.Name}
 	case actionerror.ServiceInstanceNotShareableError:
 		return ServiceInstanceNotShareableError{
 			FeatureFlagEnabled:          e.FeatureFlagEnabled,
@@ -108,6 +122,26 @@ func ConvertToTranslatableError(err error) error {
 		}
 	case actionerror.ServiceInstanceNotSharedToSpaceError:
 		return ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: e.ServiceInstanceName}
+	case actionerror.ServiceInstanceOperationFailedError:
+		return ServiceInstanceOperationFailedError(e)
+	case actionerror.ServiceInstanceOperationTimeoutError:
+		return ServiceInstanceOperationTimeoutError(e)
+	case actionerror.ServiceBrokerNotFoundError:
+		return ServiceBrokerNotFoundError(e)
+	case actionerror.ServiceNotFoundError:
+		return ServiceNotFoundError(e)
+	case actionerror.ServiceParametersInvalidError:
+		var errs []ServiceParameterError
+		for _, paramErr := range e.Errors {
+			errs = append(errs, ServiceParameterError(paramErr))
+		}
+		return ServiceParametersInvalidError{Errors: errs}
+	case actionerror.ServicePlanIsPublicError:
+		return ServicePlanIsPublicError(e)
+	case actionerror.ServicePlanNotFoundError:
+		return ServicePlanNotFoundError(e)
+	case actionerror.ServiceKeyNotFoundError:
+		return ServiceKeyNotFoundError(e)
 	case actionerror.SharedServiceInstanceNotFoundError:
 		return SharedServiceInstanceNotFoundError(e)
 	case actionerror.SpaceNotFoundError:
@@ -124,6 +158,10 @@ func ConvertToTranslatableError(err error) error {
 		return TriggerLegacyPushError{DomainHostRelated: e.DomainHostRelated}
 	case actionerror.UploadFailedError:
 		return UploadFailedError{Err: ConvertToTranslatableError(e.Err)}
+	case actionerror.UserAliasConflictError:
+		return UserAliasConflictError(e)
+	case actionerror.UserAliasCycleError:
+		return UserAliasCycleError(e)
 	case actionerror.CommandLineOptionsAndManifestConflictError:
 		return CommandLineOptionsAndManifestConflictError{
 			ManifestAttribute:  e.ManifestAttribute,
@@ -54,6 +54,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
 
+		Entry("actionerror.ChecksumMismatchError -> DownloadChecksumMismatchError",
+			actionerror.ChecksumMismatchError{Path: "some-path", Expected: "some-checksum", Actual: "some-other-checksum"},
+			DownloadChecksumMismatchError{Path: "some-path", Expected: "some-checksum", Actual: "some-other-checksum"}),
+
 		Entry("actionerror.DockerPasswordNotSetError -> DockerPasswordNotSetError",
 			actionerror.DockerPasswordNotSetError{},
 			DockerPasswordNotSetError{}),
@@ -102,6 +106,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.MissingNameError{},
 			RequiredNameForPushError{}),
 
+		Entry("actionerror.InvalidUserAliasError -> InvalidUserAliasError",
+			actionerror.InvalidUserAliasError{Name: "some-alias", Reason: "some-reason"},
+			InvalidUserAliasError{Name: "some-alias", Reason: "some-reason"}),
+
+		Entry("actionerror.UserAliasConflictError -> UserAliasConflictError",
+			actionerror.UserAliasConflictError{Name: "some-alias", PluginName: "some-plugin"},
+			UserAliasConflictError{Name: "some-alias", PluginName: "some-plugin"}),
+
+		Entry("actionerror.UserAliasCycleError -> UserAliasCycleError",
+			actionerror.UserAliasCycleError{Names: []string{"a", "b", "a"}},
+			UserAliasCycleError{Names: []string{"a", "b", "a"}}),
+
 		Entry("actionerror.NoCompatibleBinaryError -> NoCompatibleBinaryError",
 			actionerror.NoCompatibleBinaryError{},
 			NoCompatibleBinaryError{}),
@@ -114,6 +130,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoHostnameAndSharedDomainError{},
 			NoHostnameAndSharedDomainError{}),
 
+		Entry("actionerror.NoPreviousDropletError -> NoPreviousDropletError",
+			actionerror.NoPreviousDropletError{AppName: "some-app", Previous: 2},
+			NoPreviousDropletError{AppName: "some-app", Previous: 2}),
+
 		Entry("actionerror.NoMatchingDomainError -> NoMatchingDomainError",
 			actionerror.NoMatchingDomainError{Route: "some-route.com"},
 			NoMatchingDomainError{Route: "some-route.com"}),
@@ -142,6 +162,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PasswordGrantTypeLogoutRequiredError{},
 			PasswordGrantTypeLogoutRequiredError{}),
 
+		Entry("actionerror.PluginChecksumMismatchError -> InvalidChecksumError",
+			actionerror.PluginChecksumMismatchError{PluginName: "some-plugin", Platform: "linux64"},
+			InvalidChecksumError{}),
+
 		Entry("actionerror.PluginCommandConflictError -> PluginCommandConflictError",
 			actionerror.PluginCommandsConflictError{
 				PluginName:     "some-plugin",
@@ -204,6 +228,42 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.SecurityGroupNotFoundError{Name: "some-security-group"},
 			SecurityGroupNotFoundError{Name: "some-security-group"}),
 
+		Entry("actionerror.ServiceBindingRecreateFailedError -> ServiceBindingRecreateFailedError",
+			actionerror.ServiceBindingRecreateFailedError{AppName: "some-app", ServiceInstanceName: "some-service-instance", BindErr: "some-error"},
+			ServiceBindingRecreateFailedError{AppName: "some-app", ServiceInstanceName: "some-service-instance", BindErr: "some-error"}),
+
+		Entry("actionerror.ServiceInstanceHasAssociationsError -> ServiceInstanceHasAssociationsError",
+			actionerror.ServiceInstanceHasAssociationsError{Name: "some-service-instance"},
+			ServiceInstanceHasAssociationsError{Name: "some-service-instance"}),
+
+		Entry("actionerror.ServiceInstanceOperationFailedError -> ServiceInstanceOperationFailedError",
+			actionerror.ServiceInstanceOperationFailedError{Name: "some-service-instance", Operation: "create", Description: "some-description"},
+			ServiceInstanceOperationFailedError{Name: "some-service-instance", Operation: "create", Description: "some-description"}),
+
+		Entry("actionerror.ServiceInstanceOperationTimeoutError -> ServiceInstanceOperationTimeoutError",
+			actionerror.ServiceInstanceOperationTimeoutError{Name: "some-service-instance", Operation: "create", Timeout: time.Minute},
+			ServiceInstanceOperationTimeoutError{Name: "some-service-instance", Operation: "create", Timeout: time.Minute}),
+
+		Entry("actionerror.ServiceBrokerNotFoundError -> ServiceBrokerNotFoundError",
+			actionerror.ServiceBrokerNotFoundError{Name: "some-broker"},
+			ServiceBrokerNotFoundError{Name: "some-broker"}),
+
+		Entry("actionerror.ServiceNotFoundError -> ServiceNotFoundError",
+			actionerror.ServiceNotFoundError{Name: "some-service"},
+			ServiceNotFoundError{Name: "some-service"}),
+
+		Entry("actionerror.ServicePlanIsPublicError -> ServicePlanIsPublicError",
+			actionerror.ServicePlanIsPublicError{PlanName: "some-plan", ServiceName: "some-service", OrgName: "some-org"},
+			ServicePlanIsPublicError{PlanName: "some-plan", ServiceName: "some-service", OrgName: "some-org"}),
+
+		Entry("actionerror.ServicePlanNotFoundError -> ServicePlanNotFoundError",
+			actionerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"},
+			ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}),
+
+		Entry("actionerror.ServiceInstanceMoveIncompleteError -> ServiceInstanceMoveIncompleteError",
+			actionerror.ServiceInstanceMoveIncompleteError{Name: "some-service-instance", MoveErr: "some-error", RollbackErr: "some-rollback-error", TargetInstanceRemains: true, UnboundApplications: []string{"some-app"}},
+			ServiceInstanceMoveIncompleteError{Name: "some-service-instance", MoveErr: "some-error", RollbackErr: "some-rollback-error", TargetInstanceRemains: true, UnboundApplications: []string{"some-app"}}),
+
 		Entry("actionerror.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
 			actionerror.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			ServiceInstanceNotFoundError{Name: "some-service-instance"}),
@@ -216,6 +276,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 				FeatureFlagEnabled:          true,
 				ServiceBrokerSharingEnabled: false}),
 
+		Entry("actionerror.ServiceParametersInvalidError -> ServiceParametersInvalidError",
+			actionerror.ServiceParametersInvalidError{Errors: []actionerror.ServiceParameterError{{Path: "size", Message: "size is required"}}},
+			ServiceParametersInvalidError{Errors: []ServiceParameterError{{Path: "size", Message: "size is required"}}}),
+
+		Entry("actionerror.ServiceKeyNotFoundError -> ServiceKeyNotFoundError",
+			actionerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"},
+			ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}),
+
 		Entry("actionerror.SharedServiceInstanceNotFoundError -> SharedServiceInstanceNotFoundError",
 			actionerror.SharedServiceInstanceNotFoundError{},
 			SharedServiceInstanceNotFoundError{}),
@@ -0,0 +1,22 @@
+package translatableerror
+
+// DownloadChecksumMismatchError is returned when the checksum of a downloaded
+// droplet or package does not match the checksum reported by the Cloud
+// Controller.
+type DownloadChecksumMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (DownloadChecksumMismatchError) Error() string {
+	return "Checksum of downloaded file {{.Path}} is {{.Actual}}, expected {{.Expected}}.\nPlease try again."
+}
+
+func (e DownloadChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":     e.Path,
+		"Expected": e.Expected,
+		"Actual":   e.Actual,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidUserAliasError struct {
+	Name   string
+	Reason string
+}
+
+func (InvalidUserAliasError) Error() string {
+	return "Alias {{.Name}} is invalid: {{.Reason}}"
+}
+
+func (e InvalidUserAliasError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":   e.Name,
+		"Reason": e.Reason,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// NoPreviousDropletError is returned when an app does not have enough staged
+// droplets older than its current droplet to roll back to.
+type NoPreviousDropletError struct {
+	AppName  string
+	Previous int
+}
+
+func (NoPreviousDropletError) Error() string {
+	return "App {{.AppName}} does not have {{.Previous}} staged droplet(s) older than its current droplet."
+}
+
+func (e NoPreviousDropletError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":  e.AppName,
+		"Previous": e.Previous,
+	})
+}
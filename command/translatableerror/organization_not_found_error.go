@@ -1,8 +1,9 @@
 package translatableerror
 
 type OrganizationNotFoundError struct {
-	GUID string
-	Name string
+	GUID        string
+	Name        string
+	Suggestions []string
 }
 
 func (OrganizationNotFoundError) Error() string {
@@ -10,7 +11,7 @@ func (OrganizationNotFoundError) Error() string {
 }
 
 func (e OrganizationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
-	return translate(e.Error(), map[string]interface{}{
+	return translateWithSuggestions(translate, e.Error(), map[string]interface{}{
 		"Name": e.Name,
-	})
+	}, e.Suggestions)
 }
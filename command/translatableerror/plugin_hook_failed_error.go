@@ -0,0 +1,21 @@
+package translatableerror
+
+// PluginHookFailedError is returned when a plugin subscribed to a pre-event
+// hook could not be run.
+type PluginHookFailedError struct {
+	PluginName string
+	Event      string
+	Err        error
+}
+
+func (PluginHookFailedError) Error() string {
+	return "Plugin {{.PluginName}} failed to run its {{.Event}} hook: {{.Error}}"
+}
+
+func (e PluginHookFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Event":      e.Event,
+		"Error":      e.Err,
+	})
+}
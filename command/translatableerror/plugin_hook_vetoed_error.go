@@ -0,0 +1,24 @@
+package translatableerror
+
+// PluginHookVetoedError is returned when a plugin subscribed to a pre-event
+// hook refuses to let the operation continue.
+type PluginHookVetoedError struct {
+	PluginName string
+	Event      string
+	Message    string
+}
+
+func (e PluginHookVetoedError) Error() string {
+	if e.Message == "" {
+		return "Plugin {{.PluginName}} stopped the operation in its {{.Event}} hook."
+	}
+	return "Plugin {{.PluginName}} stopped the operation in its {{.Event}} hook: {{.Message}}"
+}
+
+func (e PluginHookVetoedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Event":      e.Event,
+		"Message":    e.Message,
+	})
+}
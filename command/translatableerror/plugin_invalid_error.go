@@ -3,7 +3,7 @@ package translatableerror
 import "fmt"
 
 // PluginInvalidError is returned with a plugin is invalid because it is
-// missing a name or has 0 commands.
+// missing a name or has neither commands nor hooks.
 type PluginInvalidError struct {
 	Err error
 }
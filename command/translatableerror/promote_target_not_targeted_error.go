@@ -0,0 +1,21 @@
+package translatableerror
+
+import "fmt"
+
+// PromoteTargetNotTargetedError is returned when the login that an app is
+// promoted to with --to-target has no org targeted.
+type PromoteTargetNotTargetedError struct {
+	BinaryName string
+	HomeDir    string
+}
+
+func (PromoteTargetNotTargetedError) Error() string {
+	return "No org targeted in {{.HomeDir}}, use '{{.Command}}' to target an org."
+}
+
+func (e PromoteTargetNotTargetedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"HomeDir": e.HomeDir,
+		"Command": fmt.Sprintf("CF_HOME=%s %s target -o ORG", e.HomeDir, e.BinaryName),
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// RestageFleetIncompleteError is returned when some of the apps selected by
+// restage-fleet failed to restage or were not restaged.
+type RestageFleetIncompleteError struct {
+	Failed       int
+	Remaining    int
+	ProgressFile string
+}
+
+func (RestageFleetIncompleteError) Error() string {
+	return "{{.Failed}} app(s) failed to restage and {{.Remaining}} app(s) were not restaged.\nProgress has been saved to {{.ProgressFile}}. Run the command again to resume."
+}
+
+func (e RestageFleetIncompleteError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed":       e.Failed,
+		"Remaining":    e.Remaining,
+		"ProgressFile": e.ProgressFile,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// RollbackRevertedError is returned when an app failed to start with the
+// droplet it was rolled back to and was reverted to its original droplet.
+type RollbackRevertedError struct {
+	AppName    string
+	BinaryName string
+}
+
+func (RollbackRevertedError) Error() string {
+	return "App {{.AppName}} failed to start after rolling back and was reverted to its original droplet.\n\nUse '{{.BinaryName}} logs {{.AppName}} --recent' for more information"
+}
+
+func (e RollbackRevertedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"BinaryName": e.BinaryName,
+	})
+}
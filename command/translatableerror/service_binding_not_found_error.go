@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceBindingNotFoundError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (ServiceBindingNotFoundError) Error() string {
+	return "Service instance {{.ServiceInstanceName}} is not bound to app {{.AppName}}."
+}
+
+func (e ServiceBindingNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":             e.AppName,
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
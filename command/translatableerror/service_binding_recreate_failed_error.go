@@ -0,0 +1,22 @@
+package translatableerror
+
+// ServiceBindingRecreateFailedError is returned when rebinding an application
+// to a service instance deletes the old service binding but fails to create
+// the new one, leaving the application unbound.
+type ServiceBindingRecreateFailedError struct {
+	AppName             string
+	ServiceInstanceName string
+	BindErr             string
+}
+
+func (ServiceBindingRecreateFailedError) Error() string {
+	return "App {{.AppName}} was unbound from service instance {{.ServiceInstanceName}} but binding it again failed: {{.BindErr}}\nThe app is no longer bound to the service instance."
+}
+
+func (e ServiceBindingRecreateFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":             e.AppName,
+		"ServiceInstanceName": e.ServiceInstanceName,
+		"BindErr":             e.BindErr,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type ServiceBrokerNotFoundError struct {
+	Name string
+}
+
+func (ServiceBrokerNotFoundError) Error() string {
+	return "Service broker {{.Name}} not found"
+}
+
+func (e ServiceBrokerNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
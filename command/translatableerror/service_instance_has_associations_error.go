@@ -0,0 +1,13 @@
+package translatableerror
+
+type ServiceInstanceHasAssociationsError struct {
+	Name string
+}
+
+func (ServiceInstanceHasAssociationsError) Error() string {
+	return "Cannot delete service instance. Service keys, bindings, and shares must first be deleted."
+}
+
+func (e ServiceInstanceHasAssociationsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,35 @@
+package translatableerror
+
+import "strings"
+
+// ServiceInstanceMoveIncompleteError is returned when moving a service
+// instance fails and rolling back the move fails as well.
+type ServiceInstanceMoveIncompleteError struct {
+	Name                  string
+	MoveErr               string
+	RollbackErr           string
+	TargetInstanceRemains bool
+	UnboundApplications   []string
+}
+
+func (e ServiceInstanceMoveIncompleteError) Error() string {
+	switch {
+	case e.TargetInstanceRemains && len(e.UnboundApplications) > 0:
+		return "Moving service instance {{.Name}} failed: {{.MoveErr}}\nRolling back the move failed: {{.RollbackErr}}\nThe service instance still exists in the target space, and the service instance in the current space is no longer bound to: {{.Applications}}"
+	case e.TargetInstanceRemains:
+		return "Moving service instance {{.Name}} failed: {{.MoveErr}}\nRolling back the move failed: {{.RollbackErr}}\nThe service instance still exists in the target space."
+	case len(e.UnboundApplications) > 0:
+		return "Moving service instance {{.Name}} failed: {{.MoveErr}}\nRolling back the move failed: {{.RollbackErr}}\nThe service instance in the current space is no longer bound to: {{.Applications}}"
+	default:
+		return "Moving service instance {{.Name}} failed: {{.MoveErr}}\nRolling back the move failed: {{.RollbackErr}}"
+	}
+}
+
+func (e ServiceInstanceMoveIncompleteError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":         e.Name,
+		"MoveErr":      e.MoveErr,
+		"RollbackErr":  e.RollbackErr,
+		"Applications": strings.Join(e.UnboundApplications, ", "),
+	})
+}
@@ -1,8 +1,9 @@
 package translatableerror
 
 type ServiceInstanceNotFoundError struct {
-	GUID string
-	Name string
+	GUID        string
+	Name        string
+	Suggestions []string
 }
 
 func (e ServiceInstanceNotFoundError) Error() string {
@@ -13,8 +14,8 @@ func (e ServiceInstanceNotFoundError) Error() string {
 }
 
 func (e ServiceInstanceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
-	return translate(e.Error(), map[string]interface{}{
+	return translateWithSuggestions(translate, e.Error(), map[string]interface{}{
 		"GUID":            e.GUID,
 		"ServiceInstance": e.Name,
-	})
+	}, e.Suggestions)
 }
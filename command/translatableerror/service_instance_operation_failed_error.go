@@ -0,0 +1,19 @@
+package translatableerror
+
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   string
+	Description string
+}
+
+func (ServiceInstanceOperationFailedError) Error() string {
+	return "Service instance {{.Name}} {{.Operation}} failed: {{.Description}}"
+}
+
+func (e ServiceInstanceOperationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":        e.Name,
+		"Operation":   e.Operation,
+		"Description": e.Description,
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+import "time"
+
+type ServiceInstanceOperationTimeoutError struct {
+	Name      string
+	Operation string
+	Timeout   time.Duration
+}
+
+func (ServiceInstanceOperationTimeoutError) Error() string {
+	return `Timed out after {{.Timeout}} {{if eq .Timeout 1.0}}minute{{else}}minutes{{end}} waiting for service instance {{.Name}} {{.Operation}} to complete. The operation may still be in progress.`
+}
+
+func (e ServiceInstanceOperationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":      e.Name,
+		"Operation": e.Operation,
+		"Timeout":   e.Timeout.Minutes(),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (ServiceKeyNotFoundError) Error() string {
+	return "No service key {{.ServiceKeyName}} found for service instance {{.ServiceInstanceName}}."
+}
+
+func (e ServiceKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceKeyName":      e.Name,
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
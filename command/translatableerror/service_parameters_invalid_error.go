@@ -0,0 +1,30 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ServiceParameterError struct {
+	Path    string
+	Message string
+}
+
+type ServiceParametersInvalidError struct {
+	Errors []ServiceParameterError
+}
+
+func (ServiceParametersInvalidError) Error() string {
+	return "The configuration parameters do not match the schema published by the service plan:\n{{.Errors}}\nUse --skip-schema-validation to send them to the service broker anyway."
+}
+
+func (e ServiceParametersInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	var errs []string
+	for _, paramErr := range e.Errors {
+		errs = append(errs, fmt.Sprintf("   %s: %s", paramErr.Path, paramErr.Message))
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": strings.Join(errs, "\n"),
+	})
+}
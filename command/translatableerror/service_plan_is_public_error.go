@@ -0,0 +1,19 @@
+package translatableerror
+
+type ServicePlanIsPublicError struct {
+	PlanName    string
+	ServiceName string
+	OrgName     string
+}
+
+func (ServicePlanIsPublicError) Error() string {
+	return "No action taken. The {{.PlanName}} plan of service {{.ServiceName}} is accessible to all orgs. You must disable access to it for all orgs and then grant access to every org except the {{.OrgName}} org."
+}
+
+func (e ServicePlanIsPublicError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PlanName":    e.PlanName,
+		"ServiceName": e.ServiceName,
+		"OrgName":     e.OrgName,
+	})
+}
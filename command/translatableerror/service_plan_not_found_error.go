@@ -0,0 +1,20 @@
+package translatableerror
+
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	if e.ServiceName != "" {
+		return "Plan {{.PlanName}} does not exist for the {{.ServiceName}} service"
+	}
+	return "Could not find plan with name {{.PlanName}}"
+}
+
+func (e ServicePlanNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PlanName":    e.PlanName,
+		"ServiceName": e.ServiceName,
+	})
+}
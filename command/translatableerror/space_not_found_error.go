@@ -1,7 +1,8 @@
 package translatableerror
 
 type SpaceNotFoundError struct {
-	Name string
+	Name        string
+	Suggestions []string
 }
 
 func (SpaceNotFoundError) Error() string {
@@ -9,7 +10,7 @@ func (SpaceNotFoundError) Error() string {
 }
 
 func (e SpaceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
-	return translate(e.Error(), map[string]interface{}{
+	return translateWithSuggestions(translate, e.Error(), map[string]interface{}{
 		"Name": e.Name,
-	})
+	}, e.Suggestions)
 }
@@ -0,0 +1,28 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// translateWithSuggestions translates message and, when there are
+// suggestions, appends a hint naming them.
+func translateWithSuggestions(translate func(string, ...interface{}) string, message string, keys map[string]interface{}, suggestions []string) string {
+	translated := translate(message, keys)
+	if len(suggestions) == 0 {
+		return translated
+	}
+
+	quoted := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("'%s'", suggestion))
+	}
+	names := quoted[len(quoted)-1]
+	if len(quoted) > 1 {
+		names = strings.Join(quoted[:len(quoted)-1], ", ") + " or " + names
+	}
+
+	return translated + "\n" + translate("Did you mean {{.Suggestions}}?", map[string]interface{}{
+		"Suggestions": names,
+	})
+}
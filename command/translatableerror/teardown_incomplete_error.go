@@ -0,0 +1,17 @@
+package translatableerror
+
+// TeardownIncompleteError is returned when some resources could not be
+// deleted during an ordered teardown.
+type TeardownIncompleteError struct {
+	Failures int
+}
+
+func (TeardownIncompleteError) Error() string {
+	return "{{.Failures}} deletion(s) failed. Resolve the errors above and run the command again to resume."
+}
+
+func (e TeardownIncompleteError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failures": e.Failures,
+	})
+}
@@ -75,6 +75,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
+		Entry("NoPreviousDropletError", NoPreviousDropletError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
@@ -98,12 +99,17 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
+		Entry("RestageFleetIncompleteError", RestageFleetIncompleteError{}),
+		Entry("RollbackRevertedError", RollbackRevertedError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RoutePathWithTCPDomainError", RoutePathWithTCPDomainError{}),
 		Entry("RunTaskError", RunTaskError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
+		Entry("ServiceBindingRecreateFailedError", ServiceBindingRecreateFailedError{}),
+		Entry("ServiceInstanceMoveIncompleteError", ServiceInstanceMoveIncompleteError{}),
 		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServicePlanIsPublicError", ServicePlanIsPublicError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
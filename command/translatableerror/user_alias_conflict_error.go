@@ -0,0 +1,22 @@
+package translatableerror
+
+// UserAliasConflictError is returned when a user-defined alias name is
+// already used by a core or plugin command.
+type UserAliasConflictError struct {
+	Name       string
+	PluginName string
+}
+
+func (e UserAliasConflictError) Error() string {
+	if e.PluginName != "" {
+		return "Alias {{.Name}} could not be set as it is already used by a command of plugin {{.PluginName}}."
+	}
+	return "Alias {{.Name}} could not be set as it is already used by a core command or alias."
+}
+
+func (e UserAliasConflictError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":       e.Name,
+		"PluginName": e.PluginName,
+	})
+}
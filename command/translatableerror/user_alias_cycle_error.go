@@ -0,0 +1,17 @@
+package translatableerror
+
+import "strings"
+
+type UserAliasCycleError struct {
+	Names []string
+}
+
+func (UserAliasCycleError) Error() string {
+	return "Aliases refer to each other in a loop: {{.Cycle}}"
+}
+
+func (e UserAliasCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Cycle": strings.Join(e.Names, " -> "),
+	})
+}
@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ApplyRolesActor
+
+type ApplyRolesActor interface {
+	ApplyRoleChanges(changes []v2action.RoleChange) (int, v2action.Warnings, error)
+	GetRoleChanges(rolesFile v2action.RolesFile) ([]v2action.RoleChange, v2action.Warnings, error)
+	ReadRolesFile(path string) (v2action.RolesFile, error)
+}
+
+type ApplyRolesCommand struct {
+	RequiredArgs    flag.ApplyRolesArgs `positional-args:"yes"`
+	Force           bool                `short:"f" description:"Force the role changes without confirmation"`
+	Preview         bool                `long:"preview" description:"Show the role changes without applying them"`
+	usage           interface{}         `usage:"CF_NAME apply-roles FILE [--preview] [-f]\n\n   Grants and revokes the org and space roles listed in FILE. Roles that are already\n   granted or revoked are left unchanged, and the remaining changes are shown before\n   they are applied.\n\nEXAMPLES:\n   CF_NAME apply-roles roles.yml --preview\n\n   ---\n   grant:\n   - user: alice@example.com\n     org: tenant\n     role: OrgManager\n   - user: bob@example.com\n     org: tenant\n     space: dev\n     role: SpaceDeveloper\n   revoke:\n   - user: carol@example.com\n     org: tenant\n     space: dev\n     role: SpaceManager\n\nROLES:\n   Org roles: OrgUser, OrgManager, BillingManager, OrgAuditor\n   Space roles: SpaceManager, SpaceDeveloper, SpaceAuditor"`
+	relatedCommands interface{}         `related_commands:"roles, user-roles, set-org-role, set-space-role, unset-org-role, unset-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyRolesActor
+}
+
+func (cmd *ApplyRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ApplyRolesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rolesFile, err := cmd.Actor.ReadRolesFile(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting role changes from {{.Path}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Path":        cmd.RequiredArgs.Path,
+		"CurrentUser": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.GetRoleChanges(rolesFile)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("No role changes to apply.")
+		return nil
+	}
+
+	cmd.displayChanges(changes)
+
+	if cmd.Preview {
+		return nil
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayNewline()
+		applyRoles, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really apply {{.Count}} role changes?", map[string]interface{}{
+			"Count": len(changes),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !applyRoles {
+			cmd.UI.DisplayText("Apply roles cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying role changes as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentUser": user.Name,
+	})
+
+	applied, warnings, err := cmd.Actor.ApplyRoleChanges(changes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayText("Applied {{.Applied}} of {{.Count}} role changes.", map[string]interface{}{
+			"Applied": applied,
+			"Count":   len(changes),
+		})
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplyRolesCommand) displayChanges(changes []v2action.RoleChange) {
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("user"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("role"),
+		},
+	}
+	for _, change := range changes {
+		sign := "+"
+		if change.Action == v2action.RoleRevoke {
+			sign = "-"
+		}
+		table = append(table, []string{sign, change.Username, change.OrganizationName, change.SpaceName, change.Role})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
@@ -0,0 +1,194 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-roles Command", func() {
+	var (
+		cmd             ApplyRolesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyRolesActor
+		rolesFile       v2action.RolesFile
+		changes         []v2action.RoleChange
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyRolesActor)
+
+		cmd = ApplyRolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Path = "roles.yml"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		rolesFile = v2action.RolesFile{
+			Grant: []v2action.RoleAssignment{{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"}},
+		}
+		changes = []v2action.RoleChange{
+			{
+				RoleAssignment: v2action.RoleAssignment{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"},
+				Action:         v2action.RoleGrant,
+			},
+			{
+				RoleAssignment: v2action.RoleAssignment{Username: "bob", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceAuditor"},
+				Action:         v2action.RoleRevoke,
+			},
+		}
+		fakeActor.ReadRolesFileReturns(rolesFile, nil)
+		fakeActor.GetRoleChangesReturns(changes, v2action.Warnings{"changes-warning"}, nil)
+		fakeActor.ApplyRoleChangesReturns(2, v2action.Warnings{"apply-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.ReadRolesFileCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when reading the roles file fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = actionerror.InvalidRolesFileError{Path: "roles.yml", Reason: "every role must have a user"}
+			fakeActor.ReadRolesFileReturns(v2action.RolesFile{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.GetRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("displays the changes and applies them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting role changes from roles\.yml as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`user\s+org\s+space\s+role`))
+			Expect(testUI.Out).To(Say(`\+\s+alice\s+org-1\s+OrgManager`))
+			Expect(testUI.Out).To(Say(`-\s+bob\s+org-1\s+dev\s+SpaceAuditor`))
+			Expect(testUI.Out).To(Say(`Applying role changes as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("changes-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			Expect(fakeActor.ReadRolesFileArgsForCall(0)).To(Equal("roles.yml"))
+			Expect(fakeActor.GetRoleChangesArgsForCall(0)).To(Equal(rolesFile))
+			Expect(fakeActor.ApplyRoleChangesArgsForCall(0)).To(Equal(changes))
+		})
+
+		Context("when applying the changes fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyRoleChangesReturns(1, v2action.Warnings{"apply-warning"}, errors.New("apply-error"))
+			})
+
+			It("displays how many changes were applied and returns the error", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(testUI.Out).To(Say("Applied 1 of 2 role changes."))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+
+	Context("when the --preview flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Preview = true
+		})
+
+		It("displays the changes without applying them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`\+\s+alice\s+org-1\s+OrgManager`))
+			Expect(testUI.Out).ToNot(Say("Really apply"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are no changes", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(nil, v2action.Warnings{"changes-warning"}, nil)
+		})
+
+		It("says so without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No role changes to apply."))
+			Expect(testUI.Out).ToNot(Say("Really apply"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoleChangesReturns(nil, v2action.Warnings{"changes-warning"}, actionerror.OrganizationNotFoundError{Name: "org-1"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org-1"}))
+			Expect(testUI.Err).To(Say("changes-warning"))
+		})
+	})
+
+	Context("when the user confirms the changes", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("applies them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really apply 2 role changes\? \[yN\]`))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the user declines the changes", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("cancels without applying them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Apply roles cancelled"))
+			Expect(fakeActor.ApplyRoleChangesCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -113,6 +113,47 @@ var _ = Describe("bind-service Command", func() {
 				})
 			})
 
+			Context("when validating the parameters", func() {
+				It("validates them against the service plan's binding schema", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.ValidateServiceBindingParametersCallCount()).To(Equal(1))
+					serviceInstanceName, spaceGUID, parameters := fakeActor.ValidateServiceBindingParametersArgsForCall(0)
+					Expect(serviceInstanceName).To(Equal("some-service"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+				})
+
+				Context("when the parameters do not match the schema", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = actionerror.ServiceParametersInvalidError{
+							Errors: []actionerror.ServiceParameterError{{Path: "(root)", Message: "Additional property some-parameter is not allowed"}},
+						}
+						fakeActor.ValidateServiceBindingParametersReturns(v2action.Warnings{"validate-warning"}, expectedErr)
+					})
+
+					It("returns the error without binding the service", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("validate-warning"))
+						Expect(fakeActor.BindServiceBySpaceCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when --skip-schema-validation is provided", func() {
+					BeforeEach(func() {
+						cmd.SkipValidation = true
+					})
+
+					It("does not validate the parameters", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.ValidateServiceBindingParametersCallCount()).To(Equal(0))
+						Expect(fakeActor.BindServiceBySpaceCallCount()).To(Equal(1))
+					})
+				})
+			})
+
 			Context("when passed a binding name", func() {
 				BeforeEach(func() {
 					cmd.BindingName.Value = "some-binding-name"
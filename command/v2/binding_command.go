@@ -0,0 +1,203 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . BindingActor
+
+type BindingActor interface {
+	GetServiceBindingSummaryBySpace(appName string, serviceInstanceName string, spaceGUID string) (v2action.ServiceBindingSummary, v2action.Warnings, error)
+}
+
+type BindingCommand struct {
+	RequiredArgs    flag.BindServiceArgs `positional-args:"yes"`
+	ShowSecrets     bool                 `long:"show-secrets" description:"Display the binding credentials instead of hiding them"`
+	Output          flag.OutputFormat    `long:"output" description:"Output format: json or table"`
+	usage           interface{}          `usage:"CF_NAME binding APP_NAME SERVICE_INSTANCE [--show-secrets] [--output (json | table)]\n\n   Credentials are hidden unless --show-secrets is provided. Parameters are displayed\n   when the service broker supports fetching them.\n\nEXAMPLES:\n   CF_NAME binding myapp mydb\n   CF_NAME binding myapp mydb --show-secrets --output json"`
+	relatedCommands interface{}          `related_commands:"bind-service, env, service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BindingActor
+}
+
+// bindingJSON is the --output json representation of a service binding.
+type bindingJSON struct {
+	Name            string                 `json:"name"`
+	App             string                 `json:"app"`
+	ServiceInstance string                 `json:"service_instance"`
+	Parameters      map[string]interface{} `json:"parameters"`
+	Credentials     map[string]interface{} `json:"credentials"`
+}
+
+func (cmd *BindingCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd BindingCommand) Execute(args []string) error {
+	if cmd.Output.Format == "csv" {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--output",
+			ExpectedType: `"json" or "table"`,
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output.Format == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting binding between app {{.AppName}} and service instance {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":             cmd.RequiredArgs.AppName,
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstanceName,
+			"OrgName":             cmd.Config.TargetedOrganization().Name,
+			"SpaceName":           cmd.Config.TargetedSpace().Name,
+			"CurrentUser":         user.Name,
+		})
+	}
+
+	summary, warnings, err := cmd.Actor.GetServiceBindingSummaryBySpace(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.ServiceBindingNotFoundError); ok {
+		return translatableerror.ServiceBindingNotFoundError{
+			AppName:             cmd.RequiredArgs.AppName,
+			ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		}
+	} else if err != nil {
+		return err
+	}
+
+	credentials := summary.Credentials
+	if !cmd.ShowSecrets {
+		credentials = redactValues(credentials)
+	}
+
+	if cmd.Output.Format == "json" {
+		return cmd.displayJSON(summary, credentials)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), summary.Name},
+		{cmd.UI.TranslateText("app:"), summary.AppName},
+		{cmd.UI.TranslateText("service instance:"), summary.ServiceInstanceName},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayHeader("Parameters:")
+	switch {
+	case !summary.ParametersSupported:
+		cmd.UI.DisplayText("This service does not support fetching service binding parameters.")
+	case len(summary.Parameters) == 0:
+		cmd.UI.DisplayText("No parameters have been set")
+	default:
+		err = cmd.displayValues(summary.Parameters)
+		if err != nil {
+			return err
+		}
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayHeader("Credentials:")
+	if len(credentials) == 0 {
+		cmd.UI.DisplayText("No credentials have been set")
+		return nil
+	}
+
+	err = cmd.displayValues(credentials)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.ShowSecrets {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Use '{{.Command}}' to display the credentials.", map[string]interface{}{
+			"Command": fmt.Sprintf("%s binding %s %s --show-secrets", cmd.Config.BinaryName(), cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName),
+		})
+	}
+
+	return nil
+}
+
+func (cmd BindingCommand) displayJSON(summary v2action.ServiceBindingSummary, credentials map[string]interface{}) error {
+	output, err := json.MarshalIndent(bindingJSON{
+		Name:            summary.Name,
+		App:             summary.AppName,
+		ServiceInstance: summary.ServiceInstanceName,
+		Parameters:      summary.Parameters,
+		Credentials:     credentials,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+func (cmd BindingCommand) displayValues(values map[string]interface{}) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		value, isString := values[key].(string)
+		if !isString {
+			valueJSON, err := json.Marshal(values[key])
+			if err != nil {
+				return err
+			}
+			value = string(valueJSON)
+		}
+		table = append(table, []string{key + ":", value})
+	}
+
+	cmd.UI.DisplayKeyValueTable("  ", table, 3)
+	return nil
+}
+
+// redactValues returns a copy of values with every value replaced by
+// ui.RedactedValue.
+func redactValues(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+
+	redacted := make(map[string]interface{}, len(values))
+	for key := range values {
+		redacted[key] = ui.RedactedValue
+	}
+	return redacted
+}
@@ -0,0 +1,195 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("binding Command", func() {
+	var (
+		cmd             BindingCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeBindingActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeBindingActor)
+
+		cmd = BindingCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.ServiceInstanceName = "some-service-instance"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		fakeActor.GetServiceBindingSummaryBySpaceReturns(
+			v2action.ServiceBindingSummary{
+				ServiceBinding: v2action.ServiceBinding{
+					GUID:        "some-binding-guid",
+					Name:        "some-binding",
+					Credentials: map[string]interface{}{"username": "admin", "password": "secret"},
+				},
+				AppName:             "some-app",
+				ServiceInstanceName: "some-service-instance",
+				Parameters:          map[string]interface{}{"some-key": "some-value", "some-object": map[string]interface{}{"nested": true}},
+				ParametersSupported: true,
+			},
+			v2action.Warnings{"summary-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("displays the binding with its parameters and hides the credentials", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting binding between app some-app and service instance some-service-instance in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`name:\s+some-binding`))
+		Expect(testUI.Out).To(Say(`app:\s+some-app`))
+		Expect(testUI.Out).To(Say(`service instance:\s+some-service-instance`))
+		Expect(testUI.Out).To(Say("Parameters:"))
+		Expect(testUI.Out).To(Say(`some-key:\s+some-value`))
+		Expect(testUI.Out).To(Say(`some-object:\s+{"nested":true}`))
+		Expect(testUI.Out).To(Say("Credentials:"))
+		Expect(testUI.Out).To(Say(`password:\s+\[PRIVATE DATA HIDDEN\]`))
+		Expect(testUI.Out).To(Say(`username:\s+\[PRIVATE DATA HIDDEN\]`))
+		Expect(testUI.Out).To(Say("TIP: Use 'faceman binding some-app some-service-instance --show-secrets' to display the credentials."))
+		Expect(testUI.Err).To(Say("summary-warning"))
+
+		Expect(fakeActor.GetServiceBindingSummaryBySpaceCallCount()).To(Equal(1))
+		appName, serviceInstanceName, spaceGUID := fakeActor.GetServiceBindingSummaryBySpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(serviceInstanceName).To(Equal("some-service-instance"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+	})
+
+	Context("when --show-secrets is provided", func() {
+		BeforeEach(func() {
+			cmd.ShowSecrets = true
+		})
+
+		It("displays the credentials", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`password:\s+secret`))
+			Expect(testUI.Out).To(Say(`username:\s+admin`))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+
+	Context("when the service broker does not support fetching parameters", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBindingSummaryBySpaceReturns(
+				v2action.ServiceBindingSummary{AppName: "some-app", ServiceInstanceName: "some-service-instance"},
+				nil,
+				nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("This service does not support fetching service binding parameters."))
+			Expect(testUI.Out).To(Say("No credentials have been set"))
+		})
+	})
+
+	Context("when --output csv is provided", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "csv"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--output",
+				ExpectedType: `"json" or "table"`,
+			}))
+			Expect(fakeActor.GetServiceBindingSummaryBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --output json is provided", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "json"
+		})
+
+		It("displays the binding as JSON only", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting binding"))
+			Expect(testUI.Out).To(Say(`"name": "some-binding"`))
+			Expect(testUI.Out).To(Say(`"app": "some-app"`))
+			Expect(testUI.Out).To(Say(`"service_instance": "some-service-instance"`))
+			Expect(testUI.Out).To(Say(`"parameters": {`))
+			Expect(testUI.Out).To(Say(`"credentials": {`))
+			Expect(testUI.Out).To(Say(`"password": "\[PRIVATE DATA HIDDEN\]"`))
+			Expect(testUI.Err).To(Say("summary-warning"))
+		})
+	})
+
+	Context("when the binding does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBindingSummaryBySpaceReturns(
+				v2action.ServiceBindingSummary{},
+				nil,
+				actionerror.ServiceBindingNotFoundError{AppGUID: "some-app-guid", ServiceInstanceGUID: "some-service-instance-guid"})
+		})
+
+		It("returns a ServiceBindingNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceBindingNotFoundError{
+				AppName:             "some-app",
+				ServiceInstanceName: "some-service-instance",
+			}))
+		})
+	})
+
+	Context("when getting the binding returns another error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetServiceBindingSummaryBySpaceReturns(v2action.ServiceBindingSummary{}, v2action.Warnings{"summary-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("summary-warning"))
+		})
+	})
+})
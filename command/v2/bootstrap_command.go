@@ -0,0 +1,194 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . BootstrapActor
+
+type BootstrapActor interface {
+	BootstrapOrganization(template v2action.OrganizationTemplate) (v2action.Organization, []v2action.BootstrapResult, v2action.Warnings, error)
+	ReadOrganizationTemplate(path string) (v2action.OrganizationTemplate, error)
+}
+
+//go:generate counterfeiter . BootstrapActorV3
+
+type BootstrapActorV3 interface {
+	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error)
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v3action.Warnings, error)
+}
+
+type BootstrapCommand struct {
+	RequiredArgs    flag.BootstrapArgs `positional-args:"yes"`
+	usage           interface{}        `usage:"CF_NAME bootstrap FILE\n\n   Creates the org and spaces described in FILE and gives them the listed quotas, roles,\n   security groups and default isolation segment. Resources that already match FILE are\n   left unchanged and resources missing from FILE are never removed, so the command can be\n   run again after FILE changes.\n\nEXAMPLES:\n   CF_NAME bootstrap tenant.yml\n\n   ---\n   name: tenant\n   quota: default\n   default_isolation_segment: tenant-segment\n   managers: [alice@example.com]\n   billing_managers: []\n   auditors: []\n   users: []\n   spaces:\n   - name: dev\n     quota: small\n     managers: [alice@example.com]\n     developers: [bob@example.com]\n     auditors: []\n     security_groups: [public_networks]\n     staging_security_groups: [dns]"`
+	relatedCommands interface{}        `related_commands:"create-org, create-space, set-org-role, set-space-role, bind-security-group, set-org-default-isolation-segment"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BootstrapActor
+	ActorV3     BootstrapActorV3
+}
+
+func (cmd *BootstrapCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	return nil
+}
+
+func (cmd BootstrapCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	template, err := cmd.Actor.ReadOrganizationTemplate(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	// Look the isolation segment up before changing anything, so that a typo
+	// in the template does not leave a half bootstrapped org behind.
+	var isolationSegment v3action.IsolationSegment
+	if template.DefaultIsolationSegment != "" {
+		if cmd.ActorV3 == nil {
+			return translatableerror.V3APIDoesNotExistError{
+				Message: "Setting the default isolation segment of an org requires the V3 API.",
+			}
+		}
+
+		var warnings v3action.Warnings
+		isolationSegment, warnings, err = cmd.ActorV3.GetIsolationSegmentByName(template.DefaultIsolationSegment)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Bootstrapping org {{.OrgName}} from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  template.Name,
+		"Path":     cmd.RequiredArgs.Path,
+		"Username": user.Name,
+	})
+
+	org, results, warnings, err := cmd.Actor.BootstrapOrganization(template)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.displayResults(results)
+		return err
+	}
+
+	if template.DefaultIsolationSegment != "" {
+		result, err := cmd.bootstrapDefaultIsolationSegment(org, isolationSegment)
+		if err != nil {
+			cmd.displayResults(results)
+			return err
+		}
+		results = append(results, result)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.displayResults(results)
+
+	return nil
+}
+
+func (cmd BootstrapCommand) bootstrapDefaultIsolationSegment(org v2action.Organization, isolationSegment v3action.IsolationSegment) (v2action.BootstrapResult, error) {
+	result := v2action.BootstrapResult{
+		Type:   v2action.BootstrapResourceIsolationSegment,
+		Name:   isolationSegment.Name,
+		Action: v2action.BootstrapUnchanged,
+	}
+	if org.DefaultIsolationSegmentGUID == isolationSegment.GUID {
+		return result, nil
+	}
+
+	entitledSegments, warnings, err := cmd.ActorV3.GetIsolationSegmentsByOrganization(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.BootstrapResult{}, err
+	}
+
+	entitled := false
+	for _, segment := range entitledSegments {
+		if segment.GUID == isolationSegment.GUID {
+			entitled = true
+		}
+	}
+
+	if !entitled {
+		warnings, err = cmd.ActorV3.EntitleIsolationSegmentToOrganizationByName(isolationSegment.Name, org.Name)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v2action.BootstrapResult{}, err
+		}
+	}
+
+	warnings, err = cmd.ActorV3.SetOrganizationDefaultIsolationSegment(org.GUID, isolationSegment.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.BootstrapResult{}, err
+	}
+
+	if org.DefaultIsolationSegmentGUID == "" {
+		result.Action = v2action.BootstrapCreated
+	} else {
+		result.Action = v2action.BootstrapUpdated
+	}
+	return result, nil
+}
+
+func (cmd BootstrapCommand) displayResults(results []v2action.BootstrapResult) {
+	if len(results) == 0 {
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("result"),
+		},
+	}
+	for _, result := range results {
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(result.Type)),
+			result.Name,
+			cmd.UI.TranslateText(string(result.Action)),
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
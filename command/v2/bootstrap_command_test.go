@@ -0,0 +1,203 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("bootstrap Command", func() {
+	var (
+		cmd             BootstrapCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeBootstrapActor
+		fakeActorV3     *v2fakes.FakeBootstrapActorV3
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeBootstrapActor)
+		fakeActorV3 = new(v2fakes.FakeBootstrapActorV3)
+
+		cmd = BootstrapCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+		cmd.RequiredArgs.Path = "tenant.yml"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.ReadOrganizationTemplateReturns(v2action.OrganizationTemplate{Name: "some-org"}, nil)
+		fakeActor.BootstrapOrganizationReturns(
+			v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+			[]v2action.BootstrapResult{
+				{Type: v2action.BootstrapResourceOrganization, Name: "some-org", Action: v2action.BootstrapCreated},
+				{Type: v2action.BootstrapResourceSpace, Name: "dev", Action: v2action.BootstrapUnchanged},
+			},
+			v2action.Warnings{"bootstrap-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when reading the template fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = actionerror.InvalidOrganizationTemplateError{Path: "tenant.yml", Reason: "the org must have a name"}
+			fakeActor.ReadOrganizationTemplateReturns(v2action.OrganizationTemplate{}, expectedErr)
+		})
+
+		It("returns the error without bootstrapping", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.BootstrapOrganizationCallCount()).To(Equal(0))
+		})
+	})
+
+	It("bootstraps the org and displays the result of each resource", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Bootstrapping org some-org from tenant\.yml as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`resource\s+name\s+result`))
+		Expect(testUI.Out).To(Say(`org\s+some-org\s+created`))
+		Expect(testUI.Out).To(Say(`space\s+dev\s+unchanged`))
+		Expect(testUI.Err).To(Say("bootstrap-warning"))
+
+		Expect(fakeActor.ReadOrganizationTemplateArgsForCall(0)).To(Equal("tenant.yml"))
+		Expect(fakeActor.BootstrapOrganizationArgsForCall(0)).To(Equal(v2action.OrganizationTemplate{Name: "some-org"}))
+		Expect(fakeActorV3.GetIsolationSegmentByNameCallCount()).To(Equal(0))
+	})
+
+	Context("when bootstrapping fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("create-space-failed")
+			fakeActor.BootstrapOrganizationReturns(
+				v2action.Organization{},
+				[]v2action.BootstrapResult{
+					{Type: v2action.BootstrapResourceOrganization, Name: "some-org", Action: v2action.BootstrapCreated},
+				},
+				v2action.Warnings{"bootstrap-warning"}, expectedErr)
+		})
+
+		It("displays the resources converged so far and returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(testUI.Out).To(Say(`org\s+some-org\s+created`))
+			Expect(testUI.Err).To(Say("bootstrap-warning"))
+		})
+	})
+
+	Context("when the template has a default isolation segment", func() {
+		BeforeEach(func() {
+			fakeActor.ReadOrganizationTemplateReturns(v2action.OrganizationTemplate{
+				Name:                    "some-org",
+				DefaultIsolationSegment: "some-segment",
+			}, nil)
+			fakeActorV3.GetIsolationSegmentByNameReturns(
+				v3action.IsolationSegment{GUID: "some-segment-guid", Name: "some-segment"},
+				v3action.Warnings{"get-segment-warning"}, nil)
+		})
+
+		Context("when the org is not entitled to the isolation segment", func() {
+			BeforeEach(func() {
+				fakeActorV3.GetIsolationSegmentsByOrganizationReturns(nil, v3action.Warnings{"entitled-warning"}, nil)
+				fakeActorV3.EntitleIsolationSegmentToOrganizationByNameReturns(v3action.Warnings{"entitle-warning"}, nil)
+				fakeActorV3.SetOrganizationDefaultIsolationSegmentReturns(v3action.Warnings{"set-default-warning"}, nil)
+			})
+
+			It("entitles the org and sets the default isolation segment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`isolation segment\s+some-segment\s+created`))
+				Expect(testUI.Err).To(Say("get-segment-warning"))
+				Expect(testUI.Err).To(Say("entitled-warning"))
+				Expect(testUI.Err).To(Say("entitle-warning"))
+				Expect(testUI.Err).To(Say("set-default-warning"))
+
+				segmentName, orgName := fakeActorV3.EntitleIsolationSegmentToOrganizationByNameArgsForCall(0)
+				Expect(segmentName).To(Equal("some-segment"))
+				Expect(orgName).To(Equal("some-org"))
+				orgGUID, segmentGUID := fakeActorV3.SetOrganizationDefaultIsolationSegmentArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(segmentGUID).To(Equal("some-segment-guid"))
+			})
+		})
+
+		Context("when the isolation segment already is the default", func() {
+			BeforeEach(func() {
+				fakeActor.BootstrapOrganizationReturns(
+					v2action.Organization{GUID: "some-org-guid", Name: "some-org", DefaultIsolationSegmentGUID: "some-segment-guid"},
+					nil, nil, nil)
+			})
+
+			It("leaves it unchanged", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`isolation segment\s+some-segment\s+unchanged`))
+				Expect(fakeActorV3.EntitleIsolationSegmentToOrganizationByNameCallCount()).To(Equal(0))
+				Expect(fakeActorV3.SetOrganizationDefaultIsolationSegmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the isolation segment does not exist", func() {
+			BeforeEach(func() {
+				fakeActorV3.GetIsolationSegmentByNameReturns(
+					v3action.IsolationSegment{},
+					v3action.Warnings{"get-segment-warning"},
+					actionerror.IsolationSegmentNotFoundError{Name: "some-segment"})
+			})
+
+			It("returns the error before changing anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.IsolationSegmentNotFoundError{Name: "some-segment"}))
+				Expect(testUI.Err).To(Say("get-segment-warning"))
+				Expect(fakeActor.BootstrapOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the V3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("returns a V3APIDoesNotExistError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.V3APIDoesNotExistError{}))
+				Expect(fakeActor.BootstrapOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
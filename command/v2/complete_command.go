@@ -0,0 +1,152 @@
+package v2
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/completion"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// completionCacheTTL is how long resource names are reused for completion
+// before the API is queried again.
+const completionCacheTTL = 30 * time.Second
+
+//go:generate counterfeiter . CompleteActor
+
+type CompleteActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . CompletionCache
+
+type CompletionCache interface {
+	Get(key string) ([]string, bool)
+	Set(key string, values []string) error
+}
+
+// CompleteCommand prints the names of resources in the current target for
+// the generated shell completion scripts. It never fails: when the names
+// cannot be listed nothing is printed.
+type CompleteCommand struct {
+	RequiredArgs flag.CompleteResourceArg `positional-args:"yes"`
+	usage        interface{}              `usage:"CF_NAME __complete RESOURCE"`
+
+	UI     command.UI
+	Config command.Config
+	Cache  CompletionCache
+
+	// NewActor creates the actor, which connects to the API, only when the
+	// names are not cached.
+	NewActor func() (CompleteActor, error)
+}
+
+func (cmd *CompleteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Cache = completion.NewCache(filepath.Join(filepath.Dir(configv3.ConfigFilePath()), "completion_cache.json"), completionCacheTTL)
+	cmd.NewActor = func() (CompleteActor, error) {
+		ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+		if err != nil {
+			return nil, err
+		}
+		return v2action.NewActor(ccClient, uaaClient, config), nil
+	}
+
+	return nil
+}
+
+func (cmd CompleteCommand) Execute(args []string) error {
+	resource := sharedaction.CompletionResource(cmd.RequiredArgs.Resource)
+	if cmd.Config.Target() == "" || cmd.Config.AccessToken() == "" {
+		return nil
+	}
+
+	var scopeGUID string
+	switch resource {
+	case sharedaction.AppCompletion, sharedaction.ServiceInstanceCompletion:
+		scopeGUID = cmd.Config.TargetedSpace().GUID
+	case sharedaction.SpaceCompletion:
+		scopeGUID = cmd.Config.TargetedOrganization().GUID
+	case sharedaction.OrgCompletion:
+		scopeGUID = "-"
+	default:
+		return nil
+	}
+	if scopeGUID == "" {
+		return nil
+	}
+
+	key := strings.Join([]string{cmd.Config.Target(), string(resource), scopeGUID}, " ")
+	names, cached := cmd.Cache.Get(key)
+	if !cached {
+		var err error
+		names, err = cmd.listNames(resource, scopeGUID)
+		if err != nil {
+			return nil
+		}
+		_ = cmd.Cache.Set(key, names)
+	}
+
+	for _, name := range names {
+		fmt.Fprintln(cmd.UI.Writer(), name)
+	}
+	return nil
+}
+
+func (cmd CompleteCommand) listNames(resource sharedaction.CompletionResource, scopeGUID string) ([]string, error) {
+	actor, err := cmd.NewActor()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	switch resource {
+	case sharedaction.AppCompletion:
+		apps, _, err := actor.GetApplicationsBySpace(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+	case sharedaction.ServiceInstanceCompletion:
+		instances, _, err := actor.GetServiceInstancesBySpace(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			names = append(names, instance.Name)
+		}
+	case sharedaction.OrgCompletion:
+		orgs, _, err := actor.GetOrganizations()
+		if err != nil {
+			return nil, err
+		}
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+	case sharedaction.SpaceCompletion:
+		spaces, _, err := actor.GetOrganizationSpaces(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, space := range spaces {
+			names = append(names, space.Name)
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
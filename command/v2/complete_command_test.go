@@ -0,0 +1,184 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("__complete Command", func() {
+	var (
+		cmd            CompleteCommand
+		testUI         *ui.UI
+		fakeConfig     *commandfakes.FakeConfig
+		fakeActor      *v2fakes.FakeCompleteActor
+		fakeCache      *v2fakes.FakeCompletionCache
+		newActorErr    error
+		newActorCalled int
+		executeErr     error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeCompleteActor)
+		fakeCache = new(v2fakes.FakeCompletionCache)
+		newActorErr = nil
+		newActorCalled = 0
+
+		cmd = CompleteCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Cache:  fakeCache,
+			NewActor: func() (CompleteActor, error) {
+				newActorCalled++
+				return fakeActor, newActorErr
+			},
+		}
+
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.AccessTokenReturns("some-token")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when completing app names", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Resource = "app"
+			fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{{Name: "some-app"}, {Name: "another-app"}}, v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("prints the sorted names of apps in the targeted space and caches them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("another-app\nsome-app\n"))
+			Expect(testUI.Err).ToNot(Say("some-warning"))
+
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(fakeCache.GetArgsForCall(0)).To(Equal("https://api.example.com app some-space-guid"))
+			Expect(fakeCache.SetCallCount()).To(Equal(1))
+			key, values := fakeCache.SetArgsForCall(0)
+			Expect(key).To(Equal("https://api.example.com app some-space-guid"))
+			Expect(values).To(Equal([]string{"another-app", "some-app"}))
+		})
+
+		Context("when the names are cached", func() {
+			BeforeEach(func() {
+				fakeCache.GetReturns([]string{"cached-app"}, true)
+			})
+
+			It("prints the cached names without connecting to the API", func() {
+				Expect(testUI.Out).To(Say("cached-app\n"))
+				Expect(newActorCalled).To(Equal(0))
+				Expect(fakeCache.SetCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{})
+			})
+
+			It("prints nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("."))
+				Expect(newActorCalled).To(Equal(0))
+			})
+		})
+
+		Context("when listing the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(nil, nil, errors.New("some-error"))
+			})
+
+			It("prints nothing and does not fail", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("."))
+				Expect(fakeCache.SetCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when connecting to the API fails", func() {
+			BeforeEach(func() {
+				newActorErr = errors.New("some-error")
+			})
+
+			It("prints nothing and does not fail", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("."))
+			})
+		})
+	})
+
+	Context("when completing service instance names", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Resource = "service"
+			fakeActor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{{Name: "some-service"}}, nil, nil)
+		})
+
+		It("prints the names of service instances in the targeted space", func() {
+			Expect(testUI.Out).To(Say("some-service\n"))
+			Expect(fakeActor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when completing org names", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Resource = "org"
+			fakeActor.GetOrganizationsReturns([]v2action.Organization{{Name: "some-org"}}, nil, nil)
+		})
+
+		It("prints the names of all orgs", func() {
+			Expect(testUI.Out).To(Say("some-org\n"))
+			Expect(fakeCache.GetArgsForCall(0)).To(Equal("https://api.example.com org -"))
+		})
+	})
+
+	Context("when completing space names", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Resource = "space"
+			fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{Name: "some-space"}}, nil, nil)
+		})
+
+		It("prints the names of spaces in the targeted org", func() {
+			Expect(testUI.Out).To(Say("some-space\n"))
+			Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+	})
+
+	Context("when the resource is unknown", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Resource = "banana"
+		})
+
+		It("prints nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("."))
+			Expect(fakeCache.GetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Resource = "org"
+			fakeConfig.AccessTokenReturns("")
+		})
+
+		It("prints nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(newActorCalled).To(Equal(0))
+		})
+	})
+})
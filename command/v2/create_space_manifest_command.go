@@ -0,0 +1,124 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+//go:generate counterfeiter . CreateSpaceManifestActor
+
+type CreateSpaceManifestActor interface {
+	CreateSpaceManifest(spaceGUID string, processesByApp map[string][]manifestparser.Process, pathToManifest string, pathToVarsFile string) (v2action.SpaceManifest, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . CreateSpaceManifestActorV3
+
+type CreateSpaceManifestActorV3 interface {
+	GetManifestProcessesBySpace(spaceGUID string) (map[string][]manifestparser.Process, v3action.Warnings, error)
+}
+
+type CreateSpaceManifestCommand struct {
+	FilePath        flag.Path   `short:"p" description:"Specify a path for the manifest. If path not specified, the manifest is created in the current working directory."`
+	VarsFilePath    flag.Path   `long:"vars-file" description:"Specify a path for the vars file holding the values of secrets. If path not specified, the vars file is created in the current working directory."`
+	usage           interface{} `usage:"CF_NAME create-space-manifest [-p /path/to/<space-name>_manifest.yml] [--vars-file /path/to/<space-name>_vars.yml]\n\n   Creates a manifest for all the apps in the targeted space. Environment variables that look\n   like secrets are replaced by ((variables)) whose values are written to a separate vars file,\n   so that the apps can be pushed elsewhere with:\n\n   CF_NAME push -f <space-name>_manifest.yml --vars-file <space-name>_vars.yml"`
+	relatedCommands interface{} `related_commands:"create-app-manifest, push, validate-manifest"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSpaceManifestActor
+	ActorV3     CreateSpaceManifestActorV3
+}
+
+func (cmd *CreateSpaceManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	return nil
+}
+
+func (cmd CreateSpaceManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	spaceName := cmd.Config.TargetedSpace().Name
+	cmd.UI.DisplayTextWithFlavor("Creating a manifest from current settings of all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": spaceName,
+		"Username":  user.Name,
+	})
+
+	manifestPath := cmd.FilePath.String()
+	if manifestPath == "" {
+		manifestPath = fmt.Sprintf(".%s%s_manifest.yml", string(os.PathSeparator), spaceName)
+	}
+	varsFilePath := cmd.VarsFilePath.String()
+	if varsFilePath == "" {
+		varsFilePath = fmt.Sprintf(".%s%s_vars.yml", string(os.PathSeparator), spaceName)
+	}
+
+	// Process types are only exported when the V3 API is available.
+	var processesByApp map[string][]manifestparser.Process
+	if cmd.ActorV3 != nil {
+		var v3Warnings v3action.Warnings
+		processesByApp, v3Warnings, err = cmd.ActorV3.GetManifestProcessesBySpace(cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	spaceManifest, warnings, err := cmd.Actor.CreateSpaceManifest(cmd.Config.TargetedSpace().GUID, processesByApp, manifestPath, varsFilePath)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Manifest file for {{.AppCount}} app(s) created successfully at {{.FilePath}}", map[string]interface{}{
+		"AppCount": len(spaceManifest.Applications),
+		"FilePath": manifestPath,
+	})
+
+	if len(spaceManifest.Vars) > 0 {
+		cmd.UI.DisplayText("{{.VarCount}} environment variable(s) that look like secrets were replaced by ((variables)). Their values were written to {{.VarsFilePath}}, which should be kept out of version control.", map[string]interface{}{
+			"VarCount":     len(spaceManifest.Vars),
+			"VarsFilePath": varsFilePath,
+		})
+	}
+
+	return nil
+}
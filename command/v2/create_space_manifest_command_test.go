@@ -0,0 +1,193 @@
+package v2_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-space-manifest Command", func() {
+	var (
+		cmd             CreateSpaceManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSpaceManifestActor
+		fakeActorV3     *v2fakes.FakeCreateSpaceManifestActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSpaceManifestActor)
+		fakeActorV3 = new(v2fakes.FakeCreateSpaceManifestActorV3)
+
+		cmd = CreateSpaceManifestCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when creating the manifest succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.CreateSpaceManifestReturns(
+					v2action.SpaceManifest{
+						Applications: []manifest.Application{{Name: "app-1"}, {Name: "app-2"}},
+						Vars:         map[string]string{"app-1_API_TOKEN": "some-token"},
+					},
+					v2action.Warnings{"some-warning"},
+					nil)
+			})
+
+			It("writes the manifest and vars file in the current directory by default", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.CreateSpaceManifestCallCount()).To(Equal(1))
+				spaceGUID, _, manifestPath, varsFilePath := fakeActor.CreateSpaceManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(manifestPath).To(Equal(fmt.Sprintf(".%ssome-space_manifest.yml", string(os.PathSeparator))))
+				Expect(varsFilePath).To(Equal(fmt.Sprintf(".%ssome-space_vars.yml", string(os.PathSeparator))))
+
+				Expect(testUI.Out).To(Say("Creating a manifest from current settings of all apps in org some-org / space some-space as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Manifest file for 2 app\\(s\\) created successfully at"))
+				Expect(testUI.Out).To(Say("1 environment variable\\(s\\) that look like secrets were replaced by \\(\\(variables\\)\\)\\. Their values were written to .*some-space_vars\\.yml"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+
+			Context("when paths are provided", func() {
+				BeforeEach(func() {
+					cmd.FilePath = flag.Path("some-manifest.yml")
+					cmd.VarsFilePath = flag.Path("some-vars.yml")
+				})
+
+				It("writes the files to the provided paths", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, _, manifestPath, varsFilePath := fakeActor.CreateSpaceManifestArgsForCall(0)
+					Expect(manifestPath).To(Equal("some-manifest.yml"))
+					Expect(varsFilePath).To(Equal("some-vars.yml"))
+				})
+			})
+
+			Context("when the V3 API is available", func() {
+				var processesByApp map[string][]manifestparser.Process
+
+				BeforeEach(func() {
+					processesByApp = map[string][]manifestparser.Process{
+						"app-1-guid": {{Type: "web", Command: "some-command"}},
+					}
+					fakeActorV3.GetManifestProcessesBySpaceReturns(processesByApp, v3action.Warnings{"some-v3-warning"}, nil)
+				})
+
+				It("passes the processes of each app to the manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActorV3.GetManifestProcessesBySpaceCallCount()).To(Equal(1))
+					Expect(fakeActorV3.GetManifestProcessesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+					_, passedProcesses, _, _ := fakeActor.CreateSpaceManifestArgsForCall(0)
+					Expect(passedProcesses).To(Equal(processesByApp))
+					Expect(testUI.Err).To(Say("some-v3-warning"))
+				})
+
+				Context("when getting the processes fails", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetManifestProcessesBySpaceReturns(nil, v3action.Warnings{"some-v3-warning"}, errors.New("some-process-error"))
+					})
+
+					It("returns the error and displays warnings", func() {
+						Expect(executeErr).To(MatchError("some-process-error"))
+						Expect(testUI.Err).To(Say("some-v3-warning"))
+						Expect(fakeActor.CreateSpaceManifestCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the V3 API is not available", func() {
+				BeforeEach(func() {
+					cmd.ActorV3 = nil
+				})
+
+				It("creates the manifest without processes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, passedProcesses, _, _ := fakeActor.CreateSpaceManifestArgsForCall(0)
+					Expect(passedProcesses).To(BeNil())
+				})
+			})
+
+			Context("when no environment variables were replaced", func() {
+				BeforeEach(func() {
+					fakeActor.CreateSpaceManifestReturns(v2action.SpaceManifest{Applications: []manifest.Application{{Name: "app-1"}}}, nil, nil)
+				})
+
+				It("does not mention the vars file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("variable"))
+				})
+			})
+		})
+
+		Context("when creating the manifest fails", func() {
+			BeforeEach(func() {
+				fakeActor.CreateSpaceManifestReturns(v2action.SpaceManifest{}, v2action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+})
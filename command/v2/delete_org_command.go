@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -13,17 +14,22 @@ import (
 
 type DeleteOrganizationActor interface {
 	DeleteOrganization(orgName string) (v2action.Warnings, error)
+	ExecuteTeardownStep(step v2action.TeardownStep) (v2action.Warnings, error)
+	GetOrganizationInventory(orgName string) (v2action.OrganizationInventory, v2action.Warnings, error)
 }
 
 type DeleteOrgCommand struct {
 	RequiredArgs flag.Organization `positional-args:"yes"`
 	Force        bool              `short:"f" description:"Force deletion without confirmation"`
-	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f]"`
-
-	Config      command.Config
-	UI          command.UI
-	SharedActor command.SharedActor
-	Actor       DeleteOrganizationActor
+	Preview      bool              `long:"preview" description:"List the resources that would be deleted without deleting them"`
+	Ordered      bool              `long:"ordered" description:"Delete the resources of the org one at a time, service bindings and service instances first, reporting the outcome of each deletion. Run again to resume an incomplete deletion"`
+	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f] [--preview | --ordered]"`
+
+	Config          command.Config
+	UI              command.UI
+	SharedActor     command.SharedActor
+	Actor           DeleteOrganizationActor
+	NetworkingActor shared.TeardownNetworkingActor
 }
 
 func (cmd *DeleteOrgCommand) Setup(config command.Config, ui command.UI) error {
@@ -37,10 +43,23 @@ func (cmd *DeleteOrgCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	if cmd.Preview || cmd.Ordered {
+		cmd.NetworkingActor, err = shared.NewTeardownNetworkingActor(config, ui)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (cmd *DeleteOrgCommand) Execute(args []string) error {
+	if cmd.Preview && cmd.Ordered {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--preview", "--ordered"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -51,6 +70,10 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Preview {
+		return cmd.displayPreview(user.Name)
+	}
+
 	if !cmd.Force {
 		promptMessage := "Really delete the org {{.OrgName}}, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers?"
 		deleteOrg, promptErr := cmd.UI.DisplayBoolPrompt(false, promptMessage, map[string]interface{}{"OrgName": cmd.RequiredArgs.Organization})
@@ -70,8 +93,11 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		"Username": user.Name,
 	})
 
-	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
-	cmd.UI.DisplayWarnings(warnings)
+	if cmd.Ordered {
+		err = cmd.teardownOrg()
+	} else {
+		err = cmd.deleteOrg()
+	}
 	if err != nil {
 		switch err.(type) {
 		case actionerror.OrganizationNotFoundError:
@@ -91,3 +117,63 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd DeleteOrgCommand) deleteOrg() error {
+	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+func (cmd DeleteOrgCommand) teardownOrg() error {
+	inventory, spaces, err := cmd.getOrgTeardown()
+	if err != nil {
+		return err
+	}
+
+	return shared.TeardownSpaces(cmd.UI, cmd.Actor, cmd.NetworkingActor, spaces, v2action.TeardownStep{
+		Type: v2action.TeardownOrganization,
+		GUID: inventory.GUID,
+		Name: inventory.Name,
+	})
+}
+
+func (cmd DeleteOrgCommand) displayPreview(userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Getting resources of org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.Organization,
+		"Username": userName,
+	})
+
+	_, spaces, err := cmd.getOrgTeardown()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("The following resources would be deleted:")
+	for _, space := range spaces {
+		cmd.UI.DisplayNewline()
+		shared.DisplaySpaceTeardown(cmd.UI, space, cmd.NetworkingActor != nil)
+	}
+
+	return nil
+}
+
+func (cmd DeleteOrgCommand) getOrgTeardown() (v2action.OrganizationInventory, []shared.SpaceTeardown, error) {
+	inventory, warnings, err := cmd.Actor.GetOrganizationInventory(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.OrganizationInventory{}, nil, err
+	}
+
+	var spaces []shared.SpaceTeardown
+	for _, spaceInventory := range inventory.Spaces {
+		space, err := shared.GetSpaceTeardown(cmd.UI, cmd.NetworkingActor, spaceInventory)
+		if err != nil {
+			return v2action.OrganizationInventory{}, nil, err
+		}
+		spaces = append(spaces, space)
+	}
+
+	return inventory, spaces, nil
+}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -264,6 +265,101 @@ var _ = Describe("delete-org Command", func() {
 						Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(0))
 					})
 				})
+
+				Context("when the --preview flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Preview = true
+						fakeActor.GetOrganizationInventoryReturns(v2action.OrganizationInventory{
+							Organization: v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+							Spaces: []v2action.SpaceInventory{
+								{Space: v2action.Space{Name: "space-1"}, Applications: []v2action.Application{{Name: "app-1"}}},
+								{Space: v2action.Space{Name: "space-2"}},
+							},
+						}, v2action.Warnings{"inventory-warning"}, nil)
+					})
+
+					It("lists the resources of every space without deleting anything", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Getting resources of org some-org as some-user\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("The following resources would be deleted:"))
+						Expect(testUI.Out).To(Say("space:\\s+space-1"))
+						Expect(testUI.Out).To(Say("apps:\\s+app-1"))
+						Expect(testUI.Out).To(Say("space:\\s+space-2"))
+						Expect(testUI.Err).To(Say("inventory-warning"))
+
+						Expect(fakeActor.GetOrganizationInventoryArgsForCall(0)).To(Equal("some-org"))
+						Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+						Expect(fakeActor.ExecuteTeardownStepCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the --ordered flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Force = true
+						cmd.Ordered = true
+						fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+						fakeActor.GetOrganizationInventoryReturns(v2action.OrganizationInventory{
+							Organization: v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+							Spaces: []v2action.SpaceInventory{
+								{Space: v2action.Space{GUID: "space-1-guid", Name: "space-1"}},
+								{Space: v2action.Space{GUID: "space-2-guid", Name: "space-2"}},
+							},
+						}, nil, nil)
+					})
+
+					Context("when every deletion succeeds", func() {
+						It("deletes the spaces and then the org", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Deleting org some-org as some-user\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Deleting space space-1\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Deleting space space-2\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Deleting org some-org\\.\\.\\."))
+							Expect(testUI.Out).To(Say("OK"))
+
+							Expect(fakeActor.ExecuteTeardownStepCallCount()).To(Equal(3))
+							Expect(fakeActor.ExecuteTeardownStepArgsForCall(2)).To(Equal(v2action.TeardownStep{
+								Type: v2action.TeardownOrganization,
+								GUID: "some-org-guid",
+								Name: "some-org",
+							}))
+							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when a space cannot be deleted", func() {
+						BeforeEach(func() {
+							fakeActor.ExecuteTeardownStepReturnsOnCall(0, nil, errors.New("space-delete-failed"))
+						})
+
+						It("deletes the other spaces, keeps the org and returns a TeardownIncompleteError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.TeardownIncompleteError{Failures: 1}))
+
+							Expect(testUI.Err).To(Say("FAILED: space-delete-failed"))
+							Expect(testUI.Out).To(Say("Deleting space space-2\\.\\.\\."))
+							Expect(testUI.Err).To(Say("Skipping deletion of org some-org because some of its resources could not be deleted\\."))
+
+							Expect(fakeActor.ExecuteTeardownStepCallCount()).To(Equal(2))
+							Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when both --preview and --ordered are provided", func() {
+					BeforeEach(func() {
+						cmd.Preview = true
+						cmd.Ordered = true
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+							Args: []string{"--preview", "--ordered"},
+						}))
+					})
+				})
 			})
 		})
 	})
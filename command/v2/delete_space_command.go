@@ -5,6 +5,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -12,18 +13,23 @@ import (
 
 type DeleteSpaceActor interface {
 	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Warnings, error)
+	ExecuteTeardownStep(step v2action.TeardownStep) (v2action.Warnings, error)
+	GetSpaceInventory(spaceName string, orgName string) (v2action.SpaceInventory, v2action.Warnings, error)
 }
 
 type DeleteSpaceCommand struct {
 	RequiredArgs flag.Space  `positional-args:"yes"`
 	Force        bool        `short:"f" description:"Force deletion without confirmation"`
 	Org          string      `short:"o" description:"Delete space within specified org"`
-	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f]"`
-
-	Config      command.Config
-	UI          command.UI
-	SharedActor command.SharedActor
-	Actor       DeleteSpaceActor
+	Preview      bool        `long:"preview" description:"List the resources that would be deleted without deleting them"`
+	Ordered      bool        `long:"ordered" description:"Delete the resources of the space one at a time, service bindings and service instances first, reporting the outcome of each deletion. Run again to resume an incomplete deletion"`
+	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f] [--preview | --ordered]"`
+
+	Config          command.Config
+	UI              command.UI
+	SharedActor     command.SharedActor
+	Actor           DeleteSpaceActor
+	NetworkingActor shared.TeardownNetworkingActor
 }
 
 func (cmd *DeleteSpaceCommand) Setup(config command.Config, ui command.UI) error {
@@ -37,10 +43,23 @@ func (cmd *DeleteSpaceCommand) Setup(config command.Config, ui command.UI) error
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	if cmd.Preview || cmd.Ordered {
+		cmd.NetworkingActor, err = shared.NewTeardownNetworkingActor(config, ui)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (cmd DeleteSpaceCommand) Execute(args []string) error {
+	if cmd.Preview && cmd.Ordered {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--preview", "--ordered"},
+		}
+	}
+
 	var (
 		err     error
 		orgName string
@@ -63,6 +82,10 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Preview {
+		return cmd.displayPreview(orgName, user.Name)
+	}
+
 	if !cmd.Force {
 		promptMessage := "Really delete the space {{.SpaceName}}?"
 		deleteSpace, promptErr := cmd.UI.DisplayBoolPrompt(false, promptMessage, map[string]interface{}{"SpaceName": cmd.RequiredArgs.Space})
@@ -84,14 +107,15 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 			"CurrentUser": user.Name,
 		})
 
-	warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
-	cmd.UI.DisplayWarnings(warnings)
+	if cmd.Ordered {
+		err = cmd.teardownSpace(orgName)
+	} else {
+		err = cmd.deleteSpace(orgName)
+	}
 	if err != nil {
 		return err
 	}
 
-	cmd.UI.DisplayOK()
-
 	if cmd.Config.TargetedOrganization().Name == orgName &&
 		cmd.Config.TargetedSpace().Name == cmd.RequiredArgs.Space {
 		cmd.Config.UnsetSpaceInformation()
@@ -101,3 +125,55 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd DeleteSpaceCommand) deleteSpace(orgName string) error {
+	warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd DeleteSpaceCommand) teardownSpace(orgName string) error {
+	teardown, err := cmd.getSpaceTeardown(orgName)
+	if err != nil {
+		return err
+	}
+
+	return shared.TeardownSpaces(cmd.UI, cmd.Actor, cmd.NetworkingActor, []shared.SpaceTeardown{teardown})
+}
+
+func (cmd DeleteSpaceCommand) displayPreview(orgName string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Getting resources of space {{.TargetSpace}} in org {{.TargetOrg}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"TargetSpace": cmd.RequiredArgs.Space,
+			"TargetOrg":   orgName,
+			"CurrentUser": userName,
+		})
+
+	teardown, err := cmd.getSpaceTeardown(orgName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("The following resources would be deleted:")
+	cmd.UI.DisplayNewline()
+	shared.DisplaySpaceTeardown(cmd.UI, teardown, cmd.NetworkingActor != nil)
+
+	return nil
+}
+
+func (cmd DeleteSpaceCommand) getSpaceTeardown(orgName string) (shared.SpaceTeardown, error) {
+	inventory, warnings, err := cmd.Actor.GetSpaceInventory(cmd.RequiredArgs.Space, orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.SpaceTeardown{}, err
+	}
+
+	return shared.GetSpaceTeardown(cmd.UI, cmd.NetworkingActor, inventory)
+}
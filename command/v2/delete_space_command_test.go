@@ -4,9 +4,12 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -292,6 +295,167 @@ var _ = Describe("delete-space Command", func() {
 					})
 				})
 			})
+
+			Context("when the --preview flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Org = "some-org"
+					cmd.Preview = true
+					fakeActor.GetSpaceInventoryReturns(v2action.SpaceInventory{
+						Space:        v2action.Space{GUID: "some-space-guid", Name: "some-space"},
+						Applications: []v2action.Application{{Name: "app-1"}, {Name: "app-2"}},
+						ServiceInstances: []v2action.ServiceInstanceInventory{
+							{
+								ServiceInstance: v2action.ServiceInstance{Name: "instance-1"},
+								Bindings: []v2action.ServiceBindingInventory{
+									{ApplicationName: "app-1"},
+								},
+								Keys: []v2action.ServiceKey{{Name: "key-1"}},
+							},
+						},
+					}, v2action.Warnings{"inventory-warning"}, nil)
+				})
+
+				Context("when the networking API is available", func() {
+					var fakeNetworkingActor *sharedfakes.FakeTeardownNetworkingActor
+
+					BeforeEach(func() {
+						fakeNetworkingActor = new(sharedfakes.FakeTeardownNetworkingActor)
+						fakeNetworkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+							{SourceName: "app-1", DestinationName: "app-2", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+						}, cfnetworkingaction.Warnings{"policies-warning"}, nil)
+						cmd.NetworkingActor = fakeNetworkingActor
+					})
+
+					It("lists the resources of the space without deleting anything", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Getting resources of space some-space in org some-org as some-user\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("The following resources would be deleted:"))
+						Expect(testUI.Out).To(Say("space:\\s+some-space"))
+						Expect(testUI.Out).To(Say("apps:\\s+app-1, app-2"))
+						Expect(testUI.Out).To(Say("service instances:\\s+instance-1 \\(bound to app-1; keys key-1\\)"))
+						Expect(testUI.Out).To(Say("network policies:\\s+app-1 -> app-2 \\(tcp 8080\\)"))
+
+						Expect(testUI.Err).To(Say("inventory-warning"))
+						Expect(testUI.Err).To(Say("policies-warning"))
+
+						spaceName, orgName := fakeActor.GetSpaceInventoryArgsForCall(0)
+						Expect(spaceName).To(Equal("some-space"))
+						Expect(orgName).To(Equal("some-org"))
+						Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+						Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+						Expect(fakeActor.ExecuteTeardownStepCallCount()).To(Equal(0))
+						Expect(fakeNetworkingActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the networking API is not available", func() {
+					It("reports the network policies as unavailable", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("network policies:\\s+unavailable"))
+					})
+				})
+
+				Context("when getting the inventory fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = actionerror.SpaceNotFoundError{Name: "some-space"}
+						fakeActor.GetSpaceInventoryReturns(v2action.SpaceInventory{}, v2action.Warnings{"inventory-warning"}, expectedErr)
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("inventory-warning"))
+					})
+				})
+			})
+
+			Context("when the --ordered flag is provided", func() {
+				var fakeNetworkingActor *sharedfakes.FakeTeardownNetworkingActor
+
+				BeforeEach(func() {
+					cmd.Org = "some-org"
+					cmd.Force = true
+					cmd.Ordered = true
+					fakeActor.GetSpaceInventoryReturns(v2action.SpaceInventory{
+						Space:        v2action.Space{GUID: "some-space-guid", Name: "some-space"},
+						Applications: []v2action.Application{{GUID: "app-guid", Name: "app-1"}},
+					}, nil, nil)
+
+					fakeNetworkingActor = new(sharedfakes.FakeTeardownNetworkingActor)
+					fakeNetworkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+						{SourceName: "app-1", DestinationName: "app-1", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+					}, nil, nil)
+					cmd.NetworkingActor = fakeNetworkingActor
+				})
+
+				Context("when every deletion succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.ExecuteTeardownStepReturns(v2action.Warnings{"teardown-warning"}, nil)
+					})
+
+					It("deletes the resources of the space one at a time", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Deleting space some-space in org some-org as some-user\\.\\.\\."))
+						Expect(testUI.Out).To(Say("Deleting network policy app-1 -> app-1 \\(tcp 8080-8090\\)\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Deleting app app-1\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Deleting space some-space\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("teardown-warning"))
+
+						Expect(fakeNetworkingActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
+						spaceGUID, srcApp, destApp, protocol, startPort, endPort := fakeNetworkingActor.RemoveNetworkPolicyArgsForCall(0)
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(srcApp).To(Equal("app-1"))
+						Expect(destApp).To(Equal("app-1"))
+						Expect(protocol).To(Equal("tcp"))
+						Expect(startPort).To(Equal(8080))
+						Expect(endPort).To(Equal(8090))
+
+						Expect(fakeActor.ExecuteTeardownStepCallCount()).To(Equal(2))
+						Expect(fakeActor.ExecuteTeardownStepArgsForCall(0)).To(Equal(v2action.TeardownStep{Type: v2action.TeardownApplication, GUID: "app-guid", Name: "app-1"}))
+						Expect(fakeActor.ExecuteTeardownStepArgsForCall(1)).To(Equal(v2action.TeardownStep{Type: v2action.TeardownSpace, GUID: "some-space-guid", Name: "some-space"}))
+						Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when a deletion fails", func() {
+					BeforeEach(func() {
+						fakeActor.ExecuteTeardownStepReturns(nil, errors.New("app-delete-failed"))
+					})
+
+					It("reports the failure, keeps the space and returns a TeardownIncompleteError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.TeardownIncompleteError{Failures: 1}))
+
+						Expect(testUI.Out).To(Say("Deleting app app-1\\.\\.\\."))
+						Expect(testUI.Err).To(Say("FAILED: app-delete-failed"))
+						Expect(testUI.Err).To(Say("Skipping deletion of space some-space because some of its resources could not be deleted\\."))
+
+						Expect(fakeActor.ExecuteTeardownStepCallCount()).To(Equal(1))
+						Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when both --preview and --ordered are provided", func() {
+				BeforeEach(func() {
+					cmd.Preview = true
+					cmd.Ordered = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--preview", "--ordered"},
+					}))
+					Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,127 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("disable-service-access Command", func() {
+	var (
+		cmd             DisableServiceAccessCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDisableServiceAccessActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDisableServiceAccessActor)
+
+		cmd = DisableServiceAccessCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Service = "some-service"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.DisableServiceAccessReturns(v2action.Warnings{"warning-1"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.DisableServiceAccessCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when only the service is provided", func() {
+		It("disables access to all plans for all orgs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Disabling access to all plans of service some-service for all orgs as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			serviceName, planName, orgName := fakeActor.DisableServiceAccessArgsForCall(0)
+			Expect(serviceName).To(Equal("some-service"))
+			Expect(planName).To(BeEmpty())
+			Expect(orgName).To(BeEmpty())
+		})
+	})
+
+	Context("when a plan is provided", func() {
+		BeforeEach(func() {
+			cmd.ServicePlan = "some-plan"
+		})
+
+		It("disables access to the plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Disabling access of plan some-plan for service some-service as some-user\\.\\.\\."))
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "some-org"
+		})
+
+		It("disables access to all plans for the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Disabling access to all plans of service some-service for the org some-org as some-user\\.\\.\\."))
+		})
+	})
+
+	Context("when a plan and an org are provided", func() {
+		BeforeEach(func() {
+			cmd.ServicePlan = "some-plan"
+			cmd.Organization = "some-org"
+		})
+
+		It("disables access to the plan for the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Disabling access to plan some-plan of service some-service for org some-org as some-user\\.\\.\\."))
+
+			serviceName, planName, orgName := fakeActor.DisableServiceAccessArgsForCall(0)
+			Expect(serviceName).To(Equal("some-service"))
+			Expect(planName).To(Equal("some-plan"))
+			Expect(orgName).To(Equal("some-org"))
+		})
+	})
+
+	Context("when the actor returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.DisableServiceAccessReturns(v2action.Warnings{"warning-1"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
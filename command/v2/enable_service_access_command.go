@@ -1,23 +1,81 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . EnableServiceAccessActor
+
+type EnableServiceAccessActor interface {
+	EnableServiceAccess(serviceName string, servicePlanName string, orgName string) (v2action.Warnings, error)
+}
+
 type EnableServiceAccessCommand struct {
 	RequiredArgs    flag.Service `positional-args:"yes"`
 	Organization    string       `short:"o" description:"Enable access for a specified organization"`
 	ServicePlan     string       `short:"p" description:"Enable access to a specified service plan"`
 	usage           interface{}  `usage:"CF_NAME enable-service-access SERVICE [-p PLAN] [-o ORG]"`
 	relatedCommands interface{}  `related_commands:"marketplace, service-access, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnableServiceAccessActor
 }
 
-func (EnableServiceAccessCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *EnableServiceAccessCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (EnableServiceAccessCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd EnableServiceAccessCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.Service,
+		"PlanName":    cmd.ServicePlan,
+		"OrgName":     cmd.Organization,
+		"Username":    user.Name,
+	}
+	switch {
+	case cmd.ServicePlan != "" && cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Enabling access to plan {{.PlanName}} of service {{.ServiceName}} for org {{.OrgName}} as {{.Username}}...", templateValues)
+	case cmd.ServicePlan != "":
+		cmd.UI.DisplayTextWithFlavor("Enabling access of plan {{.PlanName}} for service {{.ServiceName}} as {{.Username}}...", templateValues)
+	case cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Enabling access to all plans of service {{.ServiceName}} for the org {{.OrgName}} as {{.Username}}...", templateValues)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Enabling access to all plans of service {{.ServiceName}} for all orgs as {{.Username}}...", templateValues)
+	}
+
+	warnings, err := cmd.Actor.EnableServiceAccess(cmd.RequiredArgs.Service, cmd.ServicePlan, cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
 }
@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ExportUserProvidedServicesActor
+
+type ExportUserProvidedServicesActor interface {
+	GetUserProvidedServiceInstanceDefinitionsBySpace(spaceGUID string, names []string) ([]v2action.UserProvidedServiceInstanceDefinition, v2action.Warnings, error)
+	WriteUserProvidedServiceInstanceDefinitions(path string, definitions []v2action.UserProvidedServiceInstanceDefinition) error
+}
+
+type ExportUserProvidedServicesCommand struct {
+	RequiredArgs    flag.ExportUserProvidedServicesArgs `positional-args:"yes"`
+	FilePath        flag.Path                           `short:"p" required:"true" description:"Path of the file to write the user-provided service instances to"`
+	usage           interface{}                         `usage:"CF_NAME export-user-provided-services [SERVICE_INSTANCE...] -p FILE\n\n   Writes the credentials, syslog drain URL, route service URL, tags and bound app names of\n   user-provided service instances in the targeted space to FILE. All user-provided service\n   instances are exported when none are named.\n\n   WARNING: The file contains credentials and is only readable by the current user.\n\nEXAMPLES:\n   CF_NAME export-user-provided-services -p services.json\n   CF_NAME export-user-provided-services my-db my-drain -p services.json"`
+	relatedCommands interface{}                         `related_commands:"create-user-provided-service, import-user-provided-services, move-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportUserProvidedServicesActor
+}
+
+func (cmd *ExportUserProvidedServicesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ExportUserProvidedServicesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting user-provided service instances in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	definitions, warnings, err := cmd.Actor.GetUserProvidedServiceInstanceDefinitionsBySpace(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.ServiceInstances)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Actor.WriteUserProvidedServiceInstanceDefinitions(string(cmd.FilePath), definitions)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Exported {{.Count}} user-provided service instances to {{.FilePath}}", map[string]interface{}{
+		"Count":    len(definitions),
+		"FilePath": cmd.FilePath,
+	})
+	return nil
+}
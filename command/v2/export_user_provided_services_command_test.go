@@ -0,0 +1,84 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-user-provided-services Command", func() {
+	var (
+		cmd             ExportUserProvidedServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExportUserProvidedServicesActor
+		definitions     []v2action.UserProvidedServiceInstanceDefinition
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExportUserProvidedServicesActor)
+
+		cmd = ExportUserProvidedServicesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstances = []string{"ups-1", "ups-2"}
+		cmd.FilePath = "services.json"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		definitions = []v2action.UserProvidedServiceInstanceDefinition{{Name: "ups-1"}, {Name: "ups-2"}}
+		fakeActor.GetUserProvidedServiceInstanceDefinitionsBySpaceReturns(definitions, v2action.Warnings{"get-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("writes the definitions of the named service instances to the file", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Exporting user-provided service instances in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("Exported 2 user-provided service instances to services.json"))
+		Expect(testUI.Err).To(Say("get-warning"))
+
+		spaceGUID, names := fakeActor.GetUserProvidedServiceInstanceDefinitionsBySpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(names).To(Equal([]string{"ups-1", "ups-2"}))
+
+		path, writtenDefinitions := fakeActor.WriteUserProvidedServiceInstanceDefinitionsArgsForCall(0)
+		Expect(path).To(Equal("services.json"))
+		Expect(writtenDefinitions).To(Equal(definitions))
+	})
+
+	Context("when writing the file fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("permission denied")
+			fakeActor.WriteUserProvidedServiceInstanceDefinitionsReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
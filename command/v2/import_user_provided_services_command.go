@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ImportUserProvidedServicesActor
+
+type ImportUserProvidedServicesActor interface {
+	CreateUserProvidedServiceInstanceFromDefinition(spaceGUID string, definition v2action.UserProvidedServiceInstanceDefinition) (v2action.UserProvidedServiceInstance, v2action.Warnings, error)
+	ReadUserProvidedServiceInstanceDefinitions(path string) ([]v2action.UserProvidedServiceInstanceDefinition, error)
+}
+
+type ImportUserProvidedServicesCommand struct {
+	RequiredArgs    flag.ImportUserProvidedServicesArgs `positional-args:"yes"`
+	usage           interface{}                         `usage:"CF_NAME import-user-provided-services FILE\n\n   Creates the user-provided service instances in FILE in the targeted space and binds them\n   to the apps with the same names. Service instances that already exist are skipped.\n\nEXAMPLES:\n   CF_NAME import-user-provided-services services.json"`
+	relatedCommands interface{}                         `related_commands:"create-user-provided-service, export-user-provided-services, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ImportUserProvidedServicesActor
+}
+
+func (cmd *ImportUserProvidedServicesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ImportUserProvidedServicesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	definitions, err := cmd.Actor.ReadUserProvidedServiceInstanceDefinitions(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range definitions {
+		cmd.UI.DisplayTextWithFlavor("Creating user provided service {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"ServiceName": definition.Name,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+		_, warnings, err := cmd.Actor.CreateUserProvidedServiceInstanceFromDefinition(cmd.Config.TargetedSpace().GUID, definition)
+		cmd.UI.DisplayWarnings(warnings)
+		if _, ok := err.(actionerror.ServiceInstanceAlreadyExistsError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Service {{.ServiceName}} already exists", map[string]interface{}{
+				"ServiceName": definition.Name,
+			})
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-user-provided-services Command", func() {
+	var (
+		cmd             ImportUserProvidedServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeImportUserProvidedServicesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeImportUserProvidedServicesActor)
+
+		cmd = ImportUserProvidedServicesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Path = "services.json"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		fakeActor.ReadUserProvidedServiceInstanceDefinitionsReturns(
+			[]v2action.UserProvidedServiceInstanceDefinition{{Name: "ups-1"}, {Name: "ups-2"}},
+			nil)
+		fakeActor.CreateUserProvidedServiceInstanceFromDefinitionReturns(v2action.UserProvidedServiceInstance{}, v2action.Warnings{"create-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("creates each service instance in the targeted space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Creating user provided service ups-1 in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`Creating user provided service ups-2 in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("create-warning"))
+
+		Expect(fakeActor.ReadUserProvidedServiceInstanceDefinitionsArgsForCall(0)).To(Equal("services.json"))
+		Expect(fakeActor.CreateUserProvidedServiceInstanceFromDefinitionCallCount()).To(Equal(2))
+		spaceGUID, definition := fakeActor.CreateUserProvidedServiceInstanceFromDefinitionArgsForCall(1)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(definition.Name).To(Equal("ups-2"))
+	})
+
+	Context("when a service instance already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateUserProvidedServiceInstanceFromDefinitionReturnsOnCall(0, v2action.UserProvidedServiceInstance{}, nil, actionerror.ServiceInstanceAlreadyExistsError{Name: "ups-1"})
+		})
+
+		It("skips it and continues with the next one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Service ups-1 already exists"))
+			Expect(fakeActor.CreateUserProvidedServiceInstanceFromDefinitionCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when creating a service instance fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("create failed")
+			fakeActor.CreateUserProvidedServiceInstanceFromDefinitionReturnsOnCall(0, v2action.UserProvidedServiceInstance{}, nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActor.CreateUserProvidedServiceInstanceFromDefinitionCallCount()).To(Equal(1))
+		})
+	})
+})
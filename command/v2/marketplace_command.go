@@ -1,20 +1,323 @@
 package v2
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . MarketplaceActor
+
+type MarketplaceActor interface {
+	GetServiceSummaries(spaceGUID string, filter v2action.MarketplaceFilter) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	GetServiceSummaryByName(serviceName string, spaceGUID string) (v2action.ServiceSummary, v2action.Warnings, error)
+}
+
 type MarketplaceCommand struct {
 	ServicePlanInfo string      `short:"s" description:"Show plan details for a particular service offering"`
-	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE]"`
+	Search          string      `long:"search" description:"Only show service offerings whose name, description or tags contain the search term"`
+	Broker          string      `long:"broker" description:"Only show service offerings provided by this service broker"`
+	Compare         bool        `long:"compare" description:"Compare the plans of the service offering given with -s side by side"`
+	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE [--compare]] [--search TERM] [--broker BROKER]\n\nEXAMPLES:\n   CF_NAME marketplace --search sql\n   CF_NAME marketplace --broker my-broker\n   CF_NAME marketplace -s p-mysql --compare"`
 	relatedCommands interface{} `related_commands:"create-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MarketplaceActor
 }
 
-func (MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (MarketplaceCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd MarketplaceCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	// The marketplace can be browsed without logging in, in which case every
+	// service offering is listed. Once logged in, only the offerings
+	// available in the targeted space are listed.
+	var spaceGUID, userName string
+	if cmd.Config.AccessToken() != "" || cmd.Config.RefreshToken() != "" {
+		err = cmd.SharedActor.CheckTarget(true, true)
+		if err != nil {
+			return err
+		}
+
+		user, err := cmd.Config.CurrentUser()
+		if err != nil {
+			return err
+		}
+		spaceGUID = cmd.Config.TargetedSpace().GUID
+		userName = user.Name
+	}
+
+	if cmd.ServicePlanInfo != "" {
+		return cmd.displayServicePlans(spaceGUID, userName)
+	}
+	return cmd.displayServices(spaceGUID, userName)
+}
+
+func (cmd MarketplaceCommand) validateFlags() error {
+	if cmd.Compare && cmd.ServicePlanInfo == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--compare", Arg2: "-s"}
+	}
+
+	if cmd.ServicePlanInfo != "" {
+		var conflicts []string
+		if cmd.Search != "" {
+			conflicts = append(conflicts, "--search")
+		}
+		if cmd.Broker != "" {
+			conflicts = append(conflicts, "--broker")
+		}
+		if len(conflicts) > 0 {
+			return translatableerror.ArgumentCombinationError{Args: append([]string{"-s"}, conflicts...)}
+		}
+	}
+
+	return nil
+}
+
+func (cmd MarketplaceCommand) displayServices(spaceGUID string, userName string) error {
+	if spaceGUID == "" {
+		cmd.UI.DisplayText("Getting all services from marketplace...")
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting services from marketplace in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": userName,
+		})
+	}
+
+	summaries, warnings, err := cmd.Actor.GetServiceSummaries(spaceGUID, v2action.MarketplaceFilter{
+		Search:     cmd.Search,
+		BrokerName: cmd.Broker,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No service offerings found")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("service"),
+		cmd.UI.TranslateText("plans"),
+		cmd.UI.TranslateText("description"),
+		cmd.UI.TranslateText("broker"),
+	}}
+
+	var paidPlanExists bool
+	for _, summary := range summaries {
+		var planNames []string
+		for _, plan := range summary.Plans {
+			if plan.Free {
+				planNames = append(planNames, plan.Name)
+			} else {
+				paidPlanExists = true
+				planNames = append(planNames, plan.Name+"*")
+			}
+		}
+
+		table = append(table, []string{
+			summary.Label,
+			strings.Join(planNames, ", "),
+			summary.Description,
+			summary.ServiceBrokerName,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if paidPlanExists {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("* These service plans have an associated cost. Creating a service instance will incur this cost.")
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to view descriptions of individual plans of a given service.", map[string]interface{}{
+		"Command": fmt.Sprintf("%s marketplace -s SERVICE", cmd.Config.BinaryName()),
+	})
+
+	return nil
+}
+
+func (cmd MarketplaceCommand) displayServicePlans(spaceGUID string, userName string) error {
+	if spaceGUID == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting service plan information for service {{.ServiceName}}...", map[string]interface{}{
+			"ServiceName": cmd.ServicePlanInfo,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting service plan information for service {{.ServiceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"ServiceName": cmd.ServicePlanInfo,
+			"CurrentUser": userName,
+		})
+	}
+
+	summary, warnings, err := cmd.Actor.GetServiceSummaryByName(cmd.ServicePlanInfo, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if cmd.Compare {
+		cmd.displayPlanComparison(summary)
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("service plan"),
+		cmd.UI.TranslateText("description"),
+		cmd.UI.TranslateText("free or paid"),
+	}}
+	for _, plan := range summary.Plans {
+		table = append(table, []string{
+			plan.Name,
+			plan.Description,
+			cmd.freeOrPaid(plan),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+// displayPlanComparison displays one column per service plan and one row per
+// plan property, so that plans can be compared side by side.
+func (cmd MarketplaceCommand) displayPlanComparison(summary v2action.ServiceSummary) {
+	if len(summary.Plans) == 0 {
+		cmd.UI.DisplayText("No service plans found")
+		return
+	}
+
+	header := []string{""}
+	description := []string{cmd.UI.TranslateText("description")}
+	freeOrPaid := []string{cmd.UI.TranslateText("free or paid")}
+	costs := []string{cmd.UI.TranslateText("costs")}
+	bindable := []string{cmd.UI.TranslateText("bindable")}
+	shareable := []string{cmd.UI.TranslateText("shareable")}
+	parameters := []string{cmd.UI.TranslateText("create parameters")}
+
+	for _, plan := range summary.Plans {
+		header = append(header, plan.Name)
+		description = append(description, plan.Description)
+		freeOrPaid = append(freeOrPaid, cmd.freeOrPaid(plan))
+		costs = append(costs, planCosts(plan))
+		bindable = append(bindable, cmd.yesOrNo(summary.IsPlanBindable(plan)))
+		shareable = append(shareable, cmd.yesOrNo(summary.Extra.Shareable))
+		parameters = append(parameters, schemaParameters(plan.Schemas.ServiceInstanceCreateParameters))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", [][]string{
+		header,
+		description,
+		freeOrPaid,
+		costs,
+		bindable,
+		shareable,
+		parameters,
+	}, 3)
+}
+
+func (cmd MarketplaceCommand) freeOrPaid(plan v2action.ServicePlan) string {
+	if plan.Free {
+		return cmd.UI.TranslateText("free")
+	}
+	return cmd.UI.TranslateText("paid")
+}
+
+func (cmd MarketplaceCommand) yesOrNo(value bool) string {
+	if value {
+		return cmd.UI.TranslateText("yes")
+	}
+	return cmd.UI.TranslateText("no")
+}
+
+// planCosts formats the costs of a plan, e.g. "99.00 USD/MONTHLY".
+func planCosts(plan v2action.ServicePlan) string {
+	var costs []string
+	for _, cost := range plan.Extra.Costs {
+		var currencies []string
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			costs = append(costs, fmt.Sprintf("%.2f %s/%s", cost.Amount[currency], strings.ToUpper(currency), cost.Unit))
+		}
+	}
+	return strings.Join(costs, ", ")
+}
+
+// schemaParameters lists the properties of a JSON schema with their types,
+// e.g. "size (string, required), replicas (integer)".
+func schemaParameters(schema map[string]interface{}) string {
+	properties, _ := schema["properties"].(map[string]interface{})
+	if len(properties) == 0 {
+		return ""
+	}
+
+	required := map[string]bool{}
+	if requiredNames, ok := schema["required"].([]interface{}); ok {
+		for _, name := range requiredNames {
+			if nameString, ok := name.(string); ok {
+				required[nameString] = true
+			}
+		}
+	}
+
+	var names []string
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parameters []string
+	for _, name := range names {
+		var details []string
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			if propertyType, ok := property["type"].(string); ok {
+				details = append(details, propertyType)
+			}
+		}
+		if required[name] {
+			details = append(details, "required")
+		}
+
+		if len(details) == 0 {
+			parameters = append(parameters, name)
+		} else {
+			parameters = append(parameters, fmt.Sprintf("%s (%s)", name, strings.Join(details, ", ")))
+		}
+	}
+	return strings.Join(parameters, ", ")
 }
@@ -0,0 +1,244 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("marketplace Command", func() {
+	var (
+		cmd             MarketplaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMarketplaceActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMarketplaceActor)
+
+		cmd = MarketplaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.AccessTokenReturns("some-access-token")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --compare is provided without -s", func() {
+		BeforeEach(func() {
+			cmd.Compare = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--compare", Arg2: "-s"}))
+		})
+	})
+
+	Context("when -s is provided with --search and --broker", func() {
+		BeforeEach(func() {
+			cmd.ServicePlanInfo = "some-service"
+			cmd.Search = "sql"
+			cmd.Broker = "some-broker"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"-s", "--search", "--broker"}}))
+		})
+	})
+
+	Context("when the user is logged in and checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Describe("listing service offerings", func() {
+		BeforeEach(func() {
+			cmd.Search = "sql"
+			cmd.Broker = "some-broker"
+			fakeActor.GetServiceSummariesReturns(
+				[]v2action.ServiceSummary{
+					{
+						Service: v2action.Service{Label: "mysql", Description: "relational database", ServiceBrokerName: "some-broker"},
+						Plans: []v2action.ServicePlan{
+							{Name: "small", Free: true},
+							{Name: "large"},
+						},
+					},
+				},
+				v2action.Warnings{"get-services-warning"},
+				nil)
+		})
+
+		It("displays the matching service offerings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting services from marketplace in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`service\s+plans\s+description\s+broker`))
+			Expect(testUI.Out).To(Say(`mysql\s+small, large\*\s+relational database\s+some-broker`))
+			Expect(testUI.Out).To(Say(`\* These service plans have an associated cost\.`))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman marketplace -s SERVICE' to view descriptions of individual plans of a given service\.`))
+			Expect(testUI.Err).To(Say("get-services-warning"))
+
+			spaceGUID, filter := fakeActor.GetServiceSummariesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(filter).To(Equal(v2action.MarketplaceFilter{Search: "sql", BrokerName: "some-broker"}))
+		})
+
+		Context("when the user is not logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns("")
+			})
+
+			It("lists every service offering without checking the target", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Getting all services from marketplace\.\.\.`))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+
+				spaceGUID, _ := fakeActor.GetServiceSummariesArgsForCall(0)
+				Expect(spaceGUID).To(BeEmpty())
+			})
+		})
+
+		Context("when no service offerings match", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceSummariesReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No service offerings found"))
+			})
+		})
+
+		Context("when getting the service offerings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get services failed")
+				fakeActor.GetServiceSummariesReturns(nil, v2action.Warnings{"get-services-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("get-services-warning"))
+			})
+		})
+	})
+
+	Describe("showing the plans of a service offering", func() {
+		BeforeEach(func() {
+			cmd.ServicePlanInfo = "mysql"
+			fakeActor.GetServiceSummaryByNameReturns(
+				v2action.ServiceSummary{
+					Service: v2action.Service{
+						Label:    "mysql",
+						Bindable: true,
+						Extra:    ccv2.ServiceExtra{Shareable: true},
+					},
+					Plans: []v2action.ServicePlan{
+						{Name: "small", Description: "a small plan", Free: true},
+						{
+							Name:        "large",
+							Description: "a large plan",
+							Bindable:    types.NullBool{IsSet: true, Value: false},
+							Extra: ccv2.ServicePlanExtra{
+								Costs: []ccv2.ServicePlanCost{{Amount: map[string]float64{"usd": 99, "eur": 90}, Unit: "MONTHLY"}},
+							},
+							Schemas: ccv2.ServicePlanSchemas{
+								ServiceInstanceCreateParameters: map[string]interface{}{
+									"properties": map[string]interface{}{
+										"size":     map[string]interface{}{"type": "string"},
+										"replicas": map[string]interface{}{"type": "integer"},
+									},
+									"required": []interface{}{"size"},
+								},
+							},
+						},
+					},
+				},
+				v2action.Warnings{"get-service-warning"},
+				nil)
+		})
+
+		It("displays the plans", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting service plan information for service mysql as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`service plan\s+description\s+free or paid`))
+			Expect(testUI.Out).To(Say(`small\s+a small plan\s+free`))
+			Expect(testUI.Out).To(Say(`large\s+a large plan\s+paid`))
+			Expect(testUI.Err).To(Say("get-service-warning"))
+
+			serviceName, spaceGUID := fakeActor.GetServiceSummaryByNameArgsForCall(0)
+			Expect(serviceName).To(Equal("mysql"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		Context("when --compare is provided", func() {
+			BeforeEach(func() {
+				cmd.Compare = true
+			})
+
+			It("displays the plans side by side", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`small\s+large`))
+				Expect(testUI.Out).To(Say(`description\s+a small plan\s+a large plan`))
+				Expect(testUI.Out).To(Say(`free or paid\s+free\s+paid`))
+				Expect(testUI.Out).To(Say(`costs\s+90\.00 EUR/MONTHLY, 99\.00 USD/MONTHLY`))
+				Expect(testUI.Out).To(Say(`bindable\s+yes\s+no`))
+				Expect(testUI.Out).To(Say(`shareable\s+yes\s+yes`))
+				Expect(testUI.Out).To(Say(`create parameters\s+replicas \(integer\), size \(string, required\)`))
+			})
+		})
+
+		Context("when the service offering does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceSummaryByNameReturns(v2action.ServiceSummary{}, v2action.Warnings{"get-service-warning"}, actionerror.ServiceNotFoundError{Name: "mysql"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "mysql"}))
+				Expect(testUI.Err).To(Say("get-service-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . MoveServiceActor
+
+type MoveServiceActor interface {
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	MoveUserProvidedServiceInstance(name string, sourceSpaceGUID string, targetSpaceGUID string) (v2action.UserProvidedServiceInstance, v2action.Warnings, error)
+}
+
+type MoveServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	ToSpace         string               `long:"to-space" required:"true" description:"The space in the targeted org to move the service instance to"`
+	usage           interface{}          `usage:"CF_NAME move-service SERVICE_INSTANCE --to-space SPACE\n\n   Only user-provided service instances can be moved. The instance is re-created in the\n   target space with the same credentials, syslog drain URL and route service URL, bound\n   to the apps with the same names in the target space, and then deleted from the\n   current space.\n\nEXAMPLES:\n   CF_NAME move-service my-db --to-space production"`
+	relatedCommands interface{}          `related_commands:"export-user-provided-services, import-user-provided-services, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MoveServiceActor
+}
+
+func (cmd *MoveServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd MoveServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Moving service instance {{.ServiceInstanceName}} from space {{.SpaceName}} to space {{.TargetSpaceName}} in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"TargetSpaceName":     cmd.ToSpace,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":         user.Name,
+	})
+
+	targetSpace, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.ToSpace)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, warnings, err = cmd.Actor.MoveUserProvidedServiceInstance(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID, targetSpace.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
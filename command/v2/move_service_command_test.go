@@ -0,0 +1,112 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("move-service Command", func() {
+	var (
+		cmd             MoveServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMoveServiceActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMoveServiceActor)
+
+		cmd = MoveServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-ups"
+		cmd.ToSpace = "target-space"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "source-space-guid", Name: "source-space"})
+
+		fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "target-space-guid"}, v2action.Warnings{"get-space-warning"}, nil)
+		fakeActor.MoveUserProvidedServiceInstanceReturns(v2action.UserProvidedServiceInstance{}, v2action.Warnings{"move-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("moves the service instance to the target space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Moving service instance some-ups from space source-space to space target-space in org some-org as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("get-space-warning"))
+		Expect(testUI.Err).To(Say("move-warning"))
+
+		orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+		Expect(orgGUID).To(Equal("some-org-guid"))
+		Expect(spaceName).To(Equal("target-space"))
+
+		name, sourceSpaceGUID, targetSpaceGUID := fakeActor.MoveUserProvidedServiceInstanceArgsForCall(0)
+		Expect(name).To(Equal("some-ups"))
+		Expect(sourceSpaceGUID).To(Equal("source-space-guid"))
+		Expect(targetSpaceGUID).To(Equal("target-space-guid"))
+	})
+
+	Context("when the target space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, nil, actionerror.SpaceNotFoundError{Name: "target-space"})
+		})
+
+		It("returns the error without moving the service instance", func() {
+			Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "target-space"}))
+			Expect(fakeActor.MoveUserProvidedServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when moving the service instance fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("move failed")
+			fakeActor.MoveUserProvidedServiceInstanceReturns(v2action.UserProvidedServiceInstance{}, v2action.Warnings{"move-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("move-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -10,11 +10,14 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	pluginshared "code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
 )
@@ -34,36 +37,39 @@ type V2PushActor interface {
 	CloudControllerAPIVersion() string
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
-	ReadManifest(pathToManifest string, pathToVarsFile string) ([]manifest.Application, error)
+	ReadManifest(pathToManifest string, sources manifest.VariableSources) ([]manifest.Application, error)
 }
 
 type V2PushCommand struct {
-	OptionalArgs        flag.OptionalAppName        `positional-args:"yes"`
-	Buildpack           flag.Buildpack              `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	Command             flag.Command                `short:"c" description:"Startup command, set to null to reset to default start command"`
-	Domain              string                      `short:"d" description:"Domain (e.g. example.com)"`
-	DockerImage         flag.DockerImage            `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	DockerUsername      string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	DropletPath         flag.PathWithExistenceCheck `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
-	PathToManifest      flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
-	HealthCheckType     flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
-	Hostname            string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	Instances           flag.Instances              `short:"i" description:"Number of instances"`
-	DiskQuota           flag.Megabytes              `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	Memory              flag.Megabytes              `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoHostname          bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest          bool                        `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute             bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart             bool                        `long:"no-start" description:"Do not start an app after pushing"`
-	AppPath             flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	RandomRoute         bool                        `long:"random-route" description:"Create a random route for this app"`
-	RoutePath           flag.RoutePath              `long:"route-path" description:"Path for the route"`
-	StackName           string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	VarsFilePath        flag.PathWithExistenceCheck `long:"vars-file" description:"Path to vars file"`
-	HealthCheckTimeout  int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	envCFStagingTimeout interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword      interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	OptionalArgs        flag.OptionalAppName          `positional-args:"yes"`
+	Buildpack           flag.Buildpack                `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	Command             flag.Command                  `short:"c" description:"Startup command, set to null to reset to default start command"`
+	Domain              string                        `short:"d" description:"Domain (e.g. example.com)"`
+	DockerImage         flag.DockerImage              `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DockerUsername      string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DropletPath         flag.PathWithExistenceCheck   `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	PathToManifest      flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest"`
+	HealthCheckType     flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	Hostname            string                        `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	Instances           flag.Instances                `short:"i" description:"Number of instances"`
+	DiskQuota           flag.Megabytes                `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory              flag.Megabytes                `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoHostname          bool                          `long:"no-hostname" description:"Map the root domain to this app"`
+	NoManifest          bool                          `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute             bool                          `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart             bool                          `long:"no-start" description:"Do not start an app after pushing"`
+	AppPath             flag.PathWithExistenceCheck   `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	RandomRoute         bool                          `long:"random-route" description:"Create a random route for this app"`
+	RoutePath           flag.RoutePath                `long:"route-path" description:"Path for the route"`
+	StackName           string                        `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	PathsToVarsFiles    []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times, later files take precedence"`
+	Vars                []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times, takes precedence over vars files"`
+	VarsFromEnv         bool                          `long:"vars-from-env" description:"Read variables for substitution from CF_VAR_<name> environment variables, which vars files and --var take precedence over"`
+	HealthCheckTimeout  int                           `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	envCFStagingTimeout interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword      interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	envCFVar            interface{}                   `environmentName:"CF_VAR_*" environmentDescription:"Variable for manifest substitution, used with --vars-from-env"`
 
 	usage           interface{} `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
@@ -76,6 +82,7 @@ type V2PushCommand struct {
 
 	RestartActor RestartActor
 	NOAAClient   *consumer.Consumer
+	HookRunner   command.PluginHookRunner
 }
 
 func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -94,6 +101,7 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
+	cmd.HookRunner = pluginshared.NewHookRunner(config, ui)
 	return nil
 }
 
@@ -166,6 +174,13 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	log.Info("running pre-push plugin hooks")
+	err = cmd.HookRunner.Run(shared.NewPushHookEvent(plugin.PrePushHook, cmd.Config, appConfigs))
+	if err != nil {
+		log.Errorln("pre-push plugin hooks:", err)
+		return err
+	}
+
 	for appNumber, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
@@ -206,7 +221,8 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		}
 	}
 
-	return nil
+	log.Info("running post-push plugin hooks")
+	return cmd.HookRunner.Run(shared.NewPushHookEvent(plugin.PostPushHook, cmd.Config, appConfigs))
 }
 
 // GetCommandLineSettings generates a push CommandLineSettings object from the
@@ -263,10 +279,7 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 }
 
 func (cmd V2PushCommand) findAndReadManifestWithFlavorText(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
-	var (
-		pathToManifest string
-		pathToVarsFile string
-	)
+	var pathToManifest string
 	switch {
 	case cmd.NoManifest:
 		log.Debug("skipping reading of manifest")
@@ -328,8 +341,9 @@ func (cmd V2PushCommand) findAndReadManifestWithFlavorText(settings pushaction.C
 		return nil, nil
 	}
 
-	if cmd.VarsFilePath != "" {
-		pathToVarsFile = string(cmd.VarsFilePath)
+	sources, err := shared.NewVariableSources(cmd.PathsToVarsFiles, cmd.Vars, cmd.VarsFromEnv)
+	if err != nil {
+		return nil, err
 	}
 
 	cmd.UI.DisplayTextWithFlavor("Pushing from manifest to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
@@ -341,7 +355,7 @@ func (cmd V2PushCommand) findAndReadManifestWithFlavorText(settings pushaction.C
 	cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
 		"Path": pathToManifest,
 	})
-	return cmd.Actor.ReadManifest(pathToManifest, pathToVarsFile)
+	return cmd.Actor.ReadManifest(pathToManifest, sources)
 }
 
 func (cmd V2PushCommand) processApplyStreams(
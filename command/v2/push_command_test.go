@@ -22,6 +22,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -38,6 +39,7 @@ var _ = Describe("push Command", func() {
 		fakeActor        *v2fakes.FakeV2PushActor
 		fakeRestartActor *v2fakes.FakeRestartActor
 		fakeProgressBar  *v2fakes.FakeProgressBar
+		fakeHookRunner   *commandfakes.FakePluginHookRunner
 		input            *Buffer
 		binaryName       string
 
@@ -54,6 +56,7 @@ var _ = Describe("push Command", func() {
 		fakeActor = new(v2fakes.FakeV2PushActor)
 		fakeRestartActor = new(v2fakes.FakeRestartActor)
 		fakeProgressBar = new(v2fakes.FakeProgressBar)
+		fakeHookRunner = new(commandfakes.FakePluginHookRunner)
 
 		cmd = V2PushCommand{
 			UI:           testUI,
@@ -62,6 +65,7 @@ var _ = Describe("push Command", func() {
 			Actor:        fakeActor,
 			RestartActor: fakeRestartActor,
 			ProgressBar:  fakeProgressBar,
+			HookRunner:   fakeHookRunner,
 		}
 
 		appName = "some-app"
@@ -431,26 +435,57 @@ var _ = Describe("push Command", func() {
 											Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(providedPath))
 										})
 
-										Context("when a vars file is also provided", func() {
-											var providedVarsFilePath string
+										Context("when vars files and vars are also provided", func() {
+											var (
+												providedVarsFilePath      string
+												otherProvidedVarsFilePath string
+											)
 
 											BeforeEach(func() {
 												providedVarsFilePath = filepath.Join(tmpDir, "vars-file.yml")
-												cmd.VarsFilePath = flag.PathWithExistenceCheck(providedVarsFilePath)
+												otherProvidedVarsFilePath = filepath.Join(tmpDir, "other-vars-file.yml")
+												cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{
+													flag.PathWithExistenceCheck(providedVarsFilePath),
+													flag.PathWithExistenceCheck(otherProvidedVarsFilePath),
+												}
+												cmd.Vars = []template.VarKV{{Name: "some-var", Value: "some-value"}}
 											})
 
-											It("should read the vars-file.yml file and replace the variables in the manifest.yml file", func() {
+											It("should read the manifest.yml file with the vars files and vars", func() {
 												Expect(executeErr).ToNot(HaveOccurred())
 
 												Expect(testUI.Out).To(Say("Pushing from manifest to org some-org / space some-space as some-user\\.\\.\\."))
 												Expect(testUI.Out).To(Say("Using manifest file %s", regexp.QuoteMeta(providedPath)))
 
 												Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-												manifest, varsfile := fakeActor.ReadManifestArgsForCall(0)
-												Expect(manifest).To(Equal(providedPath))
-												Expect(varsfile).To(Equal(providedVarsFilePath))
+												manifestPath, sources := fakeActor.ReadManifestArgsForCall(0)
+												Expect(manifestPath).To(Equal(providedPath))
+												Expect(sources).To(Equal(manifest.VariableSources{
+													PathsToVarsFiles: []string{providedVarsFilePath, otherProvidedVarsFilePath},
+													Vars:             []template.VarKV{{Name: "some-var", Value: "some-value"}},
+												}))
+											})
+										})
+
+										Context("when reading vars from the environment", func() {
+											BeforeEach(func() {
+												cmd.VarsFromEnv = true
+												Expect(os.Setenv("CF_VAR_some_var", "some-value")).To(Succeed())
+												Expect(os.Setenv("CF_VAR_some_number", "3")).To(Succeed())
+											})
+
+											AfterEach(func() {
+												Expect(os.Unsetenv("CF_VAR_some_var")).To(Succeed())
+												Expect(os.Unsetenv("CF_VAR_some_number")).To(Succeed())
 											})
 
+											It("passes the CF_VAR_ environment variables as a variable source", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+
+												_, sources := fakeActor.ReadManifestArgsForCall(0)
+												Expect(sources.Environment).To(HaveKeyWithValue("some_var", "some-value"))
+												Expect(sources.Environment).To(HaveKeyWithValue("some_number", 3))
+											})
 										})
 									})
 								})
@@ -608,6 +643,34 @@ var _ = Describe("push Command", func() {
 							Expect(progressBar).To(Equal(fakeProgressBar))
 						})
 
+						It("runs the pre-push and post-push plugin hooks with the app configurations", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeHookRunner.RunCallCount()).To(Equal(2))
+							preEvent := fakeHookRunner.RunArgsForCall(0)
+							Expect(preEvent.Name).To(Equal("pre-push"))
+							Expect(preEvent.Applications).To(HaveLen(1))
+							Expect(preEvent.Applications[0].Name).To(Equal(appName))
+							Expect(preEvent.Applications[0].Path).To(Equal(appConfigs[0].Path))
+							Expect(fakeHookRunner.RunArgsForCall(1).Name).To(Equal("post-push"))
+						})
+
+						Context("when a pre-push plugin hook vetoes the push", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = translatableerror.PluginHookVetoedError{PluginName: "ticket-check", Event: "pre-push"}
+								fakeHookRunner.RunReturnsOnCall(0, expectedErr)
+							})
+
+							It("returns the error without applying the configurations", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+								Expect(fakeHookRunner.RunCallCount()).To(Equal(1))
+							})
+						})
+
 						It("display diff of changes", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
@@ -0,0 +1,299 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . RestageFleetActor
+
+type RestageFleetActor interface {
+	GetApplicationsForFleetRestage(criteria v2action.FleetRestageCriteria) ([]v2action.Application, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	RestageApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+}
+
+type RestageFleetCommand struct {
+	Buildpack           string      `long:"buildpack" description:"Restage the apps staged with this buildpack"`
+	Stack               string      `long:"stack" description:"Restage the apps running on this stack"`
+	Organization        string      `short:"o" long:"org" description:"Only restage the apps in this org"`
+	Concurrency         int         `long:"concurrency" default:"5" description:"Maximum number of apps to restage at the same time"`
+	MaxUnhealthy        int         `long:"max-unhealthy" default:"5" description:"Maximum number of apps that are restaging or failed to restage before no more apps are restaged"`
+	ProgressFile        string      `long:"progress-file" default:"restage-fleet-progress.json" description:"File that records the restaged apps so that an interrupted restage can be resumed"`
+	Force               bool        `short:"f" description:"Force the restage without confirmation"`
+	usage               interface{} `usage:"CF_NAME restage-fleet (--buildpack BUILDPACK | --stack STACK) [-o ORG] [--concurrency NUMBER] [--max-unhealthy NUMBER] [--progress-file FILE] [-f]\n\n   Restages the started apps that were staged with a buildpack or that run on a stack,\n   so that they pick up an updated buildpack or stack. Restaged apps are recorded in the\n   progress file; running the same command again skips them.\n\nEXAMPLES:\n   CF_NAME restage-fleet --buildpack ruby_buildpack --concurrency 10\n   CF_NAME restage-fleet --stack cflinuxfs2 -o my-org"`
+	relatedCommands     interface{} `related_commands:"apps, buildpacks, build-info, restage, stacks"`
+	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI            command.UI
+	Config        command.Config
+	SharedActor   command.SharedActor
+	Actor         RestageFleetActor
+	NewNOAAClient func() v2action.NOAAClient
+}
+
+type restageFleetResult struct {
+	app      v2action.Application
+	warnings []string
+	err      error
+}
+
+func (cmd *RestageFleetCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	// RestageApplication closes the client when it is done, so every app needs
+	// its own.
+	cmd.NewNOAAClient = func() v2action.NOAAClient {
+		return shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	}
+
+	return nil
+}
+
+func (cmd RestageFleetCommand) Execute(args []string) error {
+	switch {
+	case cmd.Buildpack != "" && cmd.Stack != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"--buildpack", "--stack"}}
+	case cmd.Buildpack == "" && cmd.Stack == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "--buildpack or --stack"}
+	case cmd.Concurrency < 1:
+		return translatableerror.ParseArgumentError{ArgumentName: "--concurrency", ExpectedType: "a positive integer"}
+	case cmd.MaxUnhealthy < 1:
+		return translatableerror.ParseArgumentError{ArgumentName: "--max-unhealthy", ExpectedType: "a positive integer"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	criteria := v2action.FleetRestageCriteria{
+		Buildpack: cmd.Buildpack,
+		Stack:     cmd.Stack,
+	}
+
+	if cmd.Organization != "" {
+		org, warnings, orgErr := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if orgErr != nil {
+			return orgErr
+		}
+		criteria.OrganizationGUID = org.GUID
+	}
+
+	cmd.displayGettingApps(user.Name)
+
+	apps, warnings, err := cmd.Actor.GetApplicationsForFleetRestage(criteria)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	progress, err := v2action.LoadFleetRestageProgress(cmd.ProgressFile, criteria)
+	if err != nil {
+		return err
+	}
+
+	var pending []v2action.Application
+	for _, app := range apps {
+		if !progress.Restaged(app.GUID) {
+			pending = append(pending, app)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(pending) == 0 {
+		cmd.UI.DisplayText("Nothing to restage.")
+		cmd.UI.DisplayOK()
+		return v2action.DeleteFleetRestageProgress(cmd.ProgressFile)
+	}
+
+	if skipped := len(apps) - len(pending); skipped > 0 {
+		cmd.UI.DisplayText("Skipping {{.Count}} app(s) already restaged according to {{.ProgressFile}}.", map[string]interface{}{
+			"Count":        skipped,
+			"ProgressFile": cmd.ProgressFile,
+		})
+	}
+
+	if !cmd.Force {
+		restage, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really restage {{.Count}} app(s)?", map[string]interface{}{
+			"Count": len(pending),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !restage {
+			cmd.UI.DisplayText("Restage fleet cancelled")
+			return nil
+		}
+	}
+
+	failed, remaining, err := cmd.restageApplications(pending, &progress)
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 || remaining > 0 {
+		return translatableerror.RestageFleetIncompleteError{
+			Failed:       failed,
+			Remaining:    remaining,
+			ProgressFile: cmd.ProgressFile,
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return v2action.DeleteFleetRestageProgress(cmd.ProgressFile)
+}
+
+func (cmd RestageFleetCommand) displayGettingApps(username string) {
+	params := map[string]interface{}{
+		"Buildpack":   cmd.Buildpack,
+		"Stack":       cmd.Stack,
+		"OrgName":     cmd.Organization,
+		"CurrentUser": username,
+	}
+
+	switch {
+	case cmd.Buildpack != "" && cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Getting apps staged with buildpack {{.Buildpack}} in org {{.OrgName}} as {{.CurrentUser}}...", params)
+	case cmd.Buildpack != "":
+		cmd.UI.DisplayTextWithFlavor("Getting apps staged with buildpack {{.Buildpack}} as {{.CurrentUser}}...", params)
+	case cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Getting apps on stack {{.Stack}} in org {{.OrgName}} as {{.CurrentUser}}...", params)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting apps on stack {{.Stack}} as {{.CurrentUser}}...", params)
+	}
+}
+
+// restageApplications restages the apps, at most Concurrency at a time. An app
+// counts as unhealthy while it is restaging and after it failed to restage; no
+// more apps are restaged once MaxUnhealthy apps are unhealthy. It returns the
+// number of apps that failed and the number of apps that were not restaged.
+func (cmd RestageFleetCommand) restageApplications(apps []v2action.Application, progress *v2action.FleetRestageProgress) (int, int, error) {
+	results := make(chan restageFleetResult, len(apps))
+
+	var inFlight, failed int
+	for {
+		for len(apps) > 0 && inFlight < cmd.Concurrency && inFlight+failed < cmd.MaxUnhealthy {
+			cmd.UI.DisplayTextWithFlavor("Restaging app {{.AppName}}...", map[string]interface{}{
+				"AppName": apps[0].Name,
+			})
+
+			go func(app v2action.Application) {
+				results <- cmd.restageApplication(app)
+			}(apps[0])
+
+			apps = apps[1:]
+			inFlight++
+		}
+
+		if inFlight == 0 {
+			return failed, len(apps), nil
+		}
+
+		result := <-results
+		inFlight--
+
+		cmd.UI.DisplayWarnings(result.warnings)
+		if result.err != nil {
+			failed++
+			cmd.UI.DisplayWarning("Failed to restage app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"AppName": result.app.Name,
+				"Error":   cmd.translateError(result.err),
+			})
+			continue
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Restaged app {{.AppName}}.", map[string]interface{}{
+			"AppName": result.app.Name,
+		})
+
+		progress.MarkRestaged(result.app.GUID)
+		err := progress.Save(cmd.ProgressFile)
+		if err != nil {
+			return failed, len(apps) + inFlight, err
+		}
+	}
+}
+
+// restageApplication restages a single app and waits for it to start. The log
+// messages are discarded because the logs of concurrent restages would be
+// interleaved.
+func (cmd RestageFleetCommand) restageApplication(app v2action.Application) restageFleetResult {
+	result := restageFleetResult{app: app}
+
+	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestageApplication(app, cmd.NewNOAAClient())
+	for appState != nil || apiWarnings != nil || errs != nil {
+		select {
+		case _, ok := <-messages:
+			if !ok {
+				messages = nil
+			}
+		case _, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+			}
+		case _, ok := <-appState:
+			if !ok {
+				appState = nil
+			}
+		case warning, ok := <-apiWarnings:
+			if !ok {
+				apiWarnings = nil
+				break
+			}
+			result.warnings = append(result.warnings, warning)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			if result.err == nil {
+				result.err = err
+			}
+		}
+	}
+
+	return result
+}
+
+// translateError converts err to a translatable error and translates it, so
+// that a failure that does not stop the fleet is displayed the same way as
+// an error returned by a command.
+func (cmd RestageFleetCommand) translateError(err error) string {
+	err = translatableerror.ConvertToTranslatableError(err)
+	translatableErr, ok := err.(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(template string, templateValues ...interface{}) string {
+		if len(templateValues) > 0 {
+			if values, ok := templateValues[0].(map[string]interface{}); ok {
+				return cmd.UI.TranslateText(template, values)
+			}
+		}
+		return cmd.UI.TranslateText(template)
+	})
+}
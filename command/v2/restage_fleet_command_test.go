@@ -0,0 +1,332 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("restage-fleet Command", func() {
+	var (
+		cmd             RestageFleetCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRestageFleetActor
+		tempDir         string
+		progressFile    string
+		failingApps     map[string]bool
+		failingAppsLock sync.Mutex
+		failingErr      error
+		executeErr      error
+	)
+
+	restageStub := func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+		messages := make(chan *v2action.LogMessage)
+		logErrs := make(chan error)
+		appState := make(chan v2action.ApplicationStateChange)
+		warnings := make(chan string)
+		errs := make(chan error)
+
+		failingAppsLock.Lock()
+		fail := failingApps[app.GUID]
+		failingAppsLock.Unlock()
+
+		go func() {
+			appState <- v2action.ApplicationStateStaging
+			warnings <- app.Name + "-warning"
+			if fail {
+				errs <- failingErr
+			} else {
+				appState <- v2action.ApplicationStateStarting
+			}
+			close(messages)
+			close(logErrs)
+			close(appState)
+			close(warnings)
+			close(errs)
+		}()
+
+		return messages, logErrs, appState, warnings, errs
+	}
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRestageFleetActor)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "restage-fleet")
+		Expect(err).ToNot(HaveOccurred())
+		progressFile = filepath.Join(tempDir, "progress.json")
+
+		cmd = RestageFleetCommand{
+			Buildpack:     "ruby_buildpack",
+			Concurrency:   5,
+			MaxUnhealthy:  5,
+			ProgressFile:  progressFile,
+			Force:         true,
+			UI:            testUI,
+			Config:        fakeConfig,
+			SharedActor:   fakeSharedActor,
+			Actor:         fakeActor,
+			NewNOAAClient: func() v2action.NOAAClient { return nil },
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationsForFleetRestageReturns([]v2action.Application{
+			{Name: "app-1", GUID: "app-guid-1"},
+			{Name: "app-2", GUID: "app-guid-2"},
+			{Name: "app-3", GUID: "app-guid-3"},
+		}, v2action.Warnings{"get-apps-warning"}, nil)
+
+		failingApps = map[string]bool{}
+		failingErr = actionerror.StagingFailedError{Reason: "some-reason"}
+		fakeActor.RestageApplicationStub = restageStub
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --buildpack and --stack are provided", func() {
+		BeforeEach(func() {
+			cmd.Stack = "cflinuxfs2"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--buildpack", "--stack"}}))
+		})
+	})
+
+	Context("when neither --buildpack nor --stack is provided", func() {
+		BeforeEach(func() {
+			cmd.Buildpack = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "--buildpack or --stack"}))
+		})
+	})
+
+	Context("when --concurrency is not positive", func() {
+		BeforeEach(func() {
+			cmd.Concurrency = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{ArgumentName: "--concurrency", ExpectedType: "a positive integer"}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Buildpack = ""
+			cmd.Stack = "cflinuxfs2"
+			cmd.Organization = "some-org"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid"}, v2action.Warnings{"get-org-warning"}, nil)
+		})
+
+		It("only restages the apps in the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting apps on stack cflinuxfs2 in org some-org as some-user..."))
+			Expect(testUI.Err).To(Say("get-org-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeActor.GetApplicationsForFleetRestageArgsForCall(0)).To(Equal(v2action.FleetRestageCriteria{
+				Stack:            "cflinuxfs2",
+				OrganizationGUID: "some-org-guid",
+			}))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(fakeActor.GetApplicationsForFleetRestageCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when getting the apps fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsForFleetRestageReturns(nil, v2action.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("get-apps-error"))
+			Expect(testUI.Err).To(Say("get-apps-warning"))
+		})
+	})
+
+	It("restages all the apps and removes the progress file", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting apps staged with buildpack ruby_buildpack as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+		for _, name := range []string{"app-1", "app-2", "app-3"} {
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("Restaged app %s.", name))
+			Expect(string(testUI.Err.(*Buffer).Contents())).To(ContainSubstring("%s-warning", name))
+		}
+		Expect(testUI.Err).To(Say("get-apps-warning"))
+
+		Expect(fakeActor.GetApplicationsForFleetRestageArgsForCall(0)).To(Equal(v2action.FleetRestageCriteria{Buildpack: "ruby_buildpack"}))
+		Expect(fakeActor.RestageApplicationCallCount()).To(Equal(3))
+
+		_, err := os.Stat(progressFile)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	Context("when some apps were already restaged", func() {
+		BeforeEach(func() {
+			progress := v2action.FleetRestageProgress{Criteria: v2action.FleetRestageCriteria{Buildpack: "ruby_buildpack"}}
+			progress.MarkRestaged("app-guid-1")
+			progress.MarkRestaged("app-guid-3")
+			Expect(progress.Save(progressFile)).To(Succeed())
+		})
+
+		It("only restages the remaining apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Skipping 2 app\\(s\\) already restaged according to %s.", progressFile))
+
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+			app, _ := fakeActor.RestageApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("app-guid-2"))
+		})
+	})
+
+	Context("when there are no apps to restage", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsForFleetRestageReturns(nil, nil, nil)
+		})
+
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Nothing to restage."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when some apps fail to restage", func() {
+		BeforeEach(func() {
+			cmd.Concurrency = 1
+			cmd.MaxUnhealthy = 2
+			failingApps["app-guid-1"] = true
+			failingApps["app-guid-2"] = true
+		})
+
+		It("stops once too many apps are unhealthy and saves the progress", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RestageFleetIncompleteError{
+				Failed:       2,
+				Remaining:    1,
+				ProgressFile: progressFile,
+			}))
+			Expect(testUI.Err).To(Say("Failed to restage app app-1: some-reason"))
+			Expect(testUI.Err).To(Say("Failed to restage app app-2: some-reason"))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(2))
+		})
+
+		Context("when the failure has a translatable error", func() {
+			BeforeEach(func() {
+				failingErr = actionerror.StagingTimeoutError{AppName: "app-1", Timeout: time.Minute}
+			})
+
+			It("displays the translated error", func() {
+				Expect(testUI.Err).To(Say("Failed to restage app app-1: Error staging application app-1: timed out after 1 minute"))
+			})
+		})
+
+		Context("when fewer apps fail than the maximum", func() {
+			BeforeEach(func() {
+				cmd.MaxUnhealthy = 5
+				failingApps = map[string]bool{"app-guid-2": true}
+			})
+
+			It("restages the other apps and records them in the progress file", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RestageFleetIncompleteError{
+					Failed:       1,
+					Remaining:    0,
+					ProgressFile: progressFile,
+				}))
+
+				progress, err := v2action.LoadFleetRestageProgress(progressFile, v2action.FleetRestageCriteria{Buildpack: "ruby_buildpack"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(progress.RestagedAppGUIDs).To(Equal([]string{"app-guid-1", "app-guid-3"}))
+			})
+		})
+	})
+
+	Context("when the user does not force the restage", func() {
+		BeforeEach(func() {
+			cmd.Force = false
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("restages the apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really restage 3 app\(s\)\? \[yN\]`))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(3))
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not restage the apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Restage fleet cancelled"))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package v2
+
+import (
+	"encoding/csv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . RolesActor
+
+type RolesActor interface {
+	GetOrganizationRoleAssignments(orgName string) ([]v2action.RoleAssignment, v2action.Warnings, error)
+}
+
+type RolesCommand struct {
+	Organization    string            `short:"o" long:"org" description:"Org to list the roles of (Default: targeted org)"`
+	Output          flag.OutputFormat `long:"output" description:"Output format: csv or table"`
+	usage           interface{}       `usage:"CF_NAME roles [-o ORG] [--output (csv | table)]\n\n   Lists every role held in the org and its spaces.\n\nEXAMPLES:\n   CF_NAME roles -o tenant --output csv > tenant-roles.csv"`
+	relatedCommands interface{}       `related_commands:"user-roles, org-users, space-users, apply-roles"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RolesActor
+}
+
+func (cmd *RolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd RolesCommand) Execute(args []string) error {
+	if cmd.Output.Format == "json" {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--output",
+			ExpectedType: `"csv" or "table"`,
+		}
+	}
+
+	var (
+		err     error
+		orgName string
+	)
+
+	if cmd.Organization == "" {
+		err = cmd.SharedActor.CheckTarget(true, false)
+		orgName = cmd.Config.TargetedOrganization().Name
+	} else {
+		err = cmd.SharedActor.CheckTarget(false, false)
+		orgName = cmd.Organization
+	}
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output.Format == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting roles in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     orgName,
+			"CurrentUser": user.Name,
+		})
+	}
+
+	assignments, warnings, err := cmd.Actor.GetOrganizationRoleAssignments(orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output.Format == "csv" {
+		return cmd.displayCSV(assignments)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(assignments) == 0 {
+		cmd.UI.DisplayText("No roles found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("user"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("role"),
+		},
+	}
+	for _, assignment := range assignments {
+		table = append(table, []string{assignment.Username, assignment.SpaceName, assignment.Role})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd RolesCommand) displayCSV(assignments []v2action.RoleAssignment) error {
+	writer := csv.NewWriter(cmd.UI.GetOut())
+	err := writer.Write([]string{"user", "org", "space", "role"})
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		err = writer.Write([]string{assignment.Username, assignment.OrganizationName, assignment.SpaceName, assignment.Role})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,147 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("roles Command", func() {
+	var (
+		cmd             RolesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRolesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRolesActor)
+
+		cmd = RolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org"})
+
+		fakeActor.GetOrganizationRoleAssignmentsReturns([]v2action.RoleAssignment{
+			{Username: "alice", OrganizationName: "targeted-org", Role: "OrgManager"},
+			{Username: "bob", OrganizationName: "targeted-org", SpaceName: "dev, test", Role: "SpaceDeveloper"},
+		}, v2action.Warnings{"roles-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --output json is provided", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "json"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--output",
+				ExpectedType: `"csv" or "table"`,
+			}))
+			Expect(fakeActor.GetOrganizationRoleAssignmentsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when no org is provided", func() {
+		It("displays the roles in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting roles in org targeted-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`user\s+space\s+role`))
+			Expect(testUI.Out).To(Say(`alice\s+OrgManager`))
+			Expect(testUI.Out).To(Say(`bob\s+dev, test\s+SpaceDeveloper`))
+			Expect(testUI.Err).To(Say("roles-warning"))
+			Expect(fakeActor.GetOrganizationRoleAssignmentsArgsForCall(0)).To(Equal("targeted-org"))
+		})
+
+		Context("when no org is targeted", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+				Expect(fakeActor.GetOrganizationRoleAssignmentsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+		})
+
+		It("displays the roles in that org without requiring a targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(fakeActor.GetOrganizationRoleAssignmentsArgsForCall(0)).To(Equal("other-org"))
+		})
+	})
+
+	Context("when the output format is csv", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "csv"
+		})
+
+		It("displays only the roles as CSV", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				"user,org,space,role\n" +
+					"alice,targeted-org,,OrgManager\n" +
+					"bob,targeted-org,\"dev, test\",SpaceDeveloper\n"))
+			Expect(testUI.Err).To(Say("roles-warning"))
+		})
+	})
+
+	Context("when the org has no roles", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationRoleAssignmentsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No roles found."))
+		})
+	})
+
+	Context("when getting the roles fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationRoleAssignmentsReturns(nil, v2action.Warnings{"roles-warning"}, errors.New("roles-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("roles-error"))
+			Expect(testUI.Err).To(Say("roles-warning"))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/noaa/consumer"
+)
+
+//go:generate counterfeiter . RotateServiceKeyActor
+
+type RotateServiceKeyActor interface {
+	CreateRotatedServiceKeyBySpace(serviceInstanceName string, keyName string, spaceGUID string) (v2action.ServiceKey, v2action.ServiceKey, v2action.Warnings, error)
+	DeleteServiceKey(serviceKey v2action.ServiceKey) (v2action.Warnings, error)
+	RebindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	RestartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+}
+
+type RotateServiceKeyCommand struct {
+	RequiredArgs        flag.ServiceInstanceKey `positional-args:"yes"`
+	Apps                []string                `long:"app" description:"Re-bind and restart this app after creating the new key (can be specified multiple times)"`
+	Force               bool                    `short:"f" description:"Delete the old key without asking for confirmation"`
+	usage               interface{}             `usage:"CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [--app APP_NAME]... [-f]\n\n   Creates a new key with a versioned name (KEY-v2, KEY-v3, ...) and the parameters of\n   the old key, when the service broker supports fetching them. Each app given with\n   --app is unbound from and bound to the service instance again, keeping its binding\n   name and parameters, and then restarted, one app at a time. Restarting stops all\n   instances of an app before starting them again, so each app has downtime. The\n   old key is deleted last.\n\nEXAMPLES:\n   CF_NAME rotate-service-key mydb mykey\n   CF_NAME rotate-service-key mydb mykey --app app-1 --app app-2 -f"`
+	relatedCommands     interface{}             `related_commands:"create-service-key, delete-service-key, service-keys"`
+	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RotateServiceKeyActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *RotateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd RotateServiceKeyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rotating key {{.ServiceKey}} for service instance {{.ServiceInstance}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":         cmd.Config.TargetedOrganization().Name,
+		"SpaceName":       cmd.Config.TargetedSpace().Name,
+		"CurrentUser":     user.Name,
+	})
+
+	oldKey, newKey, warnings, err := cmd.Actor.CreateRotatedServiceKeyBySpace(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Created key {{.ServiceKey}}.", map[string]interface{}{
+		"ServiceKey": newKey.Name,
+	})
+
+	for _, appName := range cmd.Apps {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Rebinding service instance {{.ServiceInstance}} to app {{.AppName}}...", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+			"AppName":         appName,
+		})
+
+		app, warnings, err := cmd.Actor.RebindServiceBySpace(appName, cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}}...", map[string]interface{}{
+			"AppName": appName,
+		})
+
+		messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestartApplication(app, cmd.NOAAClient)
+		err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	if !cmd.Force {
+		deleteKey, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the old key {{.ServiceKey}}?", map[string]interface{}{
+			"ServiceKey": oldKey.Name,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteKey {
+			cmd.UI.DisplayText("Old key {{.ServiceKey}} was not deleted.", map[string]interface{}{
+				"ServiceKey": oldKey.Name,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting key {{.ServiceKey}}...", map[string]interface{}{
+		"ServiceKey": oldKey.Name,
+	})
+
+	warnings, err = cmd.Actor.DeleteServiceKey(oldKey)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
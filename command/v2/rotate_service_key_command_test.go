@@ -0,0 +1,194 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-key Command", func() {
+	var (
+		cmd             RotateServiceKeyCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRotateServiceKeyActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRotateServiceKeyActor)
+
+		cmd = RotateServiceKeyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.ServiceKey = "some-key"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.CreateRotatedServiceKeyBySpaceReturns(
+			v2action.ServiceKey{GUID: "old-key-guid", Name: "some-key"},
+			v2action.ServiceKey{GUID: "new-key-guid", Name: "some-key-v2"},
+			v2action.Warnings{"create-warning"},
+			nil)
+		fakeActor.DeleteServiceKeyReturns(v2action.Warnings{"delete-warning"}, nil)
+		fakeActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appState := make(chan v2action.ApplicationStateChange)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				appState <- v2action.ApplicationStateStopping
+				appState <- v2action.ApplicationStateStarting
+				close(messages)
+				close(logErrs)
+				close(appState)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appState, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when creating the new key fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("create failed")
+			fakeActor.CreateRotatedServiceKeyBySpaceReturns(v2action.ServiceKey{}, v2action.ServiceKey{}, v2action.Warnings{"create-warning"}, expectedErr)
+		})
+
+		It("returns the error and does not delete anything", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when apps are provided and -f is passed", func() {
+		BeforeEach(func() {
+			cmd.Apps = []string{"app-1", "app-2"}
+			cmd.Force = true
+			fakeActor.RebindServiceBySpaceStub = func(appName string, _ string, _ string) (v2action.Application, v2action.Warnings, error) {
+				return v2action.Application{GUID: appName + "-guid", Name: appName}, v2action.Warnings{"rebind-warning"}, nil
+			}
+		})
+
+		It("creates the key, rebinds and restarts each app, then deletes the old key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Rotating key some-key for service instance some-service-instance in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`Created key some-key-v2\.`))
+			Expect(testUI.Out).To(Say(`Rebinding service instance some-service-instance to app app-1\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restarting app app-1\.\.\.`))
+			Expect(testUI.Out).To(Say(`Rebinding service instance some-service-instance to app app-2\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restarting app app-2\.\.\.`))
+			Expect(testUI.Out).To(Say(`Deleting key some-key\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("rebind-warning"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+
+			serviceInstanceName, keyName, spaceGUID := fakeActor.CreateRotatedServiceKeyBySpaceArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(keyName).To(Equal("some-key"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.RebindServiceBySpaceCallCount()).To(Equal(2))
+			appName, serviceInstanceName, spaceGUID := fakeActor.RebindServiceBySpaceArgsForCall(1)
+			Expect(appName).To(Equal("app-2"))
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.RestartApplicationCallCount()).To(Equal(2))
+			app, _ := fakeActor.RestartApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("app-1-guid"))
+
+			Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal(v2action.ServiceKey{GUID: "old-key-guid", Name: "some-key"}))
+		})
+
+		Context("when rebinding an app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("rebind failed")
+				fakeActor.RebindServiceBySpaceStub = nil
+				fakeActor.RebindServiceBySpaceReturns(v2action.Application{}, v2action.Warnings{"rebind-warning"}, expectedErr)
+			})
+
+			It("returns the error and keeps the old key", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when -f is not passed", func() {
+		Context("when the user confirms the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the old key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really delete the old key some-key\?`))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user declines the deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps the old key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Old key some-key was not deleted\.`))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,22 +1,158 @@
 package v2
 
 import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ServiceAccessActor
+
+type ServiceAccessActor interface {
+	AuditServiceAccess(filter v2action.ServiceAccessFilter) ([]v2action.ServiceBrokerAccess, v2action.Warnings, error)
+	GetServiceAccess(filter v2action.ServiceAccessFilter) ([]v2action.ServiceBrokerAccess, v2action.Warnings, error)
+}
+
 type ServiceAccessCommand struct {
 	Broker          string      `short:"b" description:"Access for plans of a particular broker"`
 	Service         string      `short:"e" description:"Access for service name of a particular service offering"`
 	Organization    string      `short:"o" description:"Plans accessible by a particular organization"`
-	usage           interface{} `usage:"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG]"`
+	Audit           bool        `long:"audit" description:"Also list the spaces that have instances of each plan"`
+	usage           interface{} `usage:"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG] [--audit]"`
 	relatedCommands interface{} `related_commands:"marketplace, disable-service-access, enable-service-access, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceAccessActor
 }
 
-func (ServiceAccessCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ServiceAccessCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ServiceAccessCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.displayGettingServiceAccess(user.Name)
+
+	filter := v2action.ServiceAccessFilter{
+		BrokerName:       cmd.Broker,
+		ServiceName:      cmd.Service,
+		OrganizationName: cmd.Organization,
+	}
+
+	var (
+		brokerAccess []v2action.ServiceBrokerAccess
+		warnings     v2action.Warnings
+	)
+	if cmd.Audit {
+		brokerAccess, warnings, err = cmd.Actor.AuditServiceAccess(filter)
+	} else {
+		brokerAccess, warnings, err = cmd.Actor.GetServiceAccess(filter)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	for _, broker := range brokerAccess {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("broker: {{.BrokerName}}", map[string]interface{}{
+			"BrokerName": broker.Name,
+		})
+
+		header := []string{
+			"",
+			cmd.UI.TranslateText("service"),
+			cmd.UI.TranslateText("plan"),
+			cmd.UI.TranslateText("access"),
+			cmd.UI.TranslateText("orgs"),
+		}
+		if cmd.Audit {
+			header = append(header, cmd.UI.TranslateText("spaces with instances"))
+		}
+
+		table := [][]string{header}
+		for _, service := range broker.Services {
+			for _, plan := range service.Plans {
+				row := []string{
+					"",
+					service.Label,
+					plan.Name,
+					cmd.UI.TranslateText(string(plan.AccessLevel())),
+					strings.Join(plan.OrganizationNames, ","),
+				}
+				if cmd.Audit {
+					row = append(row, formatServicePlanUsage(plan.Usage))
+				}
+				table = append(table, row)
+			}
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+
 	return nil
 }
 
-func (ServiceAccessCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd ServiceAccessCommand) displayGettingServiceAccess(userName string) {
+	templateValues := map[string]interface{}{
+		"Broker":       cmd.Broker,
+		"Service":      cmd.Service,
+		"Organization": cmd.Organization,
+		"Username":     userName,
+	}
+
+	switch {
+	case cmd.Broker != "" && cmd.Service != "" && cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for broker {{.Broker}} and service {{.Service}} and organization {{.Organization}} as {{.Username}}...", templateValues)
+	case cmd.Broker != "" && cmd.Service != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for broker {{.Broker}} and service {{.Service}} as {{.Username}}...", templateValues)
+	case cmd.Broker != "" && cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for broker {{.Broker}} and organization {{.Organization}} as {{.Username}}...", templateValues)
+	case cmd.Broker != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for broker {{.Broker}} as {{.Username}}...", templateValues)
+	case cmd.Service != "" && cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for service {{.Service}} and organization {{.Organization}} as {{.Username}}...", templateValues)
+	case cmd.Service != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for service {{.Service}} as {{.Username}}...", templateValues)
+	case cmd.Organization != "":
+		cmd.UI.DisplayTextWithFlavor("Getting service access for organization {{.Organization}} as {{.Username}}...", templateValues)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting service access as {{.Username}}...", templateValues)
+	}
+}
+
+// formatServicePlanUsage formats the spaces that have instances of a plan,
+// e.g. "org-1/space-1 (2), org-2/space-2 (1)".
+func formatServicePlanUsage(usage []v2action.ServicePlanUsage) string {
+	var spaces []string
+	for _, spaceUsage := range usage {
+		spaces = append(spaces, fmt.Sprintf("%s/%s (%d)", spaceUsage.OrganizationName, spaceUsage.SpaceName, spaceUsage.Instances))
+	}
+	return strings.Join(spaces, ", ")
 }
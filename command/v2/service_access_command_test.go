@@ -0,0 +1,156 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-access Command", func() {
+	var (
+		cmd             ServiceAccessCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceAccessActor
+		binaryName      string
+		executeErr      error
+		brokerAccess    []v2action.ServiceBrokerAccess
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceAccessActor)
+
+		cmd = ServiceAccessCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		brokerAccess = []v2action.ServiceBrokerAccess{{
+			ServiceBroker: v2action.ServiceBroker{Name: "some-broker"},
+			Services: []v2action.ServiceAccess{{
+				Service: v2action.Service{Label: "mysql"},
+				Plans: []v2action.ServicePlanAccess{
+					{ServicePlan: v2action.ServicePlan{Name: "small", Public: true}},
+					{
+						ServicePlan:       v2action.ServicePlan{Name: "large"},
+						OrganizationNames: []string{"org-1", "org-2"},
+						Usage: []v2action.ServicePlanUsage{
+							{OrganizationName: "org-1", SpaceName: "space-1", Instances: 2},
+							{OrganizationName: "org-3", SpaceName: "space-3", Instances: 1},
+						},
+					},
+					{ServicePlan: v2action.ServicePlan{Name: "huge"}},
+				},
+			}},
+		}}
+		fakeActor.GetServiceAccessReturns(brokerAccess, v2action.Warnings{"warning-1"}, nil)
+		fakeActor.AuditServiceAccessReturns(brokerAccess, v2action.Warnings{"audit-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when no flags are provided", func() {
+		It("displays the access to every plan grouped by broker", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting service access as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("broker: some-broker"))
+			Expect(testUI.Out).To(Say(`service\s+plan\s+access\s+orgs\n`))
+			Expect(testUI.Out).To(Say(`mysql\s+small\s+all\s*\n`))
+			Expect(testUI.Out).To(Say(`mysql\s+large\s+limited\s+org-1,org-2\n`))
+			Expect(testUI.Out).To(Say(`mysql\s+huge\s+none\s*\n`))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetServiceAccessArgsForCall(0)).To(Equal(v2action.ServiceAccessFilter{}))
+			Expect(fakeActor.AuditServiceAccessCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when filters are provided", func() {
+		BeforeEach(func() {
+			cmd.Broker = "some-broker"
+			cmd.Service = "mysql"
+			cmd.Organization = "org-1"
+		})
+
+		It("filters the service access", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting service access for broker some-broker and service mysql and organization org-1 as some-user\\.\\.\\."))
+			Expect(fakeActor.GetServiceAccessArgsForCall(0)).To(Equal(v2action.ServiceAccessFilter{
+				BrokerName:       "some-broker",
+				ServiceName:      "mysql",
+				OrganizationName: "org-1",
+			}))
+		})
+	})
+
+	Context("when --audit is provided", func() {
+		BeforeEach(func() {
+			cmd.Audit = true
+		})
+
+		It("displays the spaces that have instances of each plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`service\s+plan\s+access\s+orgs\s+spaces with instances\n`))
+			Expect(testUI.Out).To(Say(`mysql\s+large\s+limited\s+org-1,org-2\s+org-1/space-1 \(2\), org-3/space-3 \(1\)\n`))
+			Expect(testUI.Err).To(Say("audit-warning"))
+			Expect(fakeActor.GetServiceAccessCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the service access fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAccessReturns(nil, v2action.Warnings{"warning-1"}, actionerror.ServiceBrokerNotFoundError{Name: "some-broker"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceBrokerNotFoundError{Name: "some-broker"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("no user"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("no user"))
+		})
+	})
+})
@@ -1,19 +1,79 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ServiceBrokersActor
+
+type ServiceBrokersActor interface {
+	GetServiceBrokers() ([]v2action.ServiceBroker, v2action.Warnings, error)
+}
+
 type ServiceBrokersCommand struct {
 	usage           interface{} `usage:"CF_NAME service-brokers"`
 	relatedCommands interface{} `related_commands:"delete-service-broker, disable-service-access, enable-service-access"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceBrokersActor
 }
 
-func (ServiceBrokersCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ServiceBrokersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (ServiceBrokersCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd ServiceBrokersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting service brokers as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	brokers, warnings, err := cmd.Actor.GetServiceBrokers()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(brokers) == 0 {
+		cmd.UI.DisplayText("No service brokers found")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("url"),
+	}}
+	for _, broker := range brokers {
+		table = append(table, []string{broker.Name, broker.BrokerURL})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
 }
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/command"
+	pluginshared "code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+// NewPushHookEvent returns a plugin hook event describing the current target
+// and the desired state of every application being pushed.
+func NewPushHookEvent(name string, config command.Config, appConfigs []pushaction.ApplicationConfig) plugin.HookEvent {
+	event := pluginshared.NewHookEvent(name, config)
+
+	for _, appConfig := range appConfigs {
+		app := appConfig.DesiredApplication
+		hookApp := plugin.HookApplication{
+			Name:        app.Name,
+			Path:        appConfig.Path,
+			DockerImage: app.DockerImage,
+			Stack:       app.Stack.Name,
+			Command:     app.Command.Value,
+			Instances:   app.Instances.Value,
+			Memory:      app.Memory.Value,
+			DiskQuota:   app.DiskQuota.Value,
+		}
+
+		if app.Buildpack.IsSet {
+			hookApp.Buildpacks = []string{app.Buildpack.Value}
+		}
+
+		for _, route := range appConfig.DesiredRoutes {
+			hookApp.Routes = append(hookApp.Routes, route.String())
+		}
+
+		for serviceName := range appConfig.DesiredServices {
+			hookApp.Services = append(hookApp.Services, serviceName)
+		}
+		sort.Strings(hookApp.Services)
+
+		event.Applications = append(event.Applications, hookApp)
+	}
+
+	return event
+}
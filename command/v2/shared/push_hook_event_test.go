@@ -0,0 +1,64 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewPushHookEvent", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+	})
+
+	It("describes the target and the desired applications", func() {
+		appConfigs := []pushaction.ApplicationConfig{
+			{
+				DesiredApplication: pushaction.Application{
+					Application: v2action.Application{
+						Name:      "some-app",
+						Buildpack: types.FilteredString{IsSet: true, Value: "ruby_buildpack"},
+						Instances: types.NullInt{IsSet: true, Value: 3},
+						Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+					},
+					Stack: v2action.Stack{Name: "cflinuxfs2"},
+				},
+				DesiredRoutes: []v2action.Route{
+					{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}},
+				},
+				DesiredServices: map[string]v2action.ServiceInstance{
+					"service-b": {},
+					"service-a": {},
+				},
+				Path: "/some/path",
+			},
+		}
+
+		event := NewPushHookEvent(plugin.PrePushHook, fakeConfig, appConfigs)
+		Expect(event.Name).To(Equal(plugin.PrePushHook))
+		Expect(event.Target.Organization).To(Equal("some-org"))
+		Expect(event.Target.Space).To(Equal("some-space"))
+		Expect(event.Applications).To(Equal([]plugin.HookApplication{
+			{
+				Name:       "some-app",
+				Path:       "/some/path",
+				Buildpacks: []string{"ruby_buildpack"},
+				Stack:      "cflinuxfs2",
+				Instances:  3,
+				Memory:     256,
+				Routes:     []string{"some-app.example.com"},
+				Services:   []string{"service-a", "service-b"},
+			},
+		}))
+	})
+})
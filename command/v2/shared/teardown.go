@@ -0,0 +1,230 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . TeardownActor
+
+type TeardownActor interface {
+	ExecuteTeardownStep(step v2action.TeardownStep) (v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . TeardownNetworkingActor
+
+type TeardownNetworkingActor interface {
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (cfnetworkingaction.Warnings, error)
+}
+
+// NewTeardownNetworkingActor returns a networking actor used to list and
+// remove network policies during a teardown. It returns nil when the V3 or
+// networking APIs are not available.
+func NewTeardownNetworkingActor(config command.Config, ui command.UI) (TeardownNetworkingActor, error) {
+	ccClientV3, uaaClient, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	networkingClient, err := sharedV3.NewNetworkingClient(ccClientV3.NetworkPolicyV1(), config, uaaClient, ui)
+	if err != nil {
+		if _, ok := err.(translatableerror.CFNetworkingEndpointNotFoundError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return cfnetworkingaction.NewActor(networkingClient, v3action.NewActor(ccClientV3, config, nil, nil)), nil
+}
+
+// SpaceTeardown is everything that is destroyed when a space is deleted.
+type SpaceTeardown struct {
+	Inventory v2action.SpaceInventory
+
+	// NetworkPolicies are the network policies between apps of the space.
+	NetworkPolicies []cfnetworkingaction.Policy
+}
+
+// GetSpaceTeardown adds the network policies of the space to its inventory.
+// networkingActor is nil when the networking API is not available.
+func GetSpaceTeardown(ui command.UI, networkingActor TeardownNetworkingActor, inventory v2action.SpaceInventory) (SpaceTeardown, error) {
+	teardown := SpaceTeardown{Inventory: inventory}
+	if networkingActor == nil {
+		return teardown, nil
+	}
+
+	policies, warnings, err := networkingActor.NetworkPoliciesBySpace(inventory.GUID)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return SpaceTeardown{}, err
+	}
+	teardown.NetworkPolicies = policies
+
+	return teardown, nil
+}
+
+// DisplaySpaceTeardown lists the resources of the space that would be deleted.
+func DisplaySpaceTeardown(ui command.UI, teardown SpaceTeardown, networkingAvailable bool) {
+	inventory := teardown.Inventory
+
+	var apps []string
+	for _, app := range inventory.Applications {
+		apps = append(apps, app.Name)
+	}
+
+	var routes []string
+	for _, route := range inventory.Routes {
+		routes = append(routes, route.String())
+	}
+
+	var instances []string
+	for _, instance := range inventory.ServiceInstances {
+		var details []string
+		if len(instance.Bindings) > 0 {
+			var appNames []string
+			for _, binding := range instance.Bindings {
+				appNames = append(appNames, binding.ApplicationName)
+			}
+			details = append(details, ui.TranslateText("bound to {{.AppNames}}", map[string]interface{}{
+				"AppNames": strings.Join(appNames, ", "),
+			}))
+		}
+		if len(instance.Keys) > 0 {
+			var keyNames []string
+			for _, key := range instance.Keys {
+				keyNames = append(keyNames, key.Name)
+			}
+			details = append(details, ui.TranslateText("keys {{.KeyNames}}", map[string]interface{}{
+				"KeyNames": strings.Join(keyNames, ", "),
+			}))
+		}
+
+		if len(details) == 0 {
+			instances = append(instances, instance.Name)
+		} else {
+			instances = append(instances, fmt.Sprintf("%s (%s)", instance.Name, strings.Join(details, "; ")))
+		}
+	}
+
+	policies := ui.TranslateText("unavailable")
+	if networkingAvailable {
+		var policyDescriptions []string
+		for _, policy := range teardown.NetworkPolicies {
+			policyDescriptions = append(policyDescriptions, networkPolicyDescription(policy))
+		}
+		policies = strings.Join(policyDescriptions, ", ")
+	}
+
+	ui.DisplayKeyValueTable("", [][]string{
+		{ui.TranslateText("space:"), inventory.Name},
+		{ui.TranslateText("apps:"), strings.Join(apps, ", ")},
+		{ui.TranslateText("routes:"), strings.Join(routes, ", ")},
+		{ui.TranslateText("service instances:"), strings.Join(instances, ", ")},
+		{ui.TranslateText("network policies:"), policies},
+	}, 3)
+}
+
+// TeardownSpaces deletes the resources of the spaces one at a time, in an
+// order that satisfies their dependencies, and displays the outcome of every
+// deletion. A failed deletion does not stop the teardown, but a space is only
+// deleted once all its resources are, and the final steps only run once every
+// space is deleted. Since resources that are already deleted are skipped,
+// running the teardown again resumes it.
+func TeardownSpaces(ui command.UI, actor TeardownActor, networkingActor TeardownNetworkingActor, spaces []SpaceTeardown, finalSteps ...v2action.TeardownStep) error {
+	var failures int
+
+	for _, space := range spaces {
+		spaceFailures := 0
+
+		for _, policy := range space.NetworkPolicies {
+			displayTeardownStep(ui, "network policy", networkPolicyDescription(policy))
+			warnings, err := networkingActor.RemoveNetworkPolicy(space.Inventory.GUID, policy.SourceName, policy.DestinationName, policy.Protocol, policy.StartPort, policy.EndPort)
+			if !displayTeardownResult(ui, warnings, err) {
+				spaceFailures++
+			}
+		}
+
+		for _, step := range space.Inventory.TeardownSteps() {
+			if step.Type == v2action.TeardownSpace && spaceFailures > 0 {
+				displaySkippedTeardownStep(ui, step)
+				continue
+			}
+
+			if !executeTeardownStep(ui, actor, step) {
+				spaceFailures++
+			}
+		}
+
+		failures += spaceFailures
+	}
+
+	for _, step := range finalSteps {
+		if failures > 0 {
+			displaySkippedTeardownStep(ui, step)
+			continue
+		}
+
+		if !executeTeardownStep(ui, actor, step) {
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		return translatableerror.TeardownIncompleteError{Failures: failures}
+	}
+	return nil
+}
+
+func executeTeardownStep(ui command.UI, actor TeardownActor, step v2action.TeardownStep) bool {
+	displayTeardownStep(ui, string(step.Type), step.Name)
+	warnings, err := actor.ExecuteTeardownStep(step)
+	return displayTeardownResult(ui, warnings, err)
+}
+
+func displayTeardownStep(ui command.UI, resourceType string, name string) {
+	ui.DisplayText("Deleting {{.ResourceType}} {{.Name}}...", map[string]interface{}{
+		"ResourceType": ui.TranslateText(resourceType),
+		"Name":         name,
+	})
+}
+
+func displaySkippedTeardownStep(ui command.UI, step v2action.TeardownStep) {
+	ui.DisplayWarning("Skipping deletion of {{.ResourceType}} {{.Name}} because some of its resources could not be deleted.", map[string]interface{}{
+		"ResourceType": ui.TranslateText(string(step.Type)),
+		"Name":         step.Name,
+	})
+}
+
+// displayTeardownResult displays the outcome of a deletion and returns
+// whether it succeeded.
+func displayTeardownResult(ui command.UI, warnings []string, err error) bool {
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		ui.DisplayWarning("FAILED: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return false
+	}
+
+	ui.DisplayOK()
+	return true
+}
+
+func networkPolicyDescription(policy cfnetworkingaction.Policy) string {
+	ports := fmt.Sprintf("%d", policy.StartPort)
+	if policy.StartPort != policy.EndPort {
+		ports = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+	}
+	return fmt.Sprintf("%s -> %s (%s %s)", policy.SourceName, policy.DestinationName, policy.Protocol, ports)
+}
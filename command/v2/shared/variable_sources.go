@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// NewVariableSources returns the sources of manifest ((variables)) set by the
+// --vars-file, --var and --vars-from-env flags. Variables from the
+// environment are read from CF_VAR_<name> environment variables.
+func NewVariableSources(pathsToVarsFiles []flag.PathWithExistenceCheck, vars []template.VarKV, varsFromEnv bool) (manifest.VariableSources, error) {
+	sources := manifest.VariableSources{Vars: vars}
+	for _, path := range pathsToVarsFiles {
+		sources.PathsToVarsFiles = append(sources.PathsToVarsFiles, string(path))
+	}
+
+	if varsFromEnv {
+		envVars := template.VarsEnvArg{}
+		err := envVars.UnmarshalFlag("CF_VAR")
+		if err != nil {
+			return manifest.VariableSources{}, err
+		}
+		sources.Environment = envVars.Vars
+	}
+
+	return sources, nil
+}
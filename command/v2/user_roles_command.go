@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . UserRolesActor
+
+type UserRolesActor interface {
+	GetUserRoleAssignments(username string) ([]v2action.RoleAssignment, v2action.Warnings, error)
+}
+
+type UserRolesCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	usage           interface{}   `usage:"CF_NAME user-roles USERNAME\n\n   Lists the roles USERNAME holds in every org and space you can see."`
+	relatedCommands interface{}   `related_commands:"roles, org-users, space-users, set-org-role, set-space-role, apply-roles"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UserRolesActor
+}
+
+func (cmd *UserRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd UserRolesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting roles of user {{.TargetUser}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"CurrentUser": user.Name,
+	})
+
+	assignments, warnings, err := cmd.Actor.GetUserRoleAssignments(cmd.RequiredArgs.Username)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(assignments) == 0 {
+		cmd.UI.DisplayText("No roles found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("role"),
+		},
+	}
+	for _, assignment := range assignments {
+		table = append(table, []string{assignment.OrganizationName, assignment.SpaceName, assignment.Role})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
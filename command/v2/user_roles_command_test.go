@@ -0,0 +1,99 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("user-roles Command", func() {
+	var (
+		cmd             UserRolesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUserRolesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUserRolesActor)
+
+		cmd = UserRolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "alice"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user has roles", func() {
+		BeforeEach(func() {
+			fakeActor.GetUserRoleAssignmentsReturns([]v2action.RoleAssignment{
+				{Username: "alice", OrganizationName: "org-1", Role: "OrgManager"},
+				{Username: "alice", OrganizationName: "org-1", SpaceName: "dev", Role: "SpaceDeveloper"},
+			}, v2action.Warnings{"roles-warning"}, nil)
+		})
+
+		It("displays the roles of the user", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting roles of user alice as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`org\s+space\s+role`))
+			Expect(testUI.Out).To(Say(`org-1\s+OrgManager`))
+			Expect(testUI.Out).To(Say(`org-1\s+dev\s+SpaceDeveloper`))
+			Expect(testUI.Err).To(Say("roles-warning"))
+			Expect(fakeActor.GetUserRoleAssignmentsArgsForCall(0)).To(Equal("alice"))
+		})
+	})
+
+	Context("when the user has no roles", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No roles found."))
+		})
+	})
+
+	Context("when getting the roles fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetUserRoleAssignmentsReturns(nil, v2action.Warnings{"roles-warning"}, errors.New("roles-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("roles-error"))
+			Expect(testUI.Err).To(Say("roles-warning"))
+		})
+	})
+})
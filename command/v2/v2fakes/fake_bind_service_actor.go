@@ -35,6 +35,21 @@ type FakeBindServiceActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ValidateServiceBindingParametersStub        func(serviceInstanceName string, spaceGUID string, parameters map[string]interface{}) (v2action.Warnings, error)
+	validateServiceBindingParametersMutex       sync.RWMutex
+	validateServiceBindingParametersArgsForCall []struct {
+		serviceInstanceName string
+		spaceGUID           string
+		parameters          map[string]interface{}
+	}
+	validateServiceBindingParametersReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	validateServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -134,6 +149,59 @@ func (fake *FakeBindServiceActor) CloudControllerAPIVersionReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeBindServiceActor) ValidateServiceBindingParameters(serviceInstanceName string, spaceGUID string, parameters map[string]interface{}) (v2action.Warnings, error) {
+	fake.validateServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.validateServiceBindingParametersReturnsOnCall[len(fake.validateServiceBindingParametersArgsForCall)]
+	fake.validateServiceBindingParametersArgsForCall = append(fake.validateServiceBindingParametersArgsForCall, struct {
+		serviceInstanceName string
+		spaceGUID           string
+		parameters          map[string]interface{}
+	}{serviceInstanceName, spaceGUID, parameters})
+	fake.recordInvocation("ValidateServiceBindingParameters", []interface{}{serviceInstanceName, spaceGUID, parameters})
+	fake.validateServiceBindingParametersMutex.Unlock()
+	if fake.ValidateServiceBindingParametersStub != nil {
+		return fake.ValidateServiceBindingParametersStub(serviceInstanceName, spaceGUID, parameters)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.validateServiceBindingParametersReturns.result1, fake.validateServiceBindingParametersReturns.result2
+}
+
+func (fake *FakeBindServiceActor) ValidateServiceBindingParametersCallCount() int {
+	fake.validateServiceBindingParametersMutex.RLock()
+	defer fake.validateServiceBindingParametersMutex.RUnlock()
+	return len(fake.validateServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeBindServiceActor) ValidateServiceBindingParametersArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.validateServiceBindingParametersMutex.RLock()
+	defer fake.validateServiceBindingParametersMutex.RUnlock()
+	return fake.validateServiceBindingParametersArgsForCall[i].serviceInstanceName, fake.validateServiceBindingParametersArgsForCall[i].spaceGUID, fake.validateServiceBindingParametersArgsForCall[i].parameters
+}
+
+func (fake *FakeBindServiceActor) ValidateServiceBindingParametersReturns(result1 v2action.Warnings, result2 error) {
+	fake.ValidateServiceBindingParametersStub = nil
+	fake.validateServiceBindingParametersReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBindServiceActor) ValidateServiceBindingParametersReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.ValidateServiceBindingParametersStub = nil
+	if fake.validateServiceBindingParametersReturnsOnCall == nil {
+		fake.validateServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.validateServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -141,6 +209,8 @@ func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
 	defer fake.bindServiceBySpaceMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.validateServiceBindingParametersMutex.RLock()
+	defer fake.validateServiceBindingParametersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
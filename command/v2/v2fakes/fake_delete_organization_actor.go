@@ -22,6 +22,34 @@ type FakeDeleteOrganizationActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	ExecuteTeardownStepStub        func(step v2action.TeardownStep) (v2action.Warnings, error)
+	executeTeardownStepMutex       sync.RWMutex
+	executeTeardownStepArgsForCall []struct {
+		step v2action.TeardownStep
+	}
+	executeTeardownStepReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	executeTeardownStepReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GetOrganizationInventoryStub        func(orgName string) (v2action.OrganizationInventory, v2action.Warnings, error)
+	getOrganizationInventoryMutex       sync.RWMutex
+	getOrganizationInventoryArgsForCall []struct {
+		orgName string
+	}
+	getOrganizationInventoryReturns struct {
+		result1 v2action.OrganizationInventory
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationInventoryReturnsOnCall map[int]struct {
+		result1 v2action.OrganizationInventory
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -77,11 +105,120 @@ func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeDeleteOrganizationActor) ExecuteTeardownStep(step v2action.TeardownStep) (v2action.Warnings, error) {
+	fake.executeTeardownStepMutex.Lock()
+	ret, specificReturn := fake.executeTeardownStepReturnsOnCall[len(fake.executeTeardownStepArgsForCall)]
+	fake.executeTeardownStepArgsForCall = append(fake.executeTeardownStepArgsForCall, struct {
+		step v2action.TeardownStep
+	}{step})
+	fake.recordInvocation("ExecuteTeardownStep", []interface{}{step})
+	fake.executeTeardownStepMutex.Unlock()
+	if fake.ExecuteTeardownStepStub != nil {
+		return fake.ExecuteTeardownStepStub(step)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.executeTeardownStepReturns.result1, fake.executeTeardownStepReturns.result2
+}
+
+func (fake *FakeDeleteOrganizationActor) ExecuteTeardownStepCallCount() int {
+	fake.executeTeardownStepMutex.RLock()
+	defer fake.executeTeardownStepMutex.RUnlock()
+	return len(fake.executeTeardownStepArgsForCall)
+}
+
+func (fake *FakeDeleteOrganizationActor) ExecuteTeardownStepArgsForCall(i int) v2action.TeardownStep {
+	fake.executeTeardownStepMutex.RLock()
+	defer fake.executeTeardownStepMutex.RUnlock()
+	return fake.executeTeardownStepArgsForCall[i].step
+}
+
+func (fake *FakeDeleteOrganizationActor) ExecuteTeardownStepReturns(result1 v2action.Warnings, result2 error) {
+	fake.ExecuteTeardownStepStub = nil
+	fake.executeTeardownStepReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteOrganizationActor) ExecuteTeardownStepReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.ExecuteTeardownStepStub = nil
+	if fake.executeTeardownStepReturnsOnCall == nil {
+		fake.executeTeardownStepReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.executeTeardownStepReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationInventory(orgName string) (v2action.OrganizationInventory, v2action.Warnings, error) {
+	fake.getOrganizationInventoryMutex.Lock()
+	ret, specificReturn := fake.getOrganizationInventoryReturnsOnCall[len(fake.getOrganizationInventoryArgsForCall)]
+	fake.getOrganizationInventoryArgsForCall = append(fake.getOrganizationInventoryArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("GetOrganizationInventory", []interface{}{orgName})
+	fake.getOrganizationInventoryMutex.Unlock()
+	if fake.GetOrganizationInventoryStub != nil {
+		return fake.GetOrganizationInventoryStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationInventoryReturns.result1, fake.getOrganizationInventoryReturns.result2, fake.getOrganizationInventoryReturns.result3
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationInventoryCallCount() int {
+	fake.getOrganizationInventoryMutex.RLock()
+	defer fake.getOrganizationInventoryMutex.RUnlock()
+	return len(fake.getOrganizationInventoryArgsForCall)
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationInventoryArgsForCall(i int) string {
+	fake.getOrganizationInventoryMutex.RLock()
+	defer fake.getOrganizationInventoryMutex.RUnlock()
+	return fake.getOrganizationInventoryArgsForCall[i].orgName
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationInventoryReturns(result1 v2action.OrganizationInventory, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationInventoryStub = nil
+	fake.getOrganizationInventoryReturns = struct {
+		result1 v2action.OrganizationInventory
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationInventoryReturnsOnCall(i int, result1 v2action.OrganizationInventory, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationInventoryStub = nil
+	if fake.getOrganizationInventoryReturnsOnCall == nil {
+		fake.getOrganizationInventoryReturnsOnCall = make(map[int]struct {
+			result1 v2action.OrganizationInventory
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationInventoryReturnsOnCall[i] = struct {
+		result1 v2action.OrganizationInventory
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDeleteOrganizationActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.executeTeardownStepMutex.RLock()
+	defer fake.executeTeardownStepMutex.RUnlock()
+	fake.getOrganizationInventoryMutex.RLock()
+	defer fake.getOrganizationInventoryMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -23,6 +23,35 @@ type FakeDeleteSpaceActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	ExecuteTeardownStepStub        func(step v2action.TeardownStep) (v2action.Warnings, error)
+	executeTeardownStepMutex       sync.RWMutex
+	executeTeardownStepArgsForCall []struct {
+		step v2action.TeardownStep
+	}
+	executeTeardownStepReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	executeTeardownStepReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GetSpaceInventoryStub        func(spaceName string, orgName string) (v2action.SpaceInventory, v2action.Warnings, error)
+	getSpaceInventoryMutex       sync.RWMutex
+	getSpaceInventoryArgsForCall []struct {
+		spaceName string
+		orgName   string
+	}
+	getSpaceInventoryReturns struct {
+		result1 v2action.SpaceInventory
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceInventoryReturnsOnCall map[int]struct {
+		result1 v2action.SpaceInventory
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -79,11 +108,121 @@ func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameReturnsOnC
 	}{result1, result2}
 }
 
+func (fake *FakeDeleteSpaceActor) ExecuteTeardownStep(step v2action.TeardownStep) (v2action.Warnings, error) {
+	fake.executeTeardownStepMutex.Lock()
+	ret, specificReturn := fake.executeTeardownStepReturnsOnCall[len(fake.executeTeardownStepArgsForCall)]
+	fake.executeTeardownStepArgsForCall = append(fake.executeTeardownStepArgsForCall, struct {
+		step v2action.TeardownStep
+	}{step})
+	fake.recordInvocation("ExecuteTeardownStep", []interface{}{step})
+	fake.executeTeardownStepMutex.Unlock()
+	if fake.ExecuteTeardownStepStub != nil {
+		return fake.ExecuteTeardownStepStub(step)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.executeTeardownStepReturns.result1, fake.executeTeardownStepReturns.result2
+}
+
+func (fake *FakeDeleteSpaceActor) ExecuteTeardownStepCallCount() int {
+	fake.executeTeardownStepMutex.RLock()
+	defer fake.executeTeardownStepMutex.RUnlock()
+	return len(fake.executeTeardownStepArgsForCall)
+}
+
+func (fake *FakeDeleteSpaceActor) ExecuteTeardownStepArgsForCall(i int) v2action.TeardownStep {
+	fake.executeTeardownStepMutex.RLock()
+	defer fake.executeTeardownStepMutex.RUnlock()
+	return fake.executeTeardownStepArgsForCall[i].step
+}
+
+func (fake *FakeDeleteSpaceActor) ExecuteTeardownStepReturns(result1 v2action.Warnings, result2 error) {
+	fake.ExecuteTeardownStepStub = nil
+	fake.executeTeardownStepReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteSpaceActor) ExecuteTeardownStepReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.ExecuteTeardownStepStub = nil
+	if fake.executeTeardownStepReturnsOnCall == nil {
+		fake.executeTeardownStepReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.executeTeardownStepReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceInventory(spaceName string, orgName string) (v2action.SpaceInventory, v2action.Warnings, error) {
+	fake.getSpaceInventoryMutex.Lock()
+	ret, specificReturn := fake.getSpaceInventoryReturnsOnCall[len(fake.getSpaceInventoryArgsForCall)]
+	fake.getSpaceInventoryArgsForCall = append(fake.getSpaceInventoryArgsForCall, struct {
+		spaceName string
+		orgName   string
+	}{spaceName, orgName})
+	fake.recordInvocation("GetSpaceInventory", []interface{}{spaceName, orgName})
+	fake.getSpaceInventoryMutex.Unlock()
+	if fake.GetSpaceInventoryStub != nil {
+		return fake.GetSpaceInventoryStub(spaceName, orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceInventoryReturns.result1, fake.getSpaceInventoryReturns.result2, fake.getSpaceInventoryReturns.result3
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceInventoryCallCount() int {
+	fake.getSpaceInventoryMutex.RLock()
+	defer fake.getSpaceInventoryMutex.RUnlock()
+	return len(fake.getSpaceInventoryArgsForCall)
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceInventoryArgsForCall(i int) (string, string) {
+	fake.getSpaceInventoryMutex.RLock()
+	defer fake.getSpaceInventoryMutex.RUnlock()
+	return fake.getSpaceInventoryArgsForCall[i].spaceName, fake.getSpaceInventoryArgsForCall[i].orgName
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceInventoryReturns(result1 v2action.SpaceInventory, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceInventoryStub = nil
+	fake.getSpaceInventoryReturns = struct {
+		result1 v2action.SpaceInventory
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceInventoryReturnsOnCall(i int, result1 v2action.SpaceInventory, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceInventoryStub = nil
+	if fake.getSpaceInventoryReturnsOnCall == nil {
+		fake.getSpaceInventoryReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceInventory
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceInventoryReturnsOnCall[i] = struct {
+		result1 v2action.SpaceInventory
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDeleteSpaceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
 	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	fake.executeTeardownStepMutex.RLock()
+	defer fake.executeTeardownStepMutex.RUnlock()
+	fake.getSpaceInventoryMutex.RLock()
+	defer fake.getSpaceInventoryMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
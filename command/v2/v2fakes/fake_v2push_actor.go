@@ -69,11 +69,11 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
-	ReadManifestStub        func(pathToManifest string, pathToVarsFile string) ([]manifest.Application, error)
+	ReadManifestStub        func(pathToManifest string, sources manifest.VariableSources) ([]manifest.Application, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
 		pathToManifest string
-		pathToVarsFile string
+		sources        manifest.VariableSources
 	}
 	readManifestReturns struct {
 		result1 []manifest.Application
@@ -304,17 +304,17 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeV2PushActor) ReadManifest(pathToManifest string, pathToVarsFile string) ([]manifest.Application, error) {
+func (fake *FakeV2PushActor) ReadManifest(pathToManifest string, sources manifest.VariableSources) ([]manifest.Application, error) {
 	fake.readManifestMutex.Lock()
 	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
 	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
 		pathToManifest string
-		pathToVarsFile string
-	}{pathToManifest, pathToVarsFile})
-	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest, pathToVarsFile})
+		sources        manifest.VariableSources
+	}{pathToManifest, sources})
+	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest, sources})
 	fake.readManifestMutex.Unlock()
 	if fake.ReadManifestStub != nil {
-		return fake.ReadManifestStub(pathToManifest, pathToVarsFile)
+		return fake.ReadManifestStub(pathToManifest, sources)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -328,10 +328,10 @@ func (fake *FakeV2PushActor) ReadManifestCallCount() int {
 	return len(fake.readManifestArgsForCall)
 }
 
-func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) (string, string) {
+func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) (string, manifest.VariableSources) {
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
-	return fake.readManifestArgsForCall[i].pathToManifest, fake.readManifestArgsForCall[i].pathToVarsFile
+	return fake.readManifestArgsForCall[i].pathToManifest, fake.readManifestArgsForCall[i].sources
 }
 
 func (fake *FakeV2PushActor) ReadManifestReturns(result1 []manifest.Application, result2 error) {
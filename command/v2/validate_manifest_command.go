@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . ValidateManifestActor
+
+type ValidateManifestActor interface {
+	ValidateManifest(pathToManifest string, sources manifest.VariableSources) ([]manifest.Problem, error)
+}
+
+type ValidateManifestCommand struct {
+	PathToManifest   flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest, or to a directory containing manifest.yml (Default: manifest.yml in the current directory)"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times, later files take precedence"`
+	Vars             []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times, takes precedence over vars files"`
+	VarsFromEnv      bool                          `long:"vars-from-env" description:"Read variables for substitution from CF_VAR_<name> environment variables, which vars files and --var take precedence over"`
+	envCFVar         interface{}                   `environmentName:"CF_VAR_*" environmentDescription:"Variable for manifest substitution, used with --vars-from-env"`
+	usage            interface{}                   `usage:"CF_NAME validate-manifest [-f MANIFEST_PATH] [--vars-file VARS_FILE_PATH]... [--var KEY=VALUE]... [--vars-from-env]\n\n   Reports every problem found in the manifest with its line and column, using the same\n   rules as push. Exits with a non-zero status when problems are found.\n\nEXAMPLES:\n   CF_NAME validate-manifest -f deploy/manifest.yml --vars-file deploy/staging-vars.yml"`
+	relatedCommands  interface{}                   `related_commands:"push, create-app-manifest"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ValidateManifestActor
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pushaction.NewActor(nil, sharedaction.NewActor(config))
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	pathToManifest, err := cmd.findManifest()
+	if err != nil {
+		return err
+	}
+
+	sources, err := shared.NewVariableSources(cmd.PathsToVarsFiles, cmd.Vars, cmd.VarsFromEnv)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Validating manifest {{.Path}}...", map[string]interface{}{
+		"Path": pathToManifest,
+	})
+
+	problems, err := cmd.Actor.ValidateManifest(pathToManifest, sources)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(problems) == 0 {
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	for _, problem := range problems {
+		position := map[string]interface{}{
+			"Path":    pathToManifest,
+			"Line":    problem.Line,
+			"Column":  problem.Column,
+			"Message": problem.Message,
+		}
+		switch {
+		case problem.Line == 0:
+			cmd.UI.DisplayText("{{.Path}}: {{.Message}}", position)
+		case problem.Column == 0:
+			cmd.UI.DisplayText("{{.Path}}:{{.Line}}: {{.Message}}", position)
+		default:
+			cmd.UI.DisplayText("{{.Path}}:{{.Line}}:{{.Column}}: {{.Message}}", position)
+		}
+	}
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.InvalidManifestError{Path: pathToManifest, Problems: len(problems)}
+}
+
+// findManifest returns the path of the manifest provided with -f, looking for
+// manifest.yml or manifest.yaml when it is a directory, or in the current
+// directory when -f is not provided.
+func (cmd ValidateManifestCommand) findManifest() (string, error) {
+	dir := string(cmd.PathToManifest)
+	if dir == "" {
+		var err error
+		dir, err = os.Getwd()
+		if err != nil {
+			return "", err
+		}
+	} else {
+		fileInfo, err := os.Stat(dir)
+		if err != nil {
+			return "", err
+		}
+		if !fileInfo.IsDir() {
+			return dir, nil
+		}
+	}
+
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		pathToManifest := filepath.Join(dir, name)
+		if _, err := os.Stat(pathToManifest); err == nil {
+			return pathToManifest, nil
+		}
+	}
+
+	return "", translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: dir}
+}
@@ -0,0 +1,138 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd            ValidateManifestCommand
+		testUI         *ui.UI
+		fakeConfig     *commandfakes.FakeConfig
+		fakeActor      *v2fakes.FakeValidateManifestActor
+		manifestDir    string
+		pathToManifest string
+		executeErr     error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeValidateManifestActor)
+
+		cmd = ValidateManifestCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		var err error
+		manifestDir, err = ioutil.TempDir("", "validate-manifest-command-test")
+		Expect(err).ToNot(HaveOccurred())
+		pathToManifest = filepath.Join(manifestDir, "manifest.yml")
+		Expect(ioutil.WriteFile(pathToManifest, []byte("---\n"), 0600)).To(Succeed())
+
+		cmd.PathToManifest = flag.PathWithExistenceCheck(pathToManifest)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(manifestDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the manifest has no problems", func() {
+		BeforeEach(func() {
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars-1.yml", "vars-2.yml"}
+			cmd.Vars = []template.VarKV{{Name: "name", Value: "some-app"}}
+		})
+
+		It("validates the manifest with the provided variables and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(1))
+			path, sources := fakeActor.ValidateManifestArgsForCall(0)
+			Expect(path).To(Equal(pathToManifest))
+			Expect(sources).To(Equal(manifest.VariableSources{
+				PathsToVarsFiles: []string{"vars-1.yml", "vars-2.yml"},
+				Vars:             []template.VarKV{{Name: "name", Value: "some-app"}},
+			}))
+
+			Expect(testUI.Out).To(Say("Validating manifest %s\\.\\.\\.", regexp.QuoteMeta(pathToManifest)))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the manifest has problems", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns([]manifest.Problem{
+				{Message: "some-syntax-problem"},
+				{Line: 3, Message: "some-line-problem"},
+				{Line: 4, Column: 3, Message: "some-field-problem"},
+			}, nil)
+		})
+
+		It("displays every problem and returns an InvalidManifestError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidManifestError{Path: pathToManifest, Problems: 3}))
+
+			Expect(testUI.Out).To(Say("%s: some-syntax-problem", regexp.QuoteMeta(pathToManifest)))
+			Expect(testUI.Out).To(Say("%s:3: some-line-problem", regexp.QuoteMeta(pathToManifest)))
+			Expect(testUI.Out).To(Say("%s:4:3: some-field-problem", regexp.QuoteMeta(pathToManifest)))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the manifest cannot be validated", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.ValidateManifestReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the path is a directory", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = flag.PathWithExistenceCheck(manifestDir)
+		})
+
+		It("validates the manifest.yml in the directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			path, _ := fakeActor.ValidateManifestArgsForCall(0)
+			Expect(path).To(Equal(pathToManifest))
+		})
+
+		Context("when the directory has no manifest", func() {
+			BeforeEach(func() {
+				Expect(os.Remove(pathToManifest)).To(Succeed())
+			})
+
+			It("returns a ManifestFileNotFoundInDirectoryError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: manifestDir}))
+				Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
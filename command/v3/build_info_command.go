@@ -0,0 +1,241 @@
+package v3
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/blang/semver"
+)
+
+//go:generate counterfeiter . BuildInfoActor
+
+type BuildInfoActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetCurrentDropletByApplication(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . BuildInfoV2Actor
+
+type BuildInfoV2Actor interface {
+	GetBuildpacks() ([]v2action.Buildpack, v2action.Warnings, error)
+}
+
+type BuildInfoCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME build-info APP_NAME\n\n   Shows the buildpacks, stack and start command of the app's current droplet and compares the version of each buildpack with the version of the admin buildpack with the same name. Apps staged with an older version of a buildpack should be restaged to pick up its fixes."`
+	relatedCommands interface{}  `related_commands:"buildpacks, restage, v3-droplets"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildInfoActor
+	V2Actor     BuildInfoV2Actor
+}
+
+func (cmd *BuildInfoCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.V2Actor = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd BuildInfoCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting build info for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	droplet, warnings, err := cmd.Actor.GetCurrentDropletByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	buildpacks, v2Warnings, err := cmd.V2Actor.GetBuildpacks()
+	cmd.UI.DisplayWarnings(v2Warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displayDroplet(droplet)
+
+	if len(droplet.Buildpacks) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No buildpacks were used to stage the current droplet.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	if cmd.displayBuildpacks(droplet, buildpacks) {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Use '{{.Command}}' to stage the app with the latest buildpacks.", map[string]interface{}{
+			"Command": cmd.Config.BinaryName() + " restage " + cmd.RequiredArgs.AppName,
+		})
+	}
+
+	return nil
+}
+
+func (cmd BuildInfoCommand) displayDroplet(droplet v3action.Droplet) {
+	created := droplet.CreatedAt
+	if t, err := time.Parse(time.RFC3339, droplet.CreatedAt); err == nil {
+		created = cmd.UI.UserFriendlyDate(t)
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("droplet guid:"), droplet.GUID},
+		{cmd.UI.TranslateText("staged:"), created},
+		{cmd.UI.TranslateText("stack:"), droplet.Stack},
+	}
+	if droplet.Image != "" {
+		table = append(table, []string{cmd.UI.TranslateText("docker image:"), droplet.Image})
+	}
+	table = append(table, []string{cmd.UI.TranslateText("start command:"), droplet.ProcessTypes[constant.ProcessTypeWeb]})
+
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+}
+
+// displayBuildpacks displays the version of each buildpack that staged the
+// droplet next to the version of the matching admin buildpack. It returns true
+// when any of them were staged with an older version than the admin
+// buildpack's.
+func (cmd BuildInfoCommand) displayBuildpacks(droplet v3action.Droplet, adminBuildpacks []v2action.Buildpack) bool {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("buildpack"),
+			cmd.UI.TranslateText("detected"),
+			cmd.UI.TranslateText("staged version"),
+			cmd.UI.TranslateText("latest version"),
+			cmd.UI.TranslateText("status"),
+		},
+	}
+
+	needsRestage := false
+	for _, buildpack := range droplet.Buildpacks {
+		latestVersion := latestBuildpackVersion(buildpack.Name, droplet.Stack, adminBuildpacks)
+
+		var status string
+		comparison, comparable := compareBuildpackVersions(buildpack.Version, latestVersion)
+		switch {
+		case !comparable:
+			status = cmd.UI.TranslateText("unknown")
+		case comparison < 0:
+			status = cmd.UI.TranslateText("restage recommended")
+			needsRestage = true
+		case comparison > 0:
+			status = cmd.UI.TranslateText("newer")
+		default:
+			status = cmd.UI.TranslateText("up to date")
+		}
+
+		table = append(table, []string{
+			buildpack.Name,
+			buildpack.DetectOutput,
+			buildpack.Version,
+			latestVersion,
+			status,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return needsRestage
+}
+
+// compareBuildpackVersions compares the staged version of a buildpack with
+// the latest one, returning -1, 0 or 1 like semver.Version.Compare. Versions
+// that are not semantic versions can only be compared for equality; false is
+// returned when the versions cannot be compared.
+func compareBuildpackVersions(staged string, latest string) (int, bool) {
+	if staged == "" || latest == "" {
+		return 0, false
+	}
+
+	stagedVersion, stagedErr := semver.ParseTolerant(staged)
+	latestVersion, latestErr := semver.ParseTolerant(latest)
+	if stagedErr != nil || latestErr != nil {
+		if staged == latest {
+			return 0, true
+		}
+		return 0, false
+	}
+
+	return stagedVersion.Compare(latestVersion), true
+}
+
+// latestBuildpackVersion returns the version of the enabled admin buildpack
+// with the given name, preferring the one for the given stack over one that
+// can be used with any stack.
+func latestBuildpackVersion(name string, stack string, adminBuildpacks []v2action.Buildpack) string {
+	var version string
+	for _, buildpack := range adminBuildpacks {
+		if buildpack.Name != name || !buildpack.Enabled {
+			continue
+		}
+
+		switch buildpack.Stack {
+		case stack:
+			return buildpack.Version()
+		case "":
+			version = buildpack.Version()
+		}
+	}
+
+	return version
+}
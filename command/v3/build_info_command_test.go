@@ -0,0 +1,200 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("build-info Command", func() {
+	var (
+		cmd             v3.BuildInfoCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeBuildInfoActor
+		fakeV2Actor     *v3fakes.FakeBuildInfoV2Actor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeBuildInfoActor)
+		fakeV2Actor = new(v3fakes.FakeBuildInfoV2Actor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.BuildInfoCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			V2Actor:     fakeV2Actor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{Name: "some-app", GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetCurrentDropletByApplicationReturns(v3action.Droplet{
+			GUID:      "some-droplet-guid",
+			CreatedAt: "2017-08-14T21:16:42Z",
+			Stack:     "cflinuxfs2",
+			Buildpacks: []v3action.Buildpack{
+				{Name: "ruby_buildpack", DetectOutput: "ruby", Version: "1.7.17"},
+				{Name: "nodejs_buildpack", DetectOutput: "nodejs", Version: "1.6.20"},
+				{Name: "https://github.com/some/buildpack"},
+			},
+			ProcessTypes: map[string]string{"web": "bundle exec rackup"},
+		}, v3action.Warnings{"get-droplet-warning"}, nil)
+		fakeV2Actor.GetBuildpacksReturns([]v2action.Buildpack{
+			{Name: "ruby_buildpack", Stack: "", Enabled: true, Filename: "ruby_buildpack-cached-v1.7.16.zip"},
+			{Name: "ruby_buildpack", Stack: "cflinuxfs2", Enabled: true, Filename: "ruby_buildpack-cached-cflinuxfs2-v1.7.18.zip"},
+			{Name: "nodejs_buildpack", Stack: "cflinuxfs2", Enabled: true, Filename: "nodejs_buildpack-cached-cflinuxfs2-v1.6.20.zip"},
+		}, v2action.Warnings{"get-buildpacks-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the app does not have a current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturns(v3action.Droplet{}, v3action.Warnings{"get-droplet-warning"}, actionerror.DropletNotFoundError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.DropletNotFoundError{AppGUID: "some-app-guid"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-droplet-warning"))
+		})
+	})
+
+	Context("when getting the buildpacks fails", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetBuildpacksReturns(nil, v2action.Warnings{"get-buildpacks-warning"}, errors.New("buildpacks-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("buildpacks-error"))
+			Expect(testUI.Err).To(Say("get-buildpacks-warning"))
+		})
+	})
+
+	It("displays the droplet and compares its buildpacks with the admin buildpacks", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting build info for app some-app in org some-org / space some-space as steve..."))
+		Expect(testUI.Out).To(Say(`droplet guid:\s+some-droplet-guid`))
+		Expect(testUI.Out).To(Say(`staged:\s+\w+`))
+		Expect(testUI.Out).To(Say(`stack:\s+cflinuxfs2`))
+		Expect(testUI.Out).To(Say(`start command:\s+bundle exec rackup`))
+		Expect(testUI.Out).To(Say(`buildpack\s+detected\s+staged version\s+latest version\s+status`))
+		Expect(testUI.Out).To(Say(`ruby_buildpack\s+ruby\s+1\.7\.17\s+1\.7\.18\s+restage recommended`))
+		Expect(testUI.Out).To(Say(`nodejs_buildpack\s+nodejs\s+1\.6\.20\s+1\.6\.20\s+up to date`))
+		Expect(testUI.Out).To(Say(`https://github.com/some/buildpack\s+unknown`))
+		Expect(testUI.Out).To(Say("TIP: Use 'faceman restage some-app' to stage the app with the latest buildpacks."))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-droplet-warning"))
+		Expect(testUI.Err).To(Say("get-buildpacks-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(fakeActor.GetCurrentDropletByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+	})
+
+	Context("when all the buildpacks are up to date", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetBuildpacksReturns([]v2action.Buildpack{
+				{Name: "ruby_buildpack", Enabled: true, Filename: "ruby_buildpack-v1.7.17.zip"},
+				{Name: "nodejs_buildpack", Enabled: true, Filename: "nodejs_buildpack-v1.6.20.zip"},
+				{Name: "nodejs_buildpack", Stack: "other-stack", Enabled: true, Filename: "nodejs_buildpack-v1.6.21.zip"},
+			}, nil, nil)
+		})
+
+		It("does not recommend a restage", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`ruby_buildpack\s+ruby\s+1\.7\.17\s+1\.7\.17\s+up to date`))
+			Expect(testUI.Out).To(Say(`nodejs_buildpack\s+nodejs\s+1\.6\.20\s+1\.6\.20\s+up to date`))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+
+	Context("when the buildpacks were staged with a newer version than the admin buildpacks", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetBuildpacksReturns([]v2action.Buildpack{
+				{Name: "ruby_buildpack", Enabled: true, Filename: "ruby_buildpack-v1.7.9.zip"},
+				{Name: "nodejs_buildpack", Enabled: true, Filename: "nodejs_buildpack-v1.6.20.zip"},
+			}, nil, nil)
+		})
+
+		It("compares the versions semantically and does not recommend a restage", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`ruby_buildpack\s+ruby\s+1\.7\.17\s+1\.7\.9\s+newer`))
+			Expect(testUI.Out).To(Say(`nodejs_buildpack\s+nodejs\s+1\.6\.20\s+1\.6\.20\s+up to date`))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+
+	Context("when the droplet was not staged with buildpacks", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturns(v3action.Droplet{
+				GUID:  "some-droplet-guid",
+				Image: "some-org/some-image",
+			}, nil, nil)
+		})
+
+		It("displays the droplet without a buildpack table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`docker image:\s+some-org/some-image`))
+			Expect(testUI.Out).To(Say("No buildpacks were used to stage the current droplet."))
+			Expect(testUI.Out).ToNot(Say("status"))
+		})
+	})
+})
@@ -0,0 +1,206 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CleanupAppActor
+
+type CleanupAppActor interface {
+	ApplyRetentionPlan(plan v3action.RetentionPlan) (v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+	GetApplicationRetentionPlan(appName string, spaceGUID string, keep int) (v3action.RetentionPlan, v3action.Warnings, error)
+	GetSpaceRetentionPlans(spaceGUID string, keep int) ([]v3action.RetentionPlan, v3action.Warnings, error)
+}
+
+type CleanupAppCommand struct {
+	OptionalArgs    flag.OptionalAppName `positional-args:"yes"`
+	Keep            int                  `long:"keep" default:"3" description:"Number of most recent droplets and packages to keep, in addition to the current droplet and its package"`
+	Space           bool                 `long:"space" description:"Clean up all apps in the targeted space"`
+	Force           bool                 `long:"force" short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}          `usage:"CF_NAME cleanup-app APP_NAME [--keep N] [-f]\n   CF_NAME cleanup-app --space [--keep N] [-f]\n\nEXAMPLES:\n   CF_NAME cleanup-app my-app --keep 5\n   CF_NAME cleanup-app --space --keep 1 -f"`
+	relatedCommands interface{}          `related_commands:"download-droplet, v3-droplets, v3-packages"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CleanupAppActor
+}
+
+func (cmd *CleanupAppCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd CleanupAppCommand) Execute(args []string) error {
+	switch {
+	case cmd.OptionalArgs.AppName != "" && cmd.Space:
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}
+	case cmd.OptionalArgs.AppName == "" && !cmd.Space:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.Keep < 0:
+		return translatableerror.ParseArgumentError{ArgumentName: "--keep", ExpectedType: "a non-negative integer"}
+	}
+
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var plans []v3action.RetentionPlan
+	var warnings v3action.Warnings
+	if cmd.Space {
+		cmd.UI.DisplayTextWithFlavor("Getting droplets and packages of apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		plans, warnings, err = cmd.Actor.GetSpaceRetentionPlans(cmd.Config.TargetedSpace().GUID, cmd.Keep)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting droplets and packages of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.OptionalArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		var plan v3action.RetentionPlan
+		plan, warnings, err = cmd.Actor.GetApplicationRetentionPlan(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Keep)
+		if !plan.IsEmpty() {
+			plans = append(plans, plan)
+		}
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(plans) == 0 {
+		cmd.UI.DisplayText("Nothing to clean up.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	dropletCount, packageCount := cmd.displayPlans(plans)
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete {{.DropletCount}} droplet(s) and {{.PackageCount}} package(s)?", map[string]interface{}{
+			"DropletCount": dropletCount,
+			"PackageCount": packageCount,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Cleanup cancelled")
+			return nil
+		}
+	}
+
+	for _, plan := range plans {
+		cmd.UI.DisplayTextWithFlavor("Deleting {{.DropletCount}} droplet(s) and {{.PackageCount}} package(s) of app {{.AppName}}...", map[string]interface{}{
+			"DropletCount": len(plan.Droplets),
+			"PackageCount": len(plan.Packages),
+			"AppName":      plan.Application.Name,
+		})
+
+		warnings, err = cmd.Actor.ApplyRetentionPlan(plan)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd CleanupAppCommand) displayPlans(plans []v3action.RetentionPlan) (int, int) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	var dropletCount, packageCount int
+	for _, plan := range plans {
+		for _, droplet := range plan.Droplets {
+			table = append(table, []string{
+				plan.Application.Name,
+				cmd.UI.TranslateText("droplet"),
+				droplet.GUID,
+				cmd.UI.TranslateText(strings.ToLower(string(droplet.State))),
+				cmd.createdAt(droplet.CreatedAt),
+			})
+		}
+		for _, pkg := range plan.Packages {
+			table = append(table, []string{
+				plan.Application.Name,
+				cmd.UI.TranslateText("package"),
+				pkg.GUID,
+				cmd.UI.TranslateText(strings.ToLower(string(pkg.State))),
+				cmd.createdAt(pkg.CreatedAt),
+			})
+		}
+		dropletCount += len(plan.Droplets)
+		packageCount += len(plan.Packages)
+	}
+
+	cmd.UI.DisplayText("The following droplets and packages will be deleted:")
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return dropletCount, packageCount
+}
+
+func (cmd CleanupAppCommand) createdAt(createdAt string) string {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return createdAt
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
@@ -0,0 +1,250 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cleanup-app Command", func() {
+	var (
+		cmd             v3.CleanupAppCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeCleanupAppActor
+		binaryName      string
+		executeErr      error
+		plan            v3action.RetentionPlan
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeCleanupAppActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.CleanupAppCommand{
+			OptionalArgs: flag.OptionalAppName{AppName: "some-app"},
+			Keep:         2,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		plan = v3action.RetentionPlan{
+			Application: v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			Droplets: []v3action.Droplet{
+				{GUID: "old-droplet-guid", State: constant.DropletStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+			},
+			Packages: []v3action.Package{
+				{GUID: "old-package-guid", State: constant.PackageReady, CreatedAt: "2017-01-01T00:00:00Z"},
+			},
+		}
+		fakeActor.GetApplicationRetentionPlanReturns(plan, v3action.Warnings{"plan-warning"}, nil)
+		fakeActor.ApplyRetentionPlanReturns(v3action.Warnings{"apply-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither an app nor --space is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	Context("when both an app and --space are provided", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}))
+		})
+	})
+
+	Context("when --keep is negative", func() {
+		BeforeEach(func() {
+			cmd.Keep = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{ArgumentName: "--keep", ExpectedType: "a non-negative integer"}))
+		})
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when getting the retention plan fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationRetentionPlanReturns(v3action.RetentionPlan{}, v3action.Warnings{"plan-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+
+	Context("when there is nothing to delete", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationRetentionPlanReturns(v3action.RetentionPlan{}, nil, nil)
+		})
+
+		It("does not prompt or delete anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Nothing to clean up."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyRetentionPlanCallCount()).To(Equal(0))
+		})
+	})
+
+	It("previews the droplets and packages to delete", func() {
+		Expect(testUI.Out).To(Say("Getting droplets and packages of app some-app in org some-org / space some-space as steve..."))
+		Expect(testUI.Err).To(Say("plan-warning"))
+		Expect(testUI.Out).To(Say("The following droplets and packages will be deleted:"))
+		Expect(testUI.Out).To(Say(`app\s+type\s+guid\s+state\s+created`))
+		Expect(testUI.Out).To(Say(`some-app\s+droplet\s+old-droplet-guid\s+staged`))
+		Expect(testUI.Out).To(Say(`some-app\s+package\s+old-package-guid\s+ready`))
+
+		appName, spaceGUID, keep := fakeActor.GetApplicationRetentionPlanArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(keep).To(Equal(2))
+	})
+
+	Context("when the user confirms", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the droplets and packages", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete 1 droplet\(s\) and 1 package\(s\)\?`))
+			Expect(testUI.Out).To(Say(`Deleting 1 droplet\(s\) and 1 package\(s\) of app some-app\.\.\.`))
+			Expect(testUI.Err).To(Say("apply-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.ApplyRetentionPlanCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplyRetentionPlanArgsForCall(0)).To(Equal(plan))
+		})
+
+		Context("when deleting fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyRetentionPlanReturns(v3action.Warnings{"apply-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+
+	Context("when the user declines", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Cleanup cancelled"))
+			Expect(fakeActor.ApplyRetentionPlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --force is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeActor.ApplyRetentionPlanCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when --space is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = ""
+			cmd.Space = true
+			cmd.Force = true
+
+			otherPlan := v3action.RetentionPlan{
+				Application: v3action.Application{Name: "other-app"},
+				Packages:    []v3action.Package{{GUID: "other-package-guid", State: constant.PackageFailed}},
+			}
+			fakeActor.GetSpaceRetentionPlansReturns([]v3action.RetentionPlan{plan, otherPlan}, v3action.Warnings{"space-plan-warning"}, nil)
+		})
+
+		It("cleans up all apps in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting droplets and packages of apps in org some-org / space some-space as steve..."))
+			Expect(testUI.Err).To(Say("space-plan-warning"))
+			Expect(testUI.Out).To(Say(`other-app\s+package\s+other-package-guid\s+failed`))
+
+			spaceGUID, keep := fakeActor.GetSpaceRetentionPlansArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(2))
+			Expect(fakeActor.GetApplicationRetentionPlanCallCount()).To(Equal(0))
+
+			Expect(fakeActor.ApplyRetentionPlanCallCount()).To(Equal(2))
+			Expect(fakeActor.ApplyRetentionPlanArgsForCall(1).Application.Name).To(Equal("other-app"))
+		})
+	})
+})
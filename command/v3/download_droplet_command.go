@@ -0,0 +1,105 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . ProgressBar
+
+type ProgressBar interface {
+	v3action.ProgressBar
+	Complete()
+	Ready()
+}
+
+//go:generate counterfeiter . DownloadDropletActor
+
+type DownloadDropletActor interface {
+	CloudControllerAPIVersion() string
+	DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, pathToFile string, progressBar v3action.ProgressBar) (v3action.Droplet, v3action.Warnings, error)
+}
+
+type DownloadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	DropletGUID     string       `long:"droplet" description:"The guid of the droplet to download (Default: the current droplet of the app)"`
+	Path            flag.Path    `short:"p" description:"Path of the file to write the droplet to" required:"true"`
+	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [--droplet DROPLET_GUID] -p DROPLET_PATH\n\nEXAMPLES:\n   CF_NAME download-droplet my-app -p ./my-app-droplet.tgz\n   CF_NAME v3-push my-app --droplet ./my-app-droplet.tgz"`
+	relatedCommands interface{}  `related_commands:"v3-droplets, v3-push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DownloadDropletActor
+	ProgressBar ProgressBar
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd DownloadDropletCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading droplet of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	cmd.ProgressBar.Ready()
+	droplet, warnings, err := cmd.Actor.DownloadApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.DropletGUID, string(cmd.Path), cmd.ProgressBar)
+	cmd.ProgressBar.Complete()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Droplet {{.DropletGUID}} written to {{.Path}}", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+		"Path":        cmd.Path,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
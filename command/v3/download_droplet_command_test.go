@@ -0,0 +1,128 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-droplet Command", func() {
+	var (
+		cmd             v3.DownloadDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeDownloadDropletActor
+		fakeProgressBar *v3fakes.FakeProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeDownloadDropletActor)
+		fakeProgressBar = new(v3fakes.FakeProgressBar)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.DownloadDropletCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Path:         "some-droplet.tgz",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.DownloadApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the droplet is downloaded", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "some-droplet-guid"
+			fakeActor.DownloadApplicationDropletReturns(v3action.Droplet{GUID: "some-droplet-guid"}, v3action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		It("downloads the droplet with a progress bar and displays the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Downloading droplet of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Droplet some-droplet-guid written to some-droplet\\.tgz"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+
+			Expect(fakeActor.DownloadApplicationDropletCallCount()).To(Equal(1))
+			appName, spaceGUID, dropletGUID, path, progressBar := fakeActor.DownloadApplicationDropletArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(path).To(Equal("some-droplet.tgz"))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+
+			Expect(fakeProgressBar.ReadyCallCount()).To(Equal(1))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when downloading the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadApplicationDropletReturns(v3action.Droplet{}, v3action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,97 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . DownloadPackageActor
+
+type DownloadPackageActor interface {
+	CloudControllerAPIVersion() string
+	DownloadApplicationPackage(appName string, spaceGUID string, packageGUID string, pathToFile string, progressBar v3action.ProgressBar) (v3action.Package, v3action.Warnings, error)
+}
+
+type DownloadPackageCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	PackageGUID     string       `long:"package" description:"The guid of the package to download (Default: the most recent ready bits package of the app)"`
+	Path            flag.Path    `short:"p" description:"Path of the file to write the package to" required:"true"`
+	usage           interface{}  `usage:"CF_NAME download-package APP_NAME [--package PACKAGE_GUID] -p PACKAGE_PATH\n\nEXAMPLES:\n   CF_NAME download-package my-app -p ./my-app-package.zip\n   CF_NAME v3-push my-app -p ./my-app-package.zip"`
+	relatedCommands interface{}  `related_commands:"v3-packages, v3-push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DownloadPackageActor
+	ProgressBar ProgressBar
+}
+
+func (cmd *DownloadPackageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd DownloadPackageCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading package of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	cmd.ProgressBar.Ready()
+	pkg, warnings, err := cmd.Actor.DownloadApplicationPackage(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.PackageGUID, string(cmd.Path), cmd.ProgressBar)
+	cmd.ProgressBar.Complete()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Package {{.PackageGUID}} written to {{.Path}}", map[string]interface{}{
+		"PackageGUID": pkg.GUID,
+		"Path":        cmd.Path,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
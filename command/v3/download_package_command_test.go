@@ -0,0 +1,128 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-package Command", func() {
+	var (
+		cmd             v3.DownloadPackageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeDownloadPackageActor
+		fakeProgressBar *v3fakes.FakeProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeDownloadPackageActor)
+		fakeProgressBar = new(v3fakes.FakeProgressBar)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.DownloadPackageCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Path:         "some-package.zip",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.DownloadApplicationPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the package is downloaded", func() {
+		BeforeEach(func() {
+			cmd.PackageGUID = "some-package-guid"
+			fakeActor.DownloadApplicationPackageReturns(v3action.Package{GUID: "some-package-guid"}, v3action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		It("downloads the package with a progress bar and displays the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Downloading package of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Package some-package-guid written to some-package\\.zip"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+
+			Expect(fakeActor.DownloadApplicationPackageCallCount()).To(Equal(1))
+			appName, spaceGUID, packageGUID, path, progressBar := fakeActor.DownloadApplicationPackageArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(packageGUID).To(Equal("some-package-guid"))
+			Expect(path).To(Equal("some-package.zip"))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+
+			Expect(fakeProgressBar.ReadyCallCount()).To(Equal(1))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when downloading the package fails", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadApplicationPackageReturns(v3action.Package{}, v3action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,430 @@
+package v3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . PromoteActor
+
+type PromoteActor interface {
+	ApplyApplicationSettings(appGUID string, settings v3action.ApplicationSettings) ([]string, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+	CopyDroplet(dropletGUID string, appGUID string) (v3action.Droplet, v3action.Warnings, error)
+	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, pathToFile string, progressBar v3action.ProgressBar) (v3action.Droplet, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationSettings(appGUID string) (v3action.ApplicationSettings, v3action.Warnings, error)
+	GetCurrentDropletByApplication(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Warnings, error)
+	UploadDroplet(appGUID string, pathToDroplet string) (v3action.Droplet, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . PromoteV2Actor
+
+type PromoteV2Actor interface {
+	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	GetServiceInstancesByApplication(appGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+type PromoteCommand struct {
+	RequiredArgs    flag.AppName                `positional-args:"yes"`
+	ToSpace         string                      `long:"to-space" description:"Space to promote the app to, in the org targeted by the destination login" required:"true"`
+	ToTarget        flag.PathWithExistenceCheck `long:"to-target" description:"CF_HOME directory of a login to another foundation to promote the app to (Default: the current login)"`
+	usage           interface{}                 `usage:"CF_NAME promote APP_NAME --to-space SPACE [--to-target PROFILE]\n\n   Copies the current droplet, environment variables, process scale and health checks of the app to an app with the same name in another space, creating it if needed, and starts it without staging. Service instances bound to the app are bound to the instances with the same names in the destination space. Routes are not copied.\n\n   PROFILE is a CF_HOME directory logged in to the destination foundation.\n\nEXAMPLES:\n   CF_NAME promote my-app --to-space production\n   CF_HOME=~/prod-foundation CF_NAME login -a https://api.prod.example.com\n   CF_NAME promote my-app --to-space production --to-target ~/prod-foundation"`
+	relatedCommands interface{}                 `related_commands:"download-droplet, v3-push, v3-set-droplet"`
+
+	UI                 command.UI
+	Config             command.Config
+	SharedActor        command.SharedActor
+	Actor              PromoteActor
+	V2Actor            PromoteV2Actor
+	DestinationConfig  command.Config
+	DestinationActor   PromoteActor
+	DestinationV2Actor PromoteV2Actor
+	ProgressBar        ProgressBar
+}
+
+func (cmd *PromoteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	var err error
+	cmd.Actor, cmd.V2Actor, err = newPromoteActors(config, ui)
+	if err != nil {
+		return err
+	}
+
+	if cmd.ToTarget == "" {
+		cmd.DestinationConfig = config
+		cmd.DestinationActor = cmd.Actor
+		cmd.DestinationV2Actor = cmd.V2Actor
+		return nil
+	}
+
+	destinationConfig, err := configv3.LoadConfigFromHome(string(cmd.ToTarget))
+	if err != nil {
+		return err
+	}
+	cmd.DestinationConfig = destinationConfig
+	cmd.DestinationActor, cmd.DestinationV2Actor, err = newPromoteActors(destinationConfig, ui)
+	return err
+}
+
+func newPromoteActors(config command.Config, ui command.UI) (PromoteActor, PromoteV2Actor, error) {
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return nil, nil, translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return nil, nil, err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v3action.NewActor(ccClient, config, nil, nil), v2action.NewActor(ccClientV2, uaaClientV2, config), nil
+}
+
+func (cmd PromoteCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.DestinationActor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	destinationOrg := cmd.DestinationConfig.TargetedOrganization()
+	if destinationOrg.GUID == "" {
+		return translatableerror.PromoteTargetNotTargetedError{
+			BinaryName: cmd.Config.BinaryName(),
+			HomeDir:    string(cmd.ToTarget),
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Promoting app {{.AppName}} from org {{.OrgName}} / space {{.SpaceName}} to org {{.DestinationOrgName}} / space {{.DestinationSpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":              cmd.RequiredArgs.AppName,
+		"OrgName":              cmd.Config.TargetedOrganization().Name,
+		"SpaceName":            cmd.Config.TargetedSpace().Name,
+		"DestinationOrgName":   destinationOrg.Name,
+		"DestinationSpaceName": cmd.ToSpace,
+		"Username":             user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	droplet, warnings, err := cmd.Actor.GetCurrentDropletByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	settings, warnings, err := cmd.Actor.GetApplicationSettings(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	destinationSpace, warnings, err := cmd.DestinationActor.GetSpaceByNameAndOrganization(cmd.ToSpace, destinationOrg.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	destinationApp, err := cmd.getOrCreateDestinationApplication(app, destinationSpace.GUID)
+	if err != nil {
+		return err
+	}
+
+	// The droplet is copied before the destination app is stopped so that a
+	// failed or slow copy does not take the app down.
+	destinationDroplet, err := cmd.copyDroplet(droplet.GUID, destinationApp.GUID)
+	if err != nil {
+		return err
+	}
+
+	// The droplet the destination app is running is looked up so that it can
+	// be restored if the promotion fails after the copy is set. A new app has
+	// none.
+	previousDroplet, warnings, err := cmd.DestinationActor.GetCurrentDropletByApplication(destinationApp.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.DropletNotFoundError); err != nil && !ok {
+		return err
+	}
+
+	if destinationApp.Started() {
+		warnings, err = cmd.DestinationActor.StopApplication(destinationApp.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	warnings, err = cmd.DestinationActor.SetApplicationDroplet(cmd.RequiredArgs.AppName, destinationSpace.GUID, destinationDroplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if destinationApp.Started() {
+			cmd.restartPreviousDroplet(destinationApp.GUID)
+		}
+		return err
+	}
+
+	skippedProcessTypes, warnings, err := cmd.DestinationActor.ApplyApplicationSettings(destinationApp.GUID, settings)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.rollBackDestinationApplication(destinationApp, destinationSpace.GUID, previousDroplet.GUID)
+		return err
+	}
+
+	missingServiceInstances, err := cmd.bindServiceInstances(app.GUID, destinationApp.GUID, destinationSpace.GUID)
+	if err != nil {
+		cmd.rollBackDestinationApplication(destinationApp, destinationSpace.GUID, previousDroplet.GUID)
+		return err
+	}
+
+	err = cmd.startApplication(destinationApp.GUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if len(skippedProcessTypes) > 0 || len(missingServiceInstances) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("The following could not be carried over:")
+		for _, processType := range skippedProcessTypes {
+			cmd.UI.DisplayText("   process {{.ProcessType}}: the promoted droplet does not define it", map[string]interface{}{
+				"ProcessType": processType,
+			})
+		}
+		for _, serviceInstance := range missingServiceInstances {
+			cmd.UI.DisplayText("   service instance {{.ServiceInstanceName}}: not found in space {{.SpaceName}}", map[string]interface{}{
+				"ServiceInstanceName": serviceInstance,
+				"SpaceName":           cmd.ToSpace,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (cmd PromoteCommand) getOrCreateDestinationApplication(app v3action.Application, spaceGUID string) (v3action.Application, error) {
+	destinationApp, warnings, err := cmd.DestinationActor.GetApplicationByNameAndSpace(app.Name, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.ApplicationNotFoundError); !ok {
+		return destinationApp, err
+	}
+
+	destinationApp, warnings, err = cmd.DestinationActor.CreateApplicationInSpace(v3action.Application{
+		Name:                app.Name,
+		LifecycleType:       app.LifecycleType,
+		LifecycleBuildpacks: app.LifecycleBuildpacks,
+	}, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	return destinationApp, err
+}
+
+// copyDroplet copies the droplet within the foundation when there is no
+// destination login, and otherwise downloads it from the source foundation
+// and uploads it to the destination foundation.
+func (cmd PromoteCommand) copyDroplet(dropletGUID string, appGUID string) (v3action.Droplet, error) {
+	if cmd.ToTarget == "" {
+		droplet, warnings, err := cmd.DestinationActor.CopyDroplet(dropletGUID, appGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		return droplet, err
+	}
+
+	tmpDir, err := ioutil.TempDir("", "cf-promote")
+	if err != nil {
+		return v3action.Droplet{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+	pathToDroplet := filepath.Join(tmpDir, "droplet.tgz")
+
+	cmd.ProgressBar.Ready()
+	_, warnings, err := cmd.Actor.DownloadApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, dropletGUID, pathToDroplet, cmd.ProgressBar)
+	cmd.ProgressBar.Complete()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v3action.Droplet{}, err
+	}
+
+	droplet, warnings, err := cmd.DestinationActor.UploadDroplet(appGUID, pathToDroplet)
+	cmd.UI.DisplayWarnings(warnings)
+	return droplet, err
+}
+
+// bindServiceInstances binds the destination app to the service instances in
+// the destination space that have the names of the service instances bound
+// to the source app. It returns the names that are not in the destination
+// space.
+func (cmd PromoteCommand) bindServiceInstances(appGUID string, destinationAppGUID string, destinationSpaceGUID string) ([]string, error) {
+	sourceInstances, warnings, err := cmd.V2Actor.GetServiceInstancesByApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil || len(sourceInstances) == 0 {
+		return nil, err
+	}
+
+	spaceInstances, warnings, err := cmd.DestinationV2Actor.GetServiceInstancesBySpace(destinationSpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+
+	boundInstances, warnings, err := cmd.DestinationV2Actor.GetServiceInstancesByApplication(destinationAppGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+
+	bound := map[string]bool{}
+	for _, instance := range boundInstances {
+		bound[instance.GUID] = true
+	}
+
+	var missing []string
+	for _, sourceInstance := range sourceInstances {
+		var found bool
+		for _, instance := range spaceInstances {
+			if instance.Name != sourceInstance.Name {
+				continue
+			}
+
+			found = true
+			if bound[instance.GUID] {
+				break
+			}
+
+			warnings, err = cmd.DestinationV2Actor.BindServiceByApplicationAndServiceInstance(destinationAppGUID, instance.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		if !found {
+			missing = append(missing, sourceInstance.Name)
+		}
+	}
+
+	return missing, nil
+}
+
+// rollBackDestinationApplication sets the droplet the destination app was
+// running before the promotion, if it had one, and restarts the app when it
+// was started. The app is left stopped when the droplet cannot be restored,
+// so that the promoted droplet does not run half configured.
+func (cmd PromoteCommand) rollBackDestinationApplication(destinationApp v3action.Application, spaceGUID string, previousDropletGUID string) {
+	if previousDropletGUID != "" {
+		warnings, err := cmd.DestinationActor.SetApplicationDroplet(cmd.RequiredArgs.AppName, spaceGUID, previousDropletGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Failed to restore the previous droplet of app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"AppName": cmd.RequiredArgs.AppName,
+				"Error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	if destinationApp.Started() {
+		cmd.restartPreviousDroplet(destinationApp.GUID)
+	}
+}
+
+// restartPreviousDroplet starts the destination app again with the droplet it
+// was running before the promotion. A failure is only displayed, so that the
+// error that caused the restart is the one returned.
+func (cmd PromoteCommand) restartPreviousDroplet(appGUID string) {
+	cmd.UI.DisplayText("Restarting app {{.AppName}} with its previous droplet...", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	err := cmd.startApplication(appGUID)
+	if err != nil {
+		cmd.UI.DisplayWarning("Failed to restart app {{.AppName}}: {{.Error}}", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+			"Error":   err.Error(),
+		})
+	}
+}
+
+func (cmd PromoteCommand) startApplication(appGUID string) error {
+	_, warnings, err := cmd.DestinationActor.StartApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.DestinationActor.PollStart(appGUID, pollWarnings)
+	done <- true
+
+	if _, ok := err.(actionerror.StartupTimeoutError); ok {
+		return translatableerror.StartupTimeoutError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+	return err
+}
@@ -0,0 +1,462 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("promote Command", func() {
+	var (
+		cmd             v3.PromoteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakePromoteActor
+		fakeV2Actor     *v3fakes.FakePromoteV2Actor
+		fakeProgressBar *v3fakes.FakeProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakePromoteActor)
+		fakeV2Actor = new(v3fakes.FakePromoteV2Actor)
+		fakeProgressBar = new(v3fakes.FakeProgressBar)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.PromoteCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			ToSpace:      "production",
+
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SharedActor:        fakeSharedActor,
+			Actor:              fakeActor,
+			V2Actor:            fakeV2Actor,
+			DestinationConfig:  fakeConfig,
+			DestinationActor:   fakeActor,
+			DestinationV2Actor: fakeV2Actor,
+			ProgressBar:        fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(0, v3action.Application{Name: "some-app", GUID: "some-app-guid", LifecycleType: constant.AppLifecycleTypeBuildpack}, v3action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, v3action.Application{Name: "some-app", GUID: "destination-app-guid", State: constant.ApplicationStarted}, v3action.Warnings{"get-destination-app-warning"}, nil)
+		fakeActor.GetCurrentDropletByApplicationReturns(v3action.Droplet{GUID: "some-droplet-guid"}, v3action.Warnings{"get-droplet-warning"}, nil)
+		fakeActor.GetApplicationSettingsReturns(v3action.ApplicationSettings{
+			EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+		}, v3action.Warnings{"get-settings-warning"}, nil)
+		fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{Name: "production", GUID: "production-space-guid"}, v3action.Warnings{"get-space-warning"}, nil)
+		fakeActor.CopyDropletReturns(v3action.Droplet{GUID: "copied-droplet-guid"}, v3action.Warnings{"copy-droplet-warning"}, nil)
+		fakeActor.ApplyApplicationSettingsReturns(nil, v3action.Warnings{"apply-settings-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the destination login has no org targeted", func() {
+		BeforeEach(func() {
+			destinationConfig := new(commandfakes.FakeConfig)
+			cmd.DestinationConfig = destinationConfig
+			cmd.ToTarget = "some-home-dir"
+		})
+
+		It("returns a PromoteTargetNotTargetedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PromoteTargetNotTargetedError{
+				BinaryName: binaryName,
+				HomeDir:    "some-home-dir",
+			}))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("copies the droplet and settings to the app in the destination space and starts it", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Promoting app some-app from org some-org / space some-space to org some-org / space production as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Waiting for app to start\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).ToNot(Say("could not be carried over"))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-droplet-warning"))
+		Expect(testUI.Err).To(Say("get-settings-warning"))
+		Expect(testUI.Err).To(Say("get-space-warning"))
+		Expect(testUI.Err).To(Say("get-destination-app-warning"))
+		Expect(testUI.Err).To(Say("copy-droplet-warning"))
+		Expect(testUI.Err).To(Say("apply-settings-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(fakeActor.GetCurrentDropletByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+		spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+		Expect(spaceName).To(Equal("production"))
+		Expect(orgGUID).To(Equal("some-org-guid"))
+
+		appName, spaceGUID = fakeActor.GetApplicationByNameAndSpaceArgsForCall(1)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("production-space-guid"))
+		Expect(fakeActor.CreateApplicationInSpaceCallCount()).To(Equal(0))
+
+		Expect(fakeActor.StopApplicationCallCount()).To(Equal(1))
+		Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("destination-app-guid"))
+
+		dropletGUID, appGUID := fakeActor.CopyDropletArgsForCall(0)
+		Expect(dropletGUID).To(Equal("some-droplet-guid"))
+		Expect(appGUID).To(Equal("destination-app-guid"))
+		Expect(fakeActor.DownloadApplicationDropletCallCount()).To(Equal(0))
+
+		appName, spaceGUID, dropletGUID = fakeActor.SetApplicationDropletArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("production-space-guid"))
+		Expect(dropletGUID).To(Equal("copied-droplet-guid"))
+
+		appGUID, settings := fakeActor.ApplyApplicationSettingsArgsForCall(0)
+		Expect(appGUID).To(Equal("destination-app-guid"))
+		Expect(settings.EnvironmentVariables).To(HaveKeyWithValue("SOME_VAR", "some-value"))
+
+		Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("destination-app-guid"))
+		appGUID, _ = fakeActor.PollStartArgsForCall(0)
+		Expect(appGUID).To(Equal("destination-app-guid"))
+	})
+
+	Context("when the app does not exist in the destination space", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, v3action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			fakeActor.CreateApplicationInSpaceReturns(v3action.Application{Name: "some-app", GUID: "destination-app-guid"}, v3action.Warnings{"create-app-warning"}, nil)
+		})
+
+		It("creates it with the lifecycle of the source app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("create-app-warning"))
+
+			app, spaceGUID := fakeActor.CreateApplicationInSpaceArgsForCall(0)
+			Expect(app).To(Equal(v3action.Application{Name: "some-app", LifecycleType: constant.AppLifecycleTypeBuildpack}))
+			Expect(spaceGUID).To(Equal("production-space-guid"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app has no current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturns(v3action.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns the error before changing the destination", func() {
+			Expect(executeErr).To(MatchError(actionerror.DropletNotFoundError{AppGUID: "some-app-guid"}))
+			Expect(fakeActor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when promoting to another foundation", func() {
+		var (
+			destinationConfig  *commandfakes.FakeConfig
+			destinationActor   *v3fakes.FakePromoteActor
+			destinationV2Actor *v3fakes.FakePromoteV2Actor
+		)
+
+		BeforeEach(func() {
+			destinationConfig = new(commandfakes.FakeConfig)
+			destinationConfig.TargetedOrganizationReturns(configv3.Organization{Name: "prod-org", GUID: "prod-org-guid"})
+			destinationActor = new(v3fakes.FakePromoteActor)
+			destinationActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+			destinationActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "production-space-guid"}, nil, nil)
+			destinationActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "destination-app-guid"}, nil, nil)
+			destinationActor.UploadDropletReturns(v3action.Droplet{GUID: "uploaded-droplet-guid"}, v3action.Warnings{"upload-warning"}, nil)
+			destinationV2Actor = new(v3fakes.FakePromoteV2Actor)
+
+			cmd.ToTarget = "some-home-dir"
+			cmd.DestinationConfig = destinationConfig
+			cmd.DestinationActor = destinationActor
+			cmd.DestinationV2Actor = destinationV2Actor
+		})
+
+		It("downloads the droplet from the source and uploads it to the destination", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("to org prod-org / space production as steve"))
+			Expect(testUI.Err).To(Say("upload-warning"))
+
+			spaceName, orgGUID := destinationActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("production"))
+			Expect(orgGUID).To(Equal("prod-org-guid"))
+
+			Expect(fakeActor.DownloadApplicationDropletCallCount()).To(Equal(1))
+			appName, spaceGUID, dropletGUID, downloadPath, progressBar := fakeActor.DownloadApplicationDropletArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+			Expect(fakeProgressBar.ReadyCallCount()).To(Equal(1))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+
+			appGUID, uploadPath := destinationActor.UploadDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("destination-app-guid"))
+			Expect(uploadPath).To(Equal(downloadPath))
+
+			_, _, dropletGUID = destinationActor.SetApplicationDropletArgsForCall(0)
+			Expect(dropletGUID).To(Equal("uploaded-droplet-guid"))
+
+			Expect(fakeActor.CopyDropletCallCount()).To(Equal(0))
+			Expect(destinationActor.CopyDropletCallCount()).To(Equal(0))
+			Expect(destinationActor.StartApplicationCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the source app has bound service instances", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetServiceInstancesByApplicationReturnsOnCall(0, []v2action.ServiceInstance{
+				{Name: "some-db", GUID: "source-db-guid"},
+				{Name: "some-cache", GUID: "source-cache-guid"},
+				{Name: "some-queue", GUID: "source-queue-guid"},
+			}, v2action.Warnings{"get-bound-warning"}, nil)
+			fakeV2Actor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{
+				{Name: "some-db", GUID: "production-db-guid"},
+				{Name: "some-cache", GUID: "production-cache-guid"},
+			}, v2action.Warnings{"get-space-instances-warning"}, nil)
+			fakeV2Actor.GetServiceInstancesByApplicationReturnsOnCall(1, []v2action.ServiceInstance{
+				{Name: "some-cache", GUID: "production-cache-guid"},
+			}, nil, nil)
+			fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-warning"}, nil)
+		})
+
+		It("binds the unbound instances with the same names and reports the missing ones", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("get-bound-warning"))
+			Expect(testUI.Err).To(Say("get-space-instances-warning"))
+			Expect(testUI.Err).To(Say("bind-warning"))
+
+			Expect(fakeV2Actor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("production-space-guid"))
+			Expect(fakeV2Actor.GetServiceInstancesByApplicationArgsForCall(1)).To(Equal("destination-app-guid"))
+
+			Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+			appGUID, instanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+			Expect(appGUID).To(Equal("destination-app-guid"))
+			Expect(instanceGUID).To(Equal("production-db-guid"))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("The following could not be carried over:"))
+			Expect(testUI.Out).To(Say("service instance some-queue: not found in space production"))
+		})
+	})
+
+	Context("when the destination app does not have some process types", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyApplicationSettingsReturns([]string{"worker"}, nil, nil)
+		})
+
+		It("reports them after starting the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("The following could not be carried over:"))
+			Expect(testUI.Out).To(Say("process worker: the promoted droplet does not define it"))
+		})
+	})
+
+	Context("when copying the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.CopyDropletReturns(v3action.Droplet{}, v3action.Warnings{"copy-droplet-warning"}, errors.New("copy-error"))
+		})
+
+		It("returns the error without stopping the destination app", func() {
+			Expect(executeErr).To(MatchError("copy-error"))
+			Expect(testUI.Err).To(Say("copy-droplet-warning"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, errors.New("set-droplet-error"))
+		})
+
+		It("restarts the destination app with its previous droplet and returns the error", func() {
+			Expect(executeErr).To(MatchError("set-droplet-error"))
+			Expect(testUI.Err).To(Say("set-droplet-warning"))
+			Expect(testUI.Out).To(Say("Restarting app some-app with its previous droplet..."))
+
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("destination-app-guid"))
+			Expect(fakeActor.ApplyApplicationSettingsCallCount()).To(Equal(0))
+		})
+
+		Context("when the destination app was not started", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, v3action.Application{Name: "some-app", GUID: "destination-app-guid", State: constant.ApplicationStopped}, nil, nil)
+			})
+
+			It("does not start it", func() {
+				Expect(executeErr).To(MatchError("set-droplet-error"))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when applying the settings fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturnsOnCall(1, v3action.Droplet{GUID: "previous-droplet-guid"}, v3action.Warnings{"get-previous-droplet-warning"}, nil)
+			fakeActor.ApplyApplicationSettingsReturns(nil, v3action.Warnings{"apply-settings-warning"}, errors.New("apply-settings-error"))
+		})
+
+		It("restores the previous droplet, restarts the destination app and returns the error", func() {
+			Expect(executeErr).To(MatchError("apply-settings-error"))
+			Expect(testUI.Err).To(Say("get-previous-droplet-warning"))
+			Expect(testUI.Err).To(Say("apply-settings-warning"))
+			Expect(testUI.Out).To(Say("Restarting app some-app with its previous droplet..."))
+
+			Expect(fakeActor.GetCurrentDropletByApplicationArgsForCall(1)).To(Equal("destination-app-guid"))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(2))
+			appName, spaceGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("production-space-guid"))
+			Expect(dropletGUID).To(Equal("copied-droplet-guid"))
+			appName, spaceGUID, dropletGUID = fakeActor.SetApplicationDropletArgsForCall(1)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("production-space-guid"))
+			Expect(dropletGUID).To(Equal("previous-droplet-guid"))
+
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("destination-app-guid"))
+			Expect(fakeV2Actor.GetServiceInstancesByApplicationCallCount()).To(Equal(0))
+		})
+
+		Context("when restoring the previous droplet fails", func() {
+			BeforeEach(func() {
+				fakeActor.SetApplicationDropletStub = func(string, string, string) (v3action.Warnings, error) {
+					if fakeActor.SetApplicationDropletCallCount() == 2 {
+						return nil, errors.New("restore-droplet-error")
+					}
+					return nil, nil
+				}
+			})
+
+			It("warns and leaves the destination app stopped", func() {
+				Expect(executeErr).To(MatchError("apply-settings-error"))
+				Expect(testUI.Err).To(Say("Failed to restore the previous droplet of app some-app: restore-droplet-error"))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the destination app was not started", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, v3action.Application{Name: "some-app", GUID: "destination-app-guid", State: constant.ApplicationStopped}, nil, nil)
+			})
+
+			It("restores the previous droplet without starting the app", func() {
+				Expect(executeErr).To(MatchError("apply-settings-error"))
+				Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(2))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the destination app had no droplet", func() {
+			BeforeEach(func() {
+				fakeActor.GetCurrentDropletByApplicationReturnsOnCall(1, v3action.Droplet{}, nil, actionerror.DropletNotFoundError{AppGUID: "destination-app-guid"})
+			})
+
+			It("restarts the destination app without setting a droplet", func() {
+				Expect(executeErr).To(MatchError("apply-settings-error"))
+				Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when binding the service instances fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturnsOnCall(1, v3action.Droplet{GUID: "previous-droplet-guid"}, nil, nil)
+			fakeV2Actor.GetServiceInstancesByApplicationReturns([]v2action.ServiceInstance{{Name: "some-instance", GUID: "some-instance-guid"}}, nil, nil)
+			fakeV2Actor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{{Name: "some-instance", GUID: "destination-instance-guid"}}, nil, nil)
+			fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-warning"}, errors.New("bind-error"))
+		})
+
+		It("restores the previous droplet, restarts the destination app and returns the error", func() {
+			Expect(executeErr).To(MatchError("bind-error"))
+			Expect(testUI.Err).To(Say("bind-warning"))
+			Expect(testUI.Out).To(Say("Restarting app some-app with its previous droplet..."))
+
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(2))
+			_, _, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(1)
+			Expect(dropletGUID).To(Equal("previous-droplet-guid"))
+
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("destination-app-guid"))
+		})
+	})
+
+	Context("when getting the destination app's droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByApplicationReturnsOnCall(1, v3action.Droplet{}, v3action.Warnings{"get-previous-droplet-warning"}, errors.New("get-droplet-error"))
+		})
+
+		It("returns the error without stopping the destination app", func() {
+			Expect(executeErr).To(MatchError("get-droplet-error"))
+			Expect(testUI.Err).To(Say("get-previous-droplet-warning"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app does not start in time", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartReturns(actionerror.StartupTimeoutError{})
+		})
+
+		It("returns a StartupTimeoutError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+				AppName:    "some-app",
+				BinaryName: binaryName,
+			}))
+		})
+	})
+})
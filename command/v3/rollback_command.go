@@ -0,0 +1,230 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . RollbackActor
+
+type RollbackActor interface {
+	CloudControllerAPIVersion() string
+	CreateDeployment(appGUID string) (string, v3action.Warnings, error)
+	GetApplicationCrashedProcessTypes(appGUID string) ([]string, v3action.Warnings, error)
+	GetApplicationRollback(appName string, spaceGUID string, previous int) (v3action.DropletRollback, v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error
+	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Warnings, error)
+}
+
+type RollbackCommand struct {
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	To                  int          `long:"to" default:"1" description:"Number of staged droplets to go back from the current droplet"`
+	Rolling             bool         `long:"rolling" description:"Replace the app instances gradually instead of stopping the app"`
+	usage               interface{}  `usage:"CF_NAME rollback APP_NAME [--to N] [--rolling]\n\n   Sets the droplet of the app to the staged droplet created before its current droplet, or N staged droplets before it, and restarts the app. If the app fails to start, it is reverted to its original droplet.\n\nEXAMPLES:\n   CF_NAME rollback my-app\n   CF_NAME rollback my-app --to 2 --rolling"`
+	relatedCommands     interface{}  `related_commands:"v3-droplets, v3-restart, v3-set-droplet"`
+	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RollbackActor
+}
+
+func (cmd *RollbackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd RollbackCommand) Execute(args []string) error {
+	if cmd.To < 1 {
+		return translatableerror.ParseArgumentError{ArgumentName: "--to", ExpectedType: "a positive integer"}
+	}
+
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Rolling {
+		err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionDeploymentsV3, "Option '--rolling'")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	rollback, warnings, err := cmd.Actor.GetApplicationRollback(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.To)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting droplet {{.DropletGUID}} (was {{.OriginalDropletGUID}})...", map[string]interface{}{
+		"DropletGUID":         rollback.To.GUID,
+		"OriginalDropletGUID": rollback.From.GUID,
+	})
+
+	started, err := cmd.deployDroplet(rollback.Application, rollback.To.GUID)
+	if err != nil {
+		return err
+	}
+
+	if !started {
+		cmd.UI.DisplayWarning("App {{.AppName}} failed to start with droplet {{.DropletGUID}}. Reverting to droplet {{.OriginalDropletGUID}}...", map[string]interface{}{
+			"AppName":             cmd.RequiredArgs.AppName,
+			"DropletGUID":         rollback.To.GUID,
+			"OriginalDropletGUID": rollback.From.GUID,
+		})
+
+		_, err = cmd.deployDroplet(rollback.Application, rollback.From.GUID)
+		if err != nil {
+			return err
+		}
+
+		return translatableerror.RollbackRevertedError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// deployDroplet sets the droplet of the app and, if the app is started,
+// restarts it. It returns false when the app times out starting or all the
+// instances of one of its processes crash.
+func (cmd RollbackCommand) deployDroplet(app v3action.Application, dropletGUID string) (bool, error) {
+	warnings, err := cmd.Actor.SetApplicationDroplet(app.Name, cmd.Config.TargetedSpace().GUID, dropletGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, err
+	}
+
+	if !app.Started() {
+		cmd.UI.DisplayText("App {{.AppName}} is stopped and will use the droplet when it is next started.", map[string]interface{}{
+			"AppName": app.Name,
+		})
+		return true, nil
+	}
+
+	if cmd.Rolling {
+		err = cmd.rollingRestart(app.GUID)
+	} else {
+		err = cmd.restart(app.GUID)
+	}
+
+	switch err.(type) {
+	case nil:
+	case actionerror.StartupTimeoutError, actionerror.DeploymentCanceledError:
+		return false, nil
+	default:
+		return false, err
+	}
+
+	crashedTypes, warnings, err := cmd.Actor.GetApplicationCrashedProcessTypes(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, err
+	}
+
+	return len(crashedTypes) == 0, nil
+}
+
+func (cmd RollbackCommand) restart(appGUID string) error {
+	warnings, err := cmd.Actor.StopApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, warnings, err = cmd.Actor.StartApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	return cmd.poll(func(pollWarnings chan<- v3action.Warnings) error {
+		return cmd.Actor.PollStart(appGUID, pollWarnings)
+	})
+}
+
+func (cmd RollbackCommand) rollingRestart(appGUID string) error {
+	deploymentGUID, warnings, err := cmd.Actor.CreateDeployment(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Waiting for app instances to be replaced...")
+
+	return cmd.poll(func(pollWarnings chan<- v3action.Warnings) error {
+		return cmd.Actor.PollDeployment(deploymentGUID, pollWarnings)
+	})
+}
+
+func (cmd RollbackCommand) poll(pollFunc func(chan<- v3action.Warnings) error) error {
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := pollFunc(pollWarnings)
+	done <- true
+
+	return err
+}
@@ -0,0 +1,276 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rollback Command", func() {
+	var (
+		cmd             v3.RollbackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeRollbackActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRollbackActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.RollbackCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			To:           1,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionDeploymentsV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationRollbackReturns(v3action.DropletRollback{
+			Application: v3action.Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStarted},
+			From:        v3action.Droplet{GUID: "current-droplet-guid"},
+			To:          v3action.Droplet{GUID: "previous-droplet-guid"},
+		}, v3action.Warnings{"rollback-warning"}, nil)
+		fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, nil)
+		fakeActor.StopApplicationReturns(v3action.Warnings{"stop-warning"}, nil)
+		fakeActor.StartApplicationReturns(v3action.Application{}, v3action.Warnings{"start-warning"}, nil)
+		fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+			warnings <- v3action.Warnings{"poll-warning"}
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --to is less than 1", func() {
+		BeforeEach(func() {
+			cmd.To = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{ArgumentName: "--to", ExpectedType: "a positive integer"}))
+		})
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when --rolling is provided and the API version is below the deployments minimum", func() {
+		BeforeEach(func() {
+			cmd.Rolling = true
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--rolling'",
+				CurrentVersion: ccversion.MinVersionV3,
+				MinimumVersion: ccversion.MinVersionDeploymentsV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when there is no previous droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationRollbackReturns(v3action.DropletRollback{}, v3action.Warnings{"rollback-warning"}, actionerror.NoPreviousDropletError{AppName: "some-app", Previous: 1})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoPreviousDropletError{AppName: "some-app", Previous: 1}))
+			Expect(testUI.Err).To(Say("rollback-warning"))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	It("sets the previous droplet and restarts the app", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Rolling back app some-app in org some-org / space some-space as steve..."))
+		Expect(testUI.Out).To(Say(`Setting droplet previous-droplet-guid \(was current-droplet-guid\)\.\.\.`))
+		Expect(testUI.Out).To(Say("Waiting for app to start..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("rollback-warning"))
+		Expect(testUI.Err).To(Say("set-droplet-warning"))
+		Expect(testUI.Err).To(Say("stop-warning"))
+		Expect(testUI.Err).To(Say("start-warning"))
+		Expect(testUI.Err).To(Say("poll-warning"))
+
+		appName, spaceGUID, previous := fakeActor.GetApplicationRollbackArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(previous).To(Equal(1))
+
+		Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+		appName, spaceGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(dropletGUID).To(Equal("previous-droplet-guid"))
+
+		Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+		Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+		Expect(fakeActor.GetApplicationCrashedProcessTypesArgsForCall(0)).To(Equal("some-app-guid"))
+		Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+	})
+
+	Context("when the app is stopped", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationRollbackReturns(v3action.DropletRollback{
+				Application: v3action.Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStopped},
+				From:        v3action.Droplet{GUID: "current-droplet-guid"},
+				To:          v3action.Droplet{GUID: "previous-droplet-guid"},
+			}, nil, nil)
+		})
+
+		It("sets the droplet without starting the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is stopped and will use the droplet when it is next started."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, errors.New("set-droplet-error"))
+		})
+
+		It("returns the error without restarting the app", func() {
+			Expect(executeErr).To(MatchError("set-droplet-error"))
+			Expect(testUI.Err).To(Say("set-droplet-warning"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when all the instances of a process crash", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashedProcessTypesReturnsOnCall(0, []string{"web"}, v3action.Warnings{"crashed-warning"}, nil)
+			fakeActor.GetApplicationCrashedProcessTypesReturnsOnCall(1, nil, nil, nil)
+		})
+
+		It("reverts to the original droplet and returns a RollbackRevertedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RollbackRevertedError{AppName: "some-app", BinaryName: binaryName}))
+			Expect(testUI.Err).To(Say("crashed-warning"))
+			Expect(testUI.Err).To(Say(`App some-app failed to start with droplet previous-droplet-guid\. Reverting to droplet current-droplet-guid\.\.\.`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(2))
+			_, _, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(1)
+			Expect(dropletGUID).To(Equal("current-droplet-guid"))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.PollStartCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when the app times out starting", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartReturnsOnCall(0, actionerror.StartupTimeoutError{})
+		})
+
+		It("reverts to the original droplet", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RollbackRevertedError{AppName: "some-app", BinaryName: binaryName}))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(2))
+			Expect(fakeActor.GetApplicationCrashedProcessTypesCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when polling fails with an unexpected error", func() {
+		BeforeEach(func() {
+			fakeActor.PollStartReturns(errors.New("poll-error"))
+		})
+
+		It("returns the error without reverting", func() {
+			Expect(executeErr).To(MatchError("poll-error"))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when --rolling is provided", func() {
+		BeforeEach(func() {
+			cmd.Rolling = true
+			fakeActor.CreateDeploymentReturns("some-deployment-guid", v3action.Warnings{"deployment-warning"}, nil)
+			fakeActor.PollDeploymentStub = func(deploymentGUID string, warnings chan<- v3action.Warnings) error {
+				warnings <- v3action.Warnings{"poll-deployment-warning"}
+				return nil
+			}
+		})
+
+		It("replaces the app instances with a deployment instead of stopping the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Waiting for app instances to be replaced..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("deployment-warning"))
+			Expect(testUI.Err).To(Say("poll-deployment-warning"))
+
+			Expect(fakeActor.CreateDeploymentArgsForCall(0)).To(Equal("some-app-guid"))
+			deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.PollStartCallCount()).To(Equal(0))
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeActor.PollDeploymentStub = nil
+				fakeActor.PollDeploymentReturnsOnCall(0, actionerror.DeploymentCanceledError{DeploymentGUID: "some-deployment-guid"})
+			})
+
+			It("reverts to the original droplet with another deployment", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RollbackRevertedError{AppName: "some-app", BinaryName: binaryName}))
+				Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(2))
+				_, _, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(1)
+				Expect(dropletGUID).To(Equal("current-droplet-guid"))
+			})
+		})
+	})
+})
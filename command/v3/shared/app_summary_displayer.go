@@ -65,6 +65,7 @@ func (display AppSummaryDisplayer) displayAppInstancesTable(processSummary v3act
 	})
 
 	if !display.processHasAnInstance(&processSummary) {
+		display.UI.DisplayText("   There are no running instances of this process.")
 		return
 	}
 
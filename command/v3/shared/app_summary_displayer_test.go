@@ -160,6 +160,50 @@ var _ = Describe("app summary displayer", func() {
 			})
 		})
 
+		Context("when a process type has no running instances", func() {
+			BeforeEach(func() {
+				appSummary := v3action.ApplicationSummary{
+					ProcessSummaries: v3action.ProcessSummaries{
+						{
+							Process: v3action.Process{
+								Type:       constant.ProcessTypeWeb,
+								MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+							},
+							InstanceDetails: []v3action.ProcessInstance{
+								v3action.ProcessInstance{
+									Index:       0,
+									State:       constant.ProcessInstanceRunning,
+									MemoryQuota: 33554432,
+									Uptime:      int(time.Now().Sub(time.Unix(267321600, 0)).Seconds()),
+								},
+							},
+						},
+						{
+							Process: v3action.Process{
+								Type:       "worker",
+								MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+							},
+						},
+					},
+				}
+
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(appSummary, nil, nil)
+			})
+
+			It("lists every process type with its own instance table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				processTable := helpers.ParseV3AppProcessTable(output.Contents())
+				Expect(processTable.Processes).To(HaveLen(2))
+				Expect(processTable.Processes[0].Title).To(Equal("web:1/1"))
+				Expect(processTable.Processes[0].Instances).To(HaveLen(1))
+				Expect(processTable.Processes[1].Title).To(Equal("worker:0/0"))
+				Expect(processTable.Processes[1].Instances).To(BeEmpty())
+
+				Expect(testUI.Out).To(Say(`worker:0/0\n\s+There are no running instances of this process\.`))
+			})
+		})
+
 		Context("when getting the app summary fails", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
@@ -13,9 +13,12 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	pluginshared "code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 //go:generate counterfeiter . V2PushActor
@@ -27,6 +30,7 @@ type V2PushActor interface {
 //go:generate counterfeiter . V3PushActor
 
 type V3PushActor interface {
+	ApplyApplicationProcesses(appGUID string, processes []manifestparser.Process) (v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
 	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
@@ -40,6 +44,7 @@ type V3PushActor interface {
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	UpdateApplication(app v3action.Application) (v3action.Application, v3action.Warnings, error)
+	UploadDroplet(appGUID string, pathToDroplet string) (v3action.Droplet, v3action.Warnings, error)
 }
 
 type V3PushCommand struct {
@@ -47,12 +52,14 @@ type V3PushCommand struct {
 	Buildpacks     []string                    `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	DockerImage    flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DropletPath    flag.PathWithExistenceCheck `long:"droplet" description:"Path to a tgz file with a pre-staged app, such as one written by download-droplet; the app is not staged"`
 	NoRoute        bool                        `long:"no-route" description:"Do not map a route to this app"`
 	NoStart        bool                        `long:"no-start" description:"Do not stage and start the app after pushing"`
 	AppPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	PathToManifest flag.PathWithExistenceCheck `short:"f" description:"Path to app manifest; the settings of its processes are applied to the app"`
 	dockerPassword interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start]"`
+	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [-f MANIFEST_PATH] [--no-route] [--no-start]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [-f MANIFEST_PATH] [--no-route] [--no-start]\n   cf v3-push APP_NAME --droplet DROPLET_PATH [-f MANIFEST_PATH] [--no-route] [--no-start]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -64,6 +71,8 @@ type V3PushCommand struct {
 	V2PushActor         V2PushActor
 	AppSummaryDisplayer shared.AppSummaryDisplayer
 	PackageDisplayer    shared.PackageDisplayer
+	HookRunner          command.PluginHookRunner
+	Parser              ManifestParser
 }
 
 func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -102,6 +111,8 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 		AppName:         cmd.RequiredArgs.AppName,
 	}
 	cmd.PackageDisplayer = shared.NewPackageDisplayer(cmd.UI, cmd.Config)
+	cmd.HookRunner = pluginshared.NewHookRunner(config, ui)
+	cmd.Parser = manifestparser.NewParser()
 
 	return nil
 }
@@ -133,6 +144,26 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return translatableerror.ConflictingBuildpacksError{}
 	}
 
+	var processes []manifestparser.Process
+	if cmd.PathToManifest != "" {
+		err = cmd.Parser.Parse(string(cmd.PathToManifest))
+		if err != nil {
+			return err
+		}
+		processes = cmd.Parser.Processes(cmd.RequiredArgs.AppName)
+	}
+
+	// Without a droplet the app's process types are only known after staging,
+	// so the manifest's processes cannot be applied when staging is skipped.
+	if cmd.NoStart && cmd.DropletPath == "" && len(processes) > 0 {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--no-start", "-f with processes"}}
+	}
+
+	err = cmd.HookRunner.Run(cmd.pushHookEvent(plugin.PrePushHook))
+	if err != nil {
+		return err
+	}
+
 	var app v3action.Application
 	app, err = cmd.getApplication()
 	if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
@@ -149,7 +180,15 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		}
 	}
 
-	pkg, err := cmd.createPackage()
+	var (
+		pkg         v3action.Package
+		dropletGUID string
+	)
+	if cmd.DropletPath != "" {
+		dropletGUID, err = cmd.uploadDroplet(app.GUID, user.Name)
+	} else {
+		pkg, err = cmd.createPackage()
+	}
 	if err != nil {
 		return err
 	}
@@ -161,13 +200,15 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		}
 	}
 
-	if cmd.NoStart {
-		return nil
-	}
+	if cmd.DropletPath == "" {
+		if cmd.NoStart {
+			return cmd.HookRunner.Run(cmd.pushHookEvent(plugin.PostPushHook))
+		}
 
-	dropletGUID, err := cmd.stagePackage(pkg, user.Name)
-	if err != nil {
-		return err
+		dropletGUID, err = cmd.stagePackage(pkg, user.Name)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = cmd.setApplicationDroplet(dropletGUID, user.Name)
@@ -175,6 +216,17 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if len(processes) > 0 {
+		err = cmd.applyProcesses(app.GUID, processes, user.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.NoStart {
+		return cmd.HookRunner.Run(cmd.pushHookEvent(plugin.PostPushHook))
+	}
+
 	if !cmd.NoRoute {
 		err = cmd.createAndMapRoutes(app)
 		if err != nil {
@@ -224,7 +276,23 @@ func (cmd V3PushCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	return cmd.AppSummaryDisplayer.DisplayAppInfo()
+	err = cmd.AppSummaryDisplayer.DisplayAppInfo()
+	if err != nil {
+		return err
+	}
+
+	return cmd.HookRunner.Run(cmd.pushHookEvent(plugin.PostPushHook))
+}
+
+func (cmd V3PushCommand) pushHookEvent(name string) plugin.HookEvent {
+	event := pluginshared.NewHookEvent(name, cmd.Config)
+	event.Applications = []plugin.HookApplication{{
+		Name:        cmd.RequiredArgs.AppName,
+		Path:        string(cmd.AppPath),
+		Buildpacks:  cmd.Buildpacks,
+		DockerImage: cmd.DockerImage.Path,
+	}}
+	return event
 }
 
 func (cmd V3PushCommand) validateArgs() error {
@@ -237,6 +305,10 @@ func (cmd V3PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"-b", "--docker-image", "-o"},
 		}
+	case cmd.DropletPath != "" && (cmd.DockerImage.Path != "" || cmd.AppPath != "" || len(cmd.Buildpacks) > 0):
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--droplet", "--docker-image", "-o", "-p", "-b"},
+		}
 	case cmd.DockerUsername != "" && cmd.DockerImage.Path == "":
 		return translatableerror.RequiredFlagsError{
 			Arg1: "--docker-image, -o", Arg2: "--docker-username",
@@ -362,6 +434,26 @@ func (cmd V3PushCommand) createPackage() (v3action.Package, error) {
 	return pkg, nil
 }
 
+func (cmd V3PushCommand) uploadDroplet(appGUID string, userName string) (string, error) {
+	cmd.UI.DisplayTextWithFlavor("Uploading droplet {{.Path}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      cmd.DropletPath,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	droplet, warnings, err := cmd.Actor.UploadDroplet(appGUID, string(cmd.DropletPath))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return droplet.GUID, nil
+}
+
 func (cmd V3PushCommand) stagePackage(pkg v3action.Package, userName string) (string, error) {
 	cmd.UI.DisplayTextWithFlavor("Staging package for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
@@ -407,6 +499,25 @@ func (cmd V3PushCommand) setApplicationDroplet(dropletGUID string, userName stri
 	return nil
 }
 
+func (cmd V3PushCommand) applyProcesses(appGUID string, processes []manifestparser.Process, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Applying process settings from manifest to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	warnings, err := cmd.Actor.ApplyApplicationProcesses(appGUID, processes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd V3PushCommand) startApplication(appGUID string, userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
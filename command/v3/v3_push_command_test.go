@@ -20,6 +20,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -37,6 +38,8 @@ var _ = Describe("v3-push Command", func() {
 		fakeActor       *v3fakes.FakeV3PushActor
 		fakeV2PushActor *v3fakes.FakeV2PushActor
 		fakeV2AppActor  *sharedfakes.FakeV2AppRouteActor
+		fakeHookRunner  *commandfakes.FakePluginHookRunner
+		fakeParser      *v3fakes.FakeManifestParser
 		binaryName      string
 		executeErr      error
 		app             string
@@ -53,6 +56,8 @@ var _ = Describe("v3-push Command", func() {
 		fakeV2PushActor = new(v3fakes.FakeV2PushActor)
 		fakeV2AppActor = new(sharedfakes.FakeV2AppRouteActor)
 		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+		fakeHookRunner = new(commandfakes.FakePluginHookRunner)
+		fakeParser = new(v3fakes.FakeManifestParser)
 
 		fakeConfig.StagingTimeoutReturns(10 * time.Minute)
 
@@ -87,6 +92,8 @@ var _ = Describe("v3-push Command", func() {
 			NOAAClient:          fakeNOAAClient,
 			AppSummaryDisplayer: appSummaryDisplayer,
 			PackageDisplayer:    packageDisplayer,
+			HookRunner:          fakeHookRunner,
+			Parser:              fakeParser,
 		}
 		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
 
@@ -177,6 +184,20 @@ var _ = Describe("v3-push Command", func() {
 			}),
 	)
 
+	Context("when --droplet is provided along with app bits flags", func() {
+		BeforeEach(func() {
+			cmd.DropletPath = "some-droplet.tgz"
+			cmd.AppPath = "some/app/path"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--droplet", "--docker-image", "-o", "-p", "-b"},
+			}))
+			Expect(fakeActor.UploadDropletCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -200,6 +221,58 @@ var _ = Describe("v3-push Command", func() {
 
 		})
 
+		Context("when a pre-push plugin hook vetoes the push", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				cmd.Buildpacks = []string{"some-buildpack"}
+				expectedErr = translatableerror.PluginHookVetoedError{PluginName: "ticket-check", Event: "pre-push"}
+				fakeHookRunner.RunReturns(expectedErr)
+			})
+
+			It("returns the error before touching the application", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+
+				Expect(fakeHookRunner.RunCallCount()).To(Equal(1))
+				event := fakeHookRunner.RunArgsForCall(0)
+				Expect(event.Name).To(Equal("pre-push"))
+				Expect(event.Target.Space).To(Equal(spaceName))
+				Expect(event.Applications).To(HaveLen(1))
+				Expect(event.Applications[0].Name).To(Equal(app))
+				Expect(event.Applications[0].Buildpacks).To(Equal([]string{"some-buildpack"}))
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the manifest cannot be parsed", func() {
+			BeforeEach(func() {
+				cmd.PathToManifest = "some-manifest-path"
+				fakeParser.ParseReturns(errors.New("some-parse-error"))
+			})
+
+			It("returns the error before touching the application", func() {
+				Expect(executeErr).To(MatchError("some-parse-error"))
+
+				Expect(fakeParser.ParseCallCount()).To(Equal(1))
+				Expect(fakeParser.ParseArgsForCall(0)).To(Equal("some-manifest-path"))
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --no-start is provided with a manifest that has processes", func() {
+			BeforeEach(func() {
+				cmd.NoStart = true
+				cmd.PathToManifest = "some-manifest-path"
+				fakeParser.ProcessesReturns([]manifestparser.Process{{Type: "worker"}})
+			})
+
+			It("returns an ArgumentCombinationError before touching the application", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--no-start", "-f with processes"}}))
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when looking up the application returns some api error", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-warning"}, errors.New("some-error"))
@@ -253,6 +326,63 @@ var _ = Describe("v3-push Command", func() {
 					Expect(createSpaceGUID).To(Equal("some-space-guid"))
 				})
 
+				Context("when --droplet is provided", func() {
+					BeforeEach(func() {
+						cmd.DropletPath = "some-droplet.tgz"
+					})
+
+					Context("when uploading the droplet fails", func() {
+						BeforeEach(func() {
+							fakeActor.UploadDropletReturns(v3action.Droplet{}, v3action.Warnings{"upload-warning"}, errors.New("upload-error"))
+						})
+
+						It("displays the warnings and returns the error", func() {
+							Expect(executeErr).To(MatchError("upload-error"))
+
+							Expect(testUI.Out).To(Say("Uploading droplet some-droplet.tgz for app some-app in org some-org / space some-space as banana..."))
+							Expect(testUI.Err).To(Say("upload-warning"))
+							Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when uploading the droplet succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.UploadDropletReturns(v3action.Droplet{GUID: "uploaded-droplet-guid"}, v3action.Warnings{"upload-warning"}, nil)
+							fakeActor.SetApplicationDropletReturns(v3action.Warnings{"droplet-warning"}, nil)
+						})
+
+						It("uploads the droplet and sets it without creating or staging a package", func() {
+							Expect(testUI.Out).To(Say("Uploading droplet some-droplet.tgz for app some-app in org some-org / space some-space as banana..."))
+							Expect(testUI.Err).To(Say("upload-warning"))
+
+							Expect(fakeActor.UploadDropletCallCount()).To(Equal(1))
+							appGUID, path := fakeActor.UploadDropletArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(path).To(Equal("some-droplet.tgz"))
+
+							Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+							Expect(fakeActor.StagePackageCallCount()).To(Equal(0))
+
+							Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+							_, _, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+							Expect(dropletGUID).To(Equal("uploaded-droplet-guid"))
+						})
+
+						Context("when --no-start is provided", func() {
+							BeforeEach(func() {
+								cmd.NoStart = true
+							})
+
+							It("sets the droplet but does not start the app", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+								Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+							})
+						})
+					})
+				})
+
 				Context("when creating the package fails", func() {
 					var expectedErr error
 
@@ -359,6 +489,12 @@ var _ = Describe("v3-push Command", func() {
 
 							Expect(executeErr).ToNot(HaveOccurred())
 						})
+
+						It("runs the pre-push and post-push plugin hooks", func() {
+							Expect(fakeHookRunner.RunCallCount()).To(Equal(2))
+							Expect(fakeHookRunner.RunArgsForCall(0).Name).To(Equal("pre-push"))
+							Expect(fakeHookRunner.RunArgsForCall(1).Name).To(Equal("post-push"))
+						})
 					})
 
 					Context("when the logging does not error", func() {
@@ -511,6 +647,55 @@ var _ = Describe("v3-push Command", func() {
 									Expect(dropletGUID).To(Equal("some-droplet-guid"))
 								})
 
+								It("does not apply process settings", func() {
+									Expect(fakeParser.ParseCallCount()).To(Equal(0))
+									Expect(fakeActor.ApplyApplicationProcessesCallCount()).To(Equal(0))
+								})
+
+								Context("when a manifest with processes is provided via -f flag", func() {
+									var processes []manifestparser.Process
+
+									BeforeEach(func() {
+										cmd.PathToManifest = "some-manifest-path"
+										processes = []manifestparser.Process{{Type: "worker", Command: "some-command"}}
+										fakeParser.ProcessesReturns(processes)
+									})
+
+									Context("when applying the processes succeeds", func() {
+										BeforeEach(func() {
+											fakeActor.ApplyApplicationProcessesReturns(v3action.Warnings{"apply-processes-warning"}, nil)
+										})
+
+										It("applies the processes of the app before starting it", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+
+											Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid"))
+											Expect(testUI.Out).To(Say("Applying process settings from manifest to app some-app in org some-org / space some-space as banana\\.\\.\\."))
+											Expect(testUI.Out).To(Say("OK"))
+											Expect(testUI.Out).To(Say("Starting app some-app"))
+											Expect(testUI.Err).To(Say("apply-processes-warning"))
+
+											Expect(fakeParser.ProcessesArgsForCall(0)).To(Equal("some-app"))
+											Expect(fakeActor.ApplyApplicationProcessesCallCount()).To(Equal(1))
+											appGUID, passedProcesses := fakeActor.ApplyApplicationProcessesArgsForCall(0)
+											Expect(appGUID).To(Equal("some-app-guid"))
+											Expect(passedProcesses).To(Equal(processes))
+										})
+									})
+
+									Context("when applying the processes fails", func() {
+										BeforeEach(func() {
+											fakeActor.ApplyApplicationProcessesReturns(v3action.Warnings{"apply-processes-warning"}, errors.New("some-apply-error"))
+										})
+
+										It("returns the error and does not start the app", func() {
+											Expect(executeErr).To(MatchError("some-apply-error"))
+											Expect(testUI.Err).To(Say("apply-processes-warning"))
+											Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+										})
+									})
+								})
+
 								Context("when --no-route flag is set to true", func() {
 									BeforeEach(func() {
 										cmd.NoRoute = true
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -106,8 +107,34 @@ func (cmd V3StageCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("droplet guid:"), droplet.GUID},
 		{cmd.UI.TranslateText("state:"), strings.ToLower(string(droplet.State))},
 		{cmd.UI.TranslateText("created:"), cmd.UI.UserFriendlyDate(t)},
+		{cmd.UI.TranslateText("stack:"), droplet.Stack},
 	}
 
+	if droplet.Image != "" {
+		table = append(table, []string{cmd.UI.TranslateText("docker image:"), droplet.Image})
+	} else {
+		table = append(table, []string{cmd.UI.TranslateText("buildpacks:"), stagedBuildpacks(droplet.Buildpacks)})
+	}
+	table = append(table, []string{cmd.UI.TranslateText("start command:"), droplet.ProcessTypes[constant.ProcessTypeWeb]})
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 	return nil
 }
+
+// stagedBuildpacks lists the buildpacks that staged a droplet along with the
+// versions and detect output they reported.
+func stagedBuildpacks(buildpacks []v3action.Buildpack) string {
+	var descriptions []string
+	for _, buildpack := range buildpacks {
+		description := buildpack.Name
+		if buildpack.Version != "" {
+			description += " " + buildpack.Version
+		}
+		if buildpack.DetectOutput != "" && buildpack.DetectOutput != buildpack.Name {
+			description += " (" + buildpack.DetectOutput + ")"
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
@@ -153,6 +153,12 @@ var _ = Describe("v3-stage Command", func() {
 								GUID:      "some-droplet-guid",
 								CreatedAt: dropletCreateTime,
 								State:     constant.DropletStaged,
+								Stack:     "cflinuxfs2",
+								Buildpacks: []v3action.Buildpack{
+									{Name: "ruby_buildpack", Version: "1.7.18", DetectOutput: "ruby 2.4.2"},
+									{Name: "https://github.com/some/buildpack"},
+								},
+								ProcessTypes: map[string]string{"web": "bundle exec rackup"},
 							}
 						}()
 
@@ -172,6 +178,9 @@ var _ = Describe("v3-stage Command", func() {
 					Expect(testUI.Out).To(Say("droplet guid:\\s+some-droplet-guid"))
 					Expect(testUI.Out).To(Say("state:\\s+staged"))
 					Expect(testUI.Out).To(Say("created:\\s+%s", testUI.UserFriendlyDate(createdAtTimeParsed)))
+					Expect(testUI.Out).To(Say("stack:\\s+cflinuxfs2"))
+					Expect(testUI.Out).To(Say(`buildpacks:\s+ruby_buildpack 1\.7\.18 \(ruby 2\.4\.2\), https://github\.com/some/buildpack`))
+					Expect(testUI.Out).To(Say("start command:\\s+bundle exec rackup"))
 
 					Expect(testUI.Err).To(Say("some-warning"))
 					Expect(testUI.Err).To(Say("some-other-warning"))
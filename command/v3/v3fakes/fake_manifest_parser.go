@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 type FakeManifestParser struct {
@@ -17,6 +18,17 @@ type FakeManifestParser struct {
 	appNamesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	ProcessesStub        func(appName string) []manifestparser.Process
+	processesMutex       sync.RWMutex
+	processesArgsForCall []struct {
+		appName string
+	}
+	processesReturns struct {
+		result1 []manifestparser.Process
+	}
+	processesReturnsOnCall map[int]struct {
+		result1 []manifestparser.Process
+	}
 	RawManifestStub        func(name string) ([]byte, error)
 	rawManifestMutex       sync.RWMutex
 	rawManifestArgsForCall []struct {
@@ -85,6 +97,54 @@ func (fake *FakeManifestParser) AppNamesReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeManifestParser) Processes(appName string) []manifestparser.Process {
+	fake.processesMutex.Lock()
+	ret, specificReturn := fake.processesReturnsOnCall[len(fake.processesArgsForCall)]
+	fake.processesArgsForCall = append(fake.processesArgsForCall, struct {
+		appName string
+	}{appName})
+	fake.recordInvocation("Processes", []interface{}{appName})
+	fake.processesMutex.Unlock()
+	if fake.ProcessesStub != nil {
+		return fake.ProcessesStub(appName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.processesReturns.result1
+}
+
+func (fake *FakeManifestParser) ProcessesCallCount() int {
+	fake.processesMutex.RLock()
+	defer fake.processesMutex.RUnlock()
+	return len(fake.processesArgsForCall)
+}
+
+func (fake *FakeManifestParser) ProcessesArgsForCall(i int) string {
+	fake.processesMutex.RLock()
+	defer fake.processesMutex.RUnlock()
+	return fake.processesArgsForCall[i].appName
+}
+
+func (fake *FakeManifestParser) ProcessesReturns(result1 []manifestparser.Process) {
+	fake.ProcessesStub = nil
+	fake.processesReturns = struct {
+		result1 []manifestparser.Process
+	}{result1}
+}
+
+func (fake *FakeManifestParser) ProcessesReturnsOnCall(i int, result1 []manifestparser.Process) {
+	fake.ProcessesStub = nil
+	if fake.processesReturnsOnCall == nil {
+		fake.processesReturnsOnCall = make(map[int]struct {
+			result1 []manifestparser.Process
+		})
+	}
+	fake.processesReturnsOnCall[i] = struct {
+		result1 []manifestparser.Process
+	}{result1}
+}
+
 func (fake *FakeManifestParser) RawManifest(name string) ([]byte, error) {
 	fake.rawManifestMutex.Lock()
 	ret, specificReturn := fake.rawManifestReturnsOnCall[len(fake.rawManifestArgsForCall)]
@@ -189,6 +249,8 @@ func (fake *FakeManifestParser) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.appNamesMutex.RLock()
 	defer fake.appNamesMutex.RUnlock()
+	fake.processesMutex.RLock()
+	defer fake.processesMutex.RUnlock()
 	fake.rawManifestMutex.RLock()
 	defer fake.rawManifestMutex.RUnlock()
 	fake.parseMutex.RLock()
@@ -6,9 +6,24 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 type FakeV3PushActor struct {
+	ApplyApplicationProcessesStub        func(appGUID string, processes []manifestparser.Process) (v3action.Warnings, error)
+	applyApplicationProcessesMutex       sync.RWMutex
+	applyApplicationProcessesArgsForCall []struct {
+		appGUID   string
+		processes []manifestparser.Process
+	}
+	applyApplicationProcessesReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	applyApplicationProcessesReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -205,10 +220,83 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	UploadDropletStub        func(appGUID string, pathToDroplet string) (v3action.Droplet, v3action.Warnings, error)
+	uploadDropletMutex       sync.RWMutex
+	uploadDropletArgsForCall []struct {
+		appGUID       string
+		pathToDroplet string
+	}
+	uploadDropletReturns struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	uploadDropletReturnsOnCall map[int]struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3PushActor) ApplyApplicationProcesses(appGUID string, processes []manifestparser.Process) (v3action.Warnings, error) {
+	var processesCopy []manifestparser.Process
+	if processes != nil {
+		processesCopy = make([]manifestparser.Process, len(processes))
+		copy(processesCopy, processes)
+	}
+	fake.applyApplicationProcessesMutex.Lock()
+	ret, specificReturn := fake.applyApplicationProcessesReturnsOnCall[len(fake.applyApplicationProcessesArgsForCall)]
+	fake.applyApplicationProcessesArgsForCall = append(fake.applyApplicationProcessesArgsForCall, struct {
+		appGUID   string
+		processes []manifestparser.Process
+	}{appGUID, processesCopy})
+	fake.recordInvocation("ApplyApplicationProcesses", []interface{}{appGUID, processesCopy})
+	fake.applyApplicationProcessesMutex.Unlock()
+	if fake.ApplyApplicationProcessesStub != nil {
+		return fake.ApplyApplicationProcessesStub(appGUID, processes)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.applyApplicationProcessesReturns.result1, fake.applyApplicationProcessesReturns.result2
+}
+
+func (fake *FakeV3PushActor) ApplyApplicationProcessesCallCount() int {
+	fake.applyApplicationProcessesMutex.RLock()
+	defer fake.applyApplicationProcessesMutex.RUnlock()
+	return len(fake.applyApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeV3PushActor) ApplyApplicationProcessesArgsForCall(i int) (string, []manifestparser.Process) {
+	fake.applyApplicationProcessesMutex.RLock()
+	defer fake.applyApplicationProcessesMutex.RUnlock()
+	return fake.applyApplicationProcessesArgsForCall[i].appGUID, fake.applyApplicationProcessesArgsForCall[i].processes
+}
+
+func (fake *FakeV3PushActor) ApplyApplicationProcessesReturns(result1 v3action.Warnings, result2 error) {
+	fake.ApplyApplicationProcessesStub = nil
+	fake.applyApplicationProcessesReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) ApplyApplicationProcessesReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ApplyApplicationProcessesStub = nil
+	if fake.applyApplicationProcessesReturnsOnCall == nil {
+		fake.applyApplicationProcessesReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.applyApplicationProcessesReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -901,9 +989,66 @@ func (fake *FakeV3PushActor) UpdateApplicationReturnsOnCall(i int, result1 v3act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) UploadDroplet(appGUID string, pathToDroplet string) (v3action.Droplet, v3action.Warnings, error) {
+	fake.uploadDropletMutex.Lock()
+	ret, specificReturn := fake.uploadDropletReturnsOnCall[len(fake.uploadDropletArgsForCall)]
+	fake.uploadDropletArgsForCall = append(fake.uploadDropletArgsForCall, struct {
+		appGUID       string
+		pathToDroplet string
+	}{appGUID, pathToDroplet})
+	fake.recordInvocation("UploadDroplet", []interface{}{appGUID, pathToDroplet})
+	fake.uploadDropletMutex.Unlock()
+	if fake.UploadDropletStub != nil {
+		return fake.UploadDropletStub(appGUID, pathToDroplet)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.uploadDropletReturns.result1, fake.uploadDropletReturns.result2, fake.uploadDropletReturns.result3
+}
+
+func (fake *FakeV3PushActor) UploadDropletCallCount() int {
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	return len(fake.uploadDropletArgsForCall)
+}
+
+func (fake *FakeV3PushActor) UploadDropletArgsForCall(i int) (string, string) {
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	return fake.uploadDropletArgsForCall[i].appGUID, fake.uploadDropletArgsForCall[i].pathToDroplet
+}
+
+func (fake *FakeV3PushActor) UploadDropletReturns(result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.UploadDropletStub = nil
+	fake.uploadDropletReturns = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) UploadDropletReturnsOnCall(i int, result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.UploadDropletStub = nil
+	if fake.uploadDropletReturnsOnCall == nil {
+		fake.uploadDropletReturnsOnCall = make(map[int]struct {
+			result1 v3action.Droplet
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.uploadDropletReturnsOnCall[i] = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.applyApplicationProcessesMutex.RLock()
+	defer fake.applyApplicationProcessesMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
@@ -930,6 +1075,8 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
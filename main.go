@@ -4,16 +4,24 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"reflect"
+	"regexp"
 	"strings"
+	"syscall"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/command/flag"
+	pluginshared "code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	v2shared "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
+	"code.cloudfoundry.org/cli/util/spellcheck"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/jessevdk/go-flags"
 	log "github.com/sirupsen/logrus"
@@ -40,15 +48,83 @@ var ParseErr = errors.New("incorrect type for arg")
 
 func main() {
 	defer panichandler.HandlePanic()
-	exitStatus := parse(os.Args[1:])
+	exitStatus := runUserAliasOrParse(os.Args[1:])
 	if exitStatus != 0 {
 		os.Exit(exitStatus)
 	}
 }
 
+// runUserAliasOrParse runs the command lines of the user-defined alias named
+// by the first argument, if there is one, and otherwise parses args as usual.
+func runUserAliasOrParse(args []string) int {
+	if len(args) == 0 || isOption(args[0]) || isCommand(args[0]) {
+		return parse(args)
+	}
+
+	cfConfig, err := configv3.LoadConfig()
+	if err != nil {
+		return parse(args)
+	}
+
+	commandLines, isAlias, err := sharedaction.NewActor(cfConfig).ExpandUserAlias(common.Commands, args)
+	if !isAlias {
+		return parse(args)
+	}
+	if err != nil {
+		commandUI, uiErr := ui.NewUI(cfConfig)
+		if uiErr != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+			return 1
+		}
+		commandUI.DisplayError(translatableerror.ConvertToTranslatableError(err))
+		return 1
+	}
+
+	if len(commandLines) == 1 {
+		// the legacy code reads os.Args directly
+		os.Args = append([]string{os.Args[0]}, commandLines[0]...)
+		return parse(commandLines[0])
+	}
+
+	return runCommandLines(commandLines)
+}
+
+// runCommandLines runs each command line in a new CLI process, because legacy
+// commands exit the process when they finish, and stops at the first one that
+// fails.
+func runCommandLines(commandLines [][]string) int {
+	executable, err := os.Executable()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
+		return 1
+	}
+
+	for _, commandLine := range commandLines {
+		child := exec.Command(executable, commandLine...)
+		child.Stdin = os.Stdin
+		child.Stdout = os.Stdout
+		child.Stderr = os.Stderr
+
+		err = child.Run()
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+				return status.ExitStatus()
+			}
+			return 1
+		} else if err != nil {
+			fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
+			return 1
+		}
+	}
+
+	return 0
+}
+
 func parse(args []string) int {
 	parser := flags.NewParser(&common.Commands, flags.HelpFlag)
-	parser.CommandHandler = executionWrapper
+	parser.CommandHandler = func(cmd flags.Commander, args []string) error {
+		return executionWrapper(cmd, args, parser.Active.Name)
+	}
 	extraArgs, err := parser.ParseArgs(args)
 	if err == nil {
 		return 0
@@ -97,6 +173,10 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 			fmt.Fprintf(os.Stderr, "Incorrect Usage: %s\n\n", flagErr.Error())
 		}
 
+		if found && flagErr.Type == flags.ErrUnknownFlag {
+			displayFlagSuggestions(flagErr, parser.Active)
+		}
+
 		var helpErrored int
 		if found {
 			helpErrored = parse([]string{"help", parser.Active.Name})
@@ -140,6 +220,39 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 	return 0
 }
 
+var unknownFlagRegexp = regexp.MustCompile("^unknown flag `(.+)'$")
+
+// displayFlagSuggestions suggests the flags of cmd that are spelled similarly
+// to the unknown flag in flagErr.
+func displayFlagSuggestions(flagErr *flags.Error, cmd *flags.Command) {
+	matches := unknownFlagRegexp.FindStringSubmatch(flagErr.Message)
+	if matches == nil || len(matches[1]) < 2 {
+		return
+	}
+
+	var longNames []string
+	for _, option := range cmd.Options() {
+		if option.LongName != "" && !option.Hidden {
+			longNames = append(longNames, option.LongName)
+		}
+	}
+
+	suggestions := spellcheck.NewNameSuggester(longNames).Recommend(matches[1])
+	if len(suggestions) == 0 {
+		return
+	}
+
+	quoted := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("'--%s'", suggestion))
+	}
+	names := quoted[len(quoted)-1]
+	if len(quoted) > 1 {
+		names = strings.Join(quoted[:len(quoted)-1], ", ") + " or " + names
+	}
+	fmt.Fprintf(os.Stderr, "Did you mean %s?\n\n", names)
+}
+
 func isCommand(s string) bool {
 	_, found := reflect.TypeOf(common.Commands).FieldByNameFunc(
 		func(fieldName string) bool {
@@ -154,7 +267,7 @@ func isOption(s string) bool {
 	return strings.HasPrefix(s, "-")
 }
 
-func executionWrapper(cmd flags.Commander, args []string) error {
+func executionWrapper(cmd flags.Commander, args []string, commandName string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
 		Verbose: common.Commands.VerboseOrVersion,
 	})
@@ -208,7 +321,16 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		if err != nil {
 			return handleError(err, commandUI)
 		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+		hookRunner := pluginshared.NewHookRunner(cfConfig, commandUI)
+		err = common.ExecuteWithPluginHooks(extendedCmd, args, commandName, cfConfig, hookRunner)
+		err = common.AddNotFoundSuggestions(err, extendedCmd, cfConfig, func() (common.NotFoundSuggestionsActor, error) {
+			ccClient, uaaClient, clientErr := v2shared.NewClients(cfConfig, commandUI, true)
+			if clientErr != nil {
+				return nil, clientErr
+			}
+			return v2action.NewActor(ccClient, uaaClient, cfConfig), nil
+		})
+		return handleError(err, commandUI)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
@@ -50,6 +50,40 @@ func (c *cliConnection) sendPluginMetadataToCliServer(metadata PluginMetadata) {
 	os.Exit(0)
 }
 
+func (c *cliConnection) getHookEventFromCliServer() HookEvent {
+	var event HookEvent
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetHookEvent", "", &event)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	return event
+}
+
+func (c *cliConnection) sendHookResultToCliServer(result HookResult) {
+	var success bool
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.SetHookResult", result, &success)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if !success {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
 func (c *cliConnection) isMinCliVersion(version string) bool {
 	var result bool
 
@@ -0,0 +1,53 @@
+package plugin
+
+// Lifecycle events a plugin can subscribe to by listing them in
+// PluginMetadata.Hooks.
+const (
+	PreLoginHook   = "pre-login"
+	PostLoginHook  = "post-login"
+	PrePushHook    = "pre-push"
+	PostPushHook   = "post-push"
+	PreTargetHook  = "pre-target"
+	PostTargetHook = "post-target"
+)
+
+// HookHandler needs to be implemented by plugins that subscribe to lifecycle
+// events in their metadata. Returning a HookResult with Veto set from a "pre-"
+// event cancels the operation.
+type HookHandler interface {
+	HandleHook(cliConnection CliConnection, event HookEvent) HookResult
+}
+
+// HookEvent is the payload sent to a plugin's HandleHook. Applications is only
+// populated for push events.
+type HookEvent struct {
+	Name         string
+	Target       HookTarget
+	Applications []HookApplication
+}
+
+type HookTarget struct {
+	API          string
+	Organization string
+	Space        string
+	Username     string
+}
+
+type HookApplication struct {
+	Name        string
+	Path        string
+	Buildpacks  []string
+	DockerImage string
+	Stack       string
+	Command     string
+	Instances   int
+	Memory      uint64
+	DiskQuota   uint64
+	Routes      []string
+	Services    []string
+}
+
+type HookResult struct {
+	Veto    bool
+	Message string
+}
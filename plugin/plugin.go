@@ -58,6 +58,7 @@ type PluginMetadata struct {
 	Version       VersionType
 	MinCliVersion VersionType
 	Commands      []Command
+	Hooks         []string //Lifecycle events (e.g. PrePushHook) the plugin's HandleHook is run for
 }
 
 type Usage struct {
@@ -11,6 +11,7 @@ import (
 	* os.Args[1] port CF_CLI rpc server is running on
 	* os.Args[2] **OPTIONAL**
 		* SendMetadata - used to fetch the plugin metadata
+		* RunHook - used to run the plugin's HookHandler for a lifecycle event
 **/
 func Start(cmd Plugin) {
 	if len(os.Args) < 2 {
@@ -22,6 +23,13 @@ func Start(cmd Plugin) {
 	cliConnection.pingCLI()
 	if isMetadataRequest(os.Args) {
 		cliConnection.sendPluginMetadataToCliServer(cmd.GetMetadata())
+	} else if isHookRequest(os.Args) {
+		handler, ok := cmd.(HookHandler)
+		if !ok {
+			os.Exit(0)
+		}
+		event := cliConnection.getHookEventFromCliServer()
+		cliConnection.sendHookResultToCliServer(handler.HandleHook(cliConnection, event))
 	} else {
 		if version := MinCliVersionStr(cmd.GetMetadata().MinCliVersion); version != "" {
 			ok := cliConnection.isMinCliVersion(version)
@@ -39,6 +47,10 @@ func isMetadataRequest(args []string) bool {
 	return len(args) == 3 && args[2] == "SendMetadata"
 }
 
+func isHookRequest(args []string) bool {
+	return len(args) == 3 && args[2] == "RunHook"
+}
+
 func MinCliVersionStr(version VersionType) string {
 	if version.Major == 0 && version.Minor == 0 && version.Build == 0 {
 		return ""
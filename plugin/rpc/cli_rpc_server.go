@@ -39,6 +39,8 @@ type CliRpcService struct {
 type CliRpcCmd struct {
 	PluginMetadata       *plugin.PluginMetadata
 	MetadataMutex        *sync.RWMutex
+	HookEvent            *plugin.HookEvent
+	HookResult           *plugin.HookResult
 	outputCapture        OutputCapture
 	terminalOutputSwitch TerminalOutputSwitch
 	cliConfig            coreconfig.Repository
@@ -76,6 +78,8 @@ func NewRpcService(
 		RpcCmd: &CliRpcCmd{
 			PluginMetadata:       &plugin.PluginMetadata{},
 			MetadataMutex:        &sync.RWMutex{},
+			HookEvent:            &plugin.HookEvent{},
+			HookResult:           &plugin.HookResult{},
 			outputCapture:        outputCapture,
 			terminalOutputSwitch: terminalOutputSwitch,
 			cliConfig:            cliConfig,
@@ -163,6 +167,23 @@ func (cmd *CliRpcCmd) SetPluginMetadata(pluginMetadata plugin.PluginMetadata, re
 	return nil
 }
 
+func (cmd *CliRpcCmd) GetHookEvent(args string, retVal *plugin.HookEvent) error {
+	cmd.MetadataMutex.RLock()
+	defer cmd.MetadataMutex.RUnlock()
+
+	*retVal = *cmd.HookEvent
+	return nil
+}
+
+func (cmd *CliRpcCmd) SetHookResult(result plugin.HookResult, retVal *bool) error {
+	cmd.MetadataMutex.Lock()
+	defer cmd.MetadataMutex.Unlock()
+
+	cmd.HookResult = &result
+	*retVal = true
+	return nil
+}
+
 func (cmd *CliRpcCmd) DisableTerminalOutput(disable bool, retVal *bool) error {
 	cmd.terminalOutputSwitch.DisableTerminalOutput(disable)
 	*retVal = true
@@ -206,6 +206,55 @@ var _ = Describe("Server", func() {
 		})
 	})
 
+	Describe("hook events", func() {
+		BeforeEach(func() {
+			rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		Describe(".GetHookEvent", func() {
+			It("returns the event the hook is being run for", func() {
+				rpcService.RpcCmd.HookEvent = &plugin.HookEvent{
+					Name:         plugin.PrePushHook,
+					Target:       plugin.HookTarget{Organization: "some-org", Space: "some-space"},
+					Applications: []plugin.HookApplication{{Name: "some-app", Instances: 2}},
+				}
+
+				var event plugin.HookEvent
+				err = client.Call("CliRpcCmd.GetHookEvent", "", &event)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(event).To(Equal(*rpcService.RpcCmd.HookEvent))
+			})
+		})
+
+		Describe(".SetHookResult", func() {
+			It("sets the rpc command's hook result", func() {
+				var success bool
+				err = client.Call("CliRpcCmd.SetHookResult", plugin.HookResult{Veto: true, Message: "no ticket"}, &success)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(success).To(BeTrue())
+				Expect(rpcService.RpcCmd.HookResult).To(Equal(&plugin.HookResult{Veto: true, Message: "no ticket"}))
+			})
+		})
+	})
+
 	Describe(".GetOutputAndReset", func() {
 		Context("success", func() {
 			BeforeEach(func() {
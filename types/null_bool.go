@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NullBool is a wrapper around boolean values that can be null or a boolean.
+// Use IsSet to check if the value is provided, instead of checking against
+// false.
+type NullBool struct {
+	IsSet bool
+	Value bool
+}
+
+func (n *NullBool) UnmarshalJSON(rawJSON []byte) error {
+	var value *bool
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		n.Value = false
+		n.IsSet = false
+		return nil
+	}
+
+	n.Value = *value
+	n.IsSet = true
+
+	return nil
+}
+
+func (n NullBool) MarshalJSON() ([]byte, error) {
+	if n.IsSet {
+		return []byte(fmt.Sprint(n.Value)), nil
+	}
+	return []byte("null"), nil
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullBool", func() {
+	var nullBool NullBool
+
+	BeforeEach(func() {
+		nullBool = NullBool{}
+	})
+
+	DescribeTable("UnmarshalJSON",
+		func(rawJSON string, expectedNullBool NullBool) {
+			err := nullBool.UnmarshalJSON([]byte(rawJSON))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nullBool).To(Equal(expectedNullBool))
+		},
+		Entry("true", "true", NullBool{IsSet: true, Value: true}),
+		Entry("false", "false", NullBool{IsSet: true, Value: false}),
+		Entry("null", "null", NullBool{IsSet: false}),
+	)
+
+	Context("when a non-boolean value is provided", func() {
+		It("returns an error", func() {
+			err := nullBool.UnmarshalJSON([]byte(`"yes"`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("MarshalJSON",
+		func(nullBool NullBool, expectedBytes []byte) {
+			bytes, err := nullBool.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(expectedBytes))
+		},
+		Entry("true", NullBool{IsSet: true, Value: true}, []byte("true")),
+		Entry("false", NullBool{IsSet: true, Value: false}, []byte("false")),
+		Entry("no value", NullBool{IsSet: false}, []byte("null")),
+	)
+})
@@ -0,0 +1,75 @@
+// Package completion stores the resource names used to complete command line
+// arguments.
+package completion
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache keeps lists of names on disk for a short time, so that completing
+// several arguments in a row does not query the API for every key press.
+type Cache struct {
+	Path string
+	TTL  time.Duration
+	Now  func() time.Time
+}
+
+type cacheEntry struct {
+	Expires time.Time `json:"expires"`
+	Values  []string  `json:"values"`
+}
+
+// NewCache returns a Cache stored at path whose entries expire after ttl.
+func NewCache(path string, ttl time.Duration) *Cache {
+	return &Cache{
+		Path: path,
+		TTL:  ttl,
+		Now:  time.Now,
+	}
+}
+
+// Get returns the values stored for key, if they have not expired.
+func (cache Cache) Get(key string) ([]string, bool) {
+	entry, ok := cache.load()[key]
+	if !ok || !cache.Now().Before(entry.Expires) {
+		return nil, false
+	}
+	return entry.Values, true
+}
+
+// Set stores values for key, dropping any expired entries.
+func (cache Cache) Set(key string, values []string) error {
+	now := cache.Now()
+	entries := map[string]cacheEntry{}
+	for existingKey, entry := range cache.load() {
+		if now.Before(entry.Expires) {
+			entries[existingKey] = entry
+		}
+	}
+	entries[key] = cacheEntry{Expires: now.Add(cache.TTL), Values: values}
+
+	raw, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(cache.Path), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cache.Path, raw, 0600)
+}
+
+func (cache Cache) load() map[string]cacheEntry {
+	entries := map[string]cacheEntry{}
+	raw, err := ioutil.ReadFile(cache.Path)
+	if err != nil {
+		return entries
+	}
+	_ = json.Unmarshal(raw, &entries)
+	return entries
+}
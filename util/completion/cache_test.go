@@ -0,0 +1,77 @@
+package completion_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/completion"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		dir   string
+		now   time.Time
+		cache *Cache
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "completion-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		now = time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+		cache = NewCache(filepath.Join(dir, "nested", "cache.json"), time.Minute)
+		cache.Now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("returns nothing for keys that were never set", func() {
+		_, ok := cache.Get("some-key")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("returns values until they expire", func() {
+		Expect(cache.Set("some-key", []string{"a", "b"})).To(Succeed())
+
+		now = now.Add(59 * time.Second)
+		values, ok := cache.Get("some-key")
+		Expect(ok).To(BeTrue())
+		Expect(values).To(Equal([]string{"a", "b"}))
+
+		now = now.Add(time.Second)
+		_, ok = cache.Get("some-key")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("keeps other unexpired keys and drops expired ones when setting", func() {
+		Expect(cache.Set("old-key", []string{"old"})).To(Succeed())
+		now = now.Add(30 * time.Second)
+		Expect(cache.Set("some-key", []string{"a"})).To(Succeed())
+		now = now.Add(40 * time.Second)
+		Expect(cache.Set("other-key", []string{"b"})).To(Succeed())
+
+		raw, err := ioutil.ReadFile(cache.Path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(raw)).ToNot(ContainSubstring("old-key"))
+
+		values, ok := cache.Get("some-key")
+		Expect(ok).To(BeTrue())
+		Expect(values).To(Equal([]string{"a"}))
+	})
+
+	It("ignores a corrupt cache file", func() {
+		Expect(os.MkdirAll(filepath.Dir(cache.Path), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(cache.Path, []byte("not json"), 0600)).To(Succeed())
+
+		_, ok := cache.Get("some-key")
+		Expect(ok).To(BeFalse())
+		Expect(cache.Set("some-key", []string{"a"})).To(Succeed())
+	})
+})
@@ -31,6 +31,7 @@ type JSONConfig struct {
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	UserAliases              []UserAlias        `json:"UserAliases,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -27,12 +27,24 @@ import (
 //   2. HOMEDRIVE\HOMEPATH\.cf if HOMEDRIVE or HOMEPATH is set
 //   3. USERPROFILE\.cf as the default
 func LoadConfig(flags ...FlagOverride) (*Config, error) {
-	err := removeOldTempConfigFiles()
+	return loadConfig(configDirectory(), flags...)
+}
+
+// LoadConfigFromHome loads the config the same way as LoadConfig, but from
+// the '.cf' directory in homeDir instead of the one in $CF_HOME or $HOME. It
+// is used to work with a second login, such as one to another foundation.
+// The loaded config must not be written with WriteConfig.
+func LoadConfigFromHome(homeDir string, flags ...FlagOverride) (*Config, error) {
+	return loadConfig(filepath.Join(homeDir, ".cf"), flags...)
+}
+
+func loadConfig(configDir string, flags ...FlagOverride) (*Config, error) {
+	err := removeOldTempConfigFiles(configDir)
 	if err != nil {
 		return nil, err
 	}
 
-	configFilePath := ConfigFilePath()
+	configFilePath := filepath.Join(configDir, "config.json")
 
 	config := Config{
 		ConfigFile: JSONConfig{
@@ -147,8 +159,8 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	return &config, jsonError
 }
 
-func removeOldTempConfigFiles() error {
-	oldTempFileNames, err := filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
+func removeOldTempConfigFiles(configDir string) error {
+	oldTempFileNames, err := filepath.Glob(filepath.Join(configDir, "temp-config?*"))
 	if err != nil {
 		return err
 	}
@@ -267,4 +267,27 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("LoadConfigFromHome", func() {
+		var otherHomeDir string
+
+		BeforeEach(func() {
+			setConfig(homeDir, `{"Target": "https://api.foo.com"}`)
+
+			var err error
+			otherHomeDir, err = ioutil.TempDir("", "cli-config-tests-other-home")
+			Expect(err).ToNot(HaveOccurred())
+			setConfig(otherHomeDir, `{"Target": "https://api.bar.com"}`)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(otherHomeDir)).To(Succeed())
+		})
+
+		It("loads the config from the given home directory", func() {
+			config, err := LoadConfigFromHome(otherHomeDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Target()).To(Equal("https://api.bar.com"))
+		})
+	})
 })
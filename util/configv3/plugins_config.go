@@ -23,6 +23,7 @@ type Plugin struct {
 	Location string          `json:"Location"`
 	Version  PluginVersion   `json:"Version"`
 	Commands []PluginCommand `json:"Commands"`
+	Hooks    []string        `json:"Hooks,omitempty"`
 }
 
 // PluginVersion is the plugin version information
@@ -86,6 +87,17 @@ func (p Plugin) PluginCommands() []PluginCommand {
 	return p.Commands
 }
 
+// SubscribesTo returns true if the plugin declared a hook for the given
+// lifecycle event.
+func (p Plugin) SubscribesTo(event string) bool {
+	for _, hook := range p.Hooks {
+		if hook == event {
+			return true
+		}
+	}
+	return false
+}
+
 // CommandName returns the name of the plugin. The name is concatenated with
 // alias if alias is specified.
 func (c PluginCommand) CommandName() string {
@@ -165,6 +165,23 @@ var _ = Describe("PluginsConfig", func() {
 				}))
 			})
 		})
+
+		Describe("SubscribesTo", func() {
+			var plugin Plugin
+
+			BeforeEach(func() {
+				plugin = Plugin{Hooks: []string{"pre-push", "post-login"}}
+			})
+
+			It("returns true for events the plugin has hooks for", func() {
+				Expect(plugin.SubscribesTo("pre-push")).To(BeTrue())
+				Expect(plugin.SubscribesTo("post-login")).To(BeTrue())
+			})
+
+			It("returns false for events the plugin has no hooks for", func() {
+				Expect(plugin.SubscribesTo("post-push")).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("PluginVersion", func() {
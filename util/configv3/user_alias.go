@@ -0,0 +1,43 @@
+package configv3
+
+import (
+	"sort"
+	"strings"
+)
+
+// UserAlias is a user-defined command that runs one or more CLI command
+// lines, stored in .cf/config.json.
+type UserAlias struct {
+	Name  string   `json:"Name"`
+	Steps []string `json:"Steps"`
+}
+
+// UserAliases returns the user-defined aliases from .cf/config.json sorted by
+// name.
+func (config *Config) UserAliases() []UserAlias {
+	aliases := make([]UserAlias, len(config.ConfigFile.UserAliases))
+	copy(aliases, config.ConfigFile.UserAliases)
+	sort.Slice(aliases, func(i, j int) bool {
+		return strings.ToLower(aliases[i].Name) < strings.ToLower(aliases[j].Name)
+	})
+	return aliases
+}
+
+// SetUserAlias adds the alias, replacing any existing alias with the same
+// name.
+func (config *Config) SetUserAlias(name string, steps []string) {
+	config.RemoveUserAlias(name)
+	config.ConfigFile.UserAliases = append(config.ConfigFile.UserAliases,
+		UserAlias{Name: name, Steps: steps})
+}
+
+// RemoveUserAlias removes the alias with the given name, if it exists.
+func (config *Config) RemoveUserAlias(name string) {
+	aliases := []UserAlias{}
+	for _, alias := range config.ConfigFile.UserAliases {
+		if alias.Name != name {
+			aliases = append(aliases, alias)
+		}
+	}
+	config.ConfigFile.UserAliases = aliases
+}
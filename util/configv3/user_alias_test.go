@@ -0,0 +1,55 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UserAlias", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{
+			ConfigFile: JSONConfig{
+				UserAliases: []UserAlias{
+					{Name: "deploy", Steps: []string{"push -f manifest.yml"}},
+					{Name: "Bounce", Steps: []string{"stop my-app", "start my-app"}},
+				},
+			},
+		}
+	})
+
+	Describe("UserAliases", func() {
+		It("returns the aliases sorted by name", func() {
+			Expect(config.UserAliases()).To(Equal([]UserAlias{
+				{Name: "Bounce", Steps: []string{"stop my-app", "start my-app"}},
+				{Name: "deploy", Steps: []string{"push -f manifest.yml"}},
+			}))
+		})
+	})
+
+	Describe("SetUserAlias", func() {
+		It("adds new aliases", func() {
+			config.SetUserAlias("logs-prod", []string{"logs my-app"})
+			Expect(config.UserAliases()).To(ContainElement(UserAlias{Name: "logs-prod", Steps: []string{"logs my-app"}}))
+			Expect(config.UserAliases()).To(HaveLen(3))
+		})
+
+		It("replaces existing aliases", func() {
+			config.SetUserAlias("deploy", []string{"push -f other.yml"})
+			Expect(config.UserAliases()).To(ContainElement(UserAlias{Name: "deploy", Steps: []string{"push -f other.yml"}}))
+			Expect(config.UserAliases()).To(HaveLen(2))
+		})
+	})
+
+	Describe("RemoveUserAlias", func() {
+		It("removes the alias", func() {
+			config.RemoveUserAlias("deploy")
+			Expect(config.UserAliases()).To(Equal([]UserAlias{
+				{Name: "Bounce", Steps: []string{"stop my-app", "start my-app"}},
+			}))
+		})
+	})
+})
@@ -0,0 +1,320 @@
+// Package jsonschema validates decoded JSON documents against JSON schemas.
+//
+// Only the validation keywords that service brokers commonly publish are
+// supported: type, enum, properties, required, additionalProperties, items,
+// minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength,
+// pattern, minItems, maxItems, allOf, anyOf and oneOf. Any other keyword is
+// ignored, so a document is never rejected because of a keyword that is not
+// understood.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// RootPath is the path of the validated document itself.
+const RootPath = "(root)"
+
+// ValidationError is a violation of a schema at a given path of a document.
+type ValidationError struct {
+	// Path is the dot-separated path of the invalid value, e.g.
+	// "cluster_nodes.count" or "hosts.0".
+	Path string
+
+	// Message describes how the value violates the schema.
+	Message string
+}
+
+// Validate returns all the ways in which document violates schema, sorted by
+// path. document is expected to be decoded with encoding/json into
+// interface{} values.
+func Validate(schema map[string]interface{}, document interface{}) []ValidationError {
+	var errs []ValidationError
+	validate(schema, document, nil, &errs)
+
+	sort.SliceStable(errs, func(i int, j int) bool {
+		return errs[i].Path < errs[j].Path
+	})
+	return errs
+}
+
+func validate(schema map[string]interface{}, value interface{}, path []string, errs *[]ValidationError) {
+	addError := func(format string, args ...interface{}) {
+		*errs = append(*errs, ValidationError{Path: formatPath(path), Message: fmt.Sprintf(format, args...)})
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 && !matchesAnyType(value, types) {
+		addError("Invalid type. Expected: %s, given: %s", strings.Join(types, " or "), typeOf(value))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		var allowed []string
+		for _, option := range enum {
+			allowed = append(allowed, formatValue(option))
+		}
+		addError("Must be one of the following: %s", strings.Join(allowed, ", "))
+	}
+
+	validateCombinators(schema, value, path, errs, addError)
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		validateObject(schema, typedValue, path, errs, addError)
+	case []interface{}:
+		validateArray(schema, typedValue, path, errs, addError)
+	case string:
+		validateString(schema, typedValue, addError)
+	case float64:
+		validateNumber(schema, typedValue, addError)
+	case json.Number:
+		if number, err := typedValue.Float64(); err == nil {
+			validateNumber(schema, number, addError)
+		}
+	}
+}
+
+func validateCombinators(schema map[string]interface{}, value interface{}, path []string, errs *[]ValidationError, addError func(string, ...interface{})) {
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, subschema := range allOf {
+			if subschemaMap, ok := subschema.(map[string]interface{}); ok {
+				validate(subschemaMap, value, path, errs)
+			}
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		if countMatchingSchemas(anyOf, value) == 0 {
+			addError("Must validate against at least one of the allowed schemas")
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		if countMatchingSchemas(oneOf, value) != 1 {
+			addError("Must validate against exactly one of the allowed schemas")
+		}
+	}
+}
+
+func validateObject(schema map[string]interface{}, object map[string]interface{}, path []string, errs *[]ValidationError, addError func(string, ...interface{})) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if nameString, ok := name.(string); ok {
+				if _, exists := object[nameString]; !exists {
+					addError("%s is required", nameString)
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	var names []string
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPath := append(append([]string{}, path...), name)
+
+		if propertySchema, ok := properties[name].(map[string]interface{}); ok {
+			validate(propertySchema, object[name], propertyPath, errs)
+			continue
+		}
+		if _, ok := properties[name]; ok {
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				addError("Additional property %s is not allowed", name)
+			}
+		case map[string]interface{}:
+			validate(additional, object[name], propertyPath, errs)
+		}
+	}
+}
+
+func validateArray(schema map[string]interface{}, array []interface{}, path []string, errs *[]ValidationError, addError func(string, ...interface{})) {
+	if minItems, ok := schemaInt(schema["minItems"]); ok && len(array) < minItems {
+		addError("Array must have at least %d items", minItems)
+	}
+	if maxItems, ok := schemaInt(schema["maxItems"]); ok && len(array) > maxItems {
+		addError("Array must have at most %d items", maxItems)
+	}
+
+	if itemSchema, ok := schema["items"].(map[string]interface{}); ok {
+		for i, item := range array {
+			validate(itemSchema, item, append(append([]string{}, path...), strconv.Itoa(i)), errs)
+		}
+	}
+}
+
+func validateString(schema map[string]interface{}, value string, addError func(string, ...interface{})) {
+	length := utf8.RuneCountInString(value)
+	if minLength, ok := schemaInt(schema["minLength"]); ok && length < minLength {
+		addError("String length must be greater than or equal to %d", minLength)
+	}
+	if maxLength, ok := schemaInt(schema["maxLength"]); ok && length > maxLength {
+		addError("String length must be less than or equal to %d", maxLength)
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		if expression, err := regexp.Compile(pattern); err == nil && !expression.MatchString(value) {
+			addError("Does not match pattern '%s'", pattern)
+		}
+	}
+}
+
+func validateNumber(schema map[string]interface{}, value float64, addError func(string, ...interface{})) {
+	if minimum, ok := schemaNumber(schema["minimum"]); ok {
+		// Draft 4 declares exclusiveMinimum as a boolean modifier of minimum.
+		if exclusive, _ := schema["exclusiveMinimum"].(bool); exclusive {
+			if value <= minimum {
+				addError("Must be greater than %s", formatNumber(minimum))
+			}
+		} else if value < minimum {
+			addError("Must be greater than or equal to %s", formatNumber(minimum))
+		}
+	}
+	if maximum, ok := schemaNumber(schema["maximum"]); ok {
+		if exclusive, _ := schema["exclusiveMaximum"].(bool); exclusive {
+			if value >= maximum {
+				addError("Must be less than %s", formatNumber(maximum))
+			}
+		} else if value > maximum {
+			addError("Must be less than or equal to %s", formatNumber(maximum))
+		}
+	}
+
+	// Later drafts declare exclusiveMinimum and exclusiveMaximum as numbers.
+	if exclusiveMinimum, ok := schemaNumber(schema["exclusiveMinimum"]); ok && value <= exclusiveMinimum {
+		addError("Must be greater than %s", formatNumber(exclusiveMinimum))
+	}
+	if exclusiveMaximum, ok := schemaNumber(schema["exclusiveMaximum"]); ok && value >= exclusiveMaximum {
+		addError("Must be less than %s", formatNumber(exclusiveMaximum))
+	}
+}
+
+func countMatchingSchemas(schemas []interface{}, value interface{}) int {
+	matching := 0
+	for _, subschema := range schemas {
+		if subschemaMap, ok := subschema.(map[string]interface{}); ok {
+			var subErrs []ValidationError
+			validate(subschemaMap, value, nil, &subErrs)
+			if len(subErrs) == 0 {
+				matching++
+			}
+		}
+	}
+	return matching
+}
+
+func schemaTypes(rawType interface{}) []string {
+	switch typedType := rawType.(type) {
+	case string:
+		return []string{typedType}
+	case []interface{}:
+		var types []string
+		for _, t := range typedType {
+			if typeString, ok := t.(string); ok {
+				types = append(types, typeString)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func matchesAnyType(value interface{}, types []string) bool {
+	actualType := typeOf(value)
+	for _, expectedType := range types {
+		if expectedType == actualType {
+			return true
+		}
+		if expectedType == "number" && actualType == "integer" {
+			return true
+		}
+	}
+	return false
+}
+
+func typeOf(value interface{}) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case float64:
+		if typedValue == math.Trunc(typedValue) {
+			return "integer"
+		}
+		return "number"
+	case json.Number:
+		if _, err := typedValue.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(options []interface{}, value interface{}) bool {
+	for _, option := range options {
+		if reflect.DeepEqual(option, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaNumber(raw interface{}) (float64, bool) {
+	switch number := raw.(type) {
+	case float64:
+		return number, true
+	case json.Number:
+		value, err := number.Float64()
+		return value, err == nil
+	}
+	return 0, false
+}
+
+func schemaInt(raw interface{}) (int, bool) {
+	number, ok := schemaNumber(raw)
+	return int(number), ok
+}
+
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+func formatValue(value interface{}) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(bytes)
+}
+
+func formatPath(path []string) string {
+	if len(path) == 0 {
+		return RootPath
+	}
+	return strings.Join(path, ".")
+}
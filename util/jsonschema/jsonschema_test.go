@@ -0,0 +1,92 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/jsonschema"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func decode(rawJSON string) map[string]interface{} {
+	var decoded map[string]interface{}
+	Expect(json.Unmarshal([]byte(rawJSON), &decoded)).To(Succeed())
+	return decoded
+}
+
+var _ = Describe("Validate", func() {
+	var schema map[string]interface{}
+
+	BeforeEach(func() {
+		schema = decode(`{
+			"$schema": "http://json-schema.org/draft-04/schema#",
+			"type": "object",
+			"additionalProperties": false,
+			"required": ["size"],
+			"properties": {
+				"size": {"type": "string", "enum": ["small", "large"]},
+				"nodes": {"type": "integer", "minimum": 1, "maximum": 5},
+				"ratio": {"type": "number", "minimum": 0, "exclusiveMinimum": true},
+				"name": {"type": "string", "minLength": 3, "maxLength": 8, "pattern": "^[a-z]+$"},
+				"hosts": {"type": "array", "minItems": 1, "maxItems": 2, "items": {"type": "string"}},
+				"backup": {
+					"type": "object",
+					"properties": {
+						"enabled": {"type": "boolean"}
+					}
+				},
+				"port": {"anyOf": [{"type": "integer"}, {"type": "string", "pattern": "^[0-9]+$"}]},
+				"mode": {"oneOf": [{"enum": ["a"]}, {"enum": ["b"]}]}
+			}
+		}`)
+	})
+
+	It("accepts a valid document", func() {
+		document := decode(`{"size": "small", "nodes": 3, "ratio": 0.5, "name": "abcd", "hosts": ["h1"], "backup": {"enabled": true}, "port": "8080", "mode": "a"}`)
+		Expect(Validate(schema, document)).To(BeEmpty())
+	})
+
+	It("ignores keywords it does not understand", func() {
+		Expect(Validate(map[string]interface{}{"format": "email", "type": "string"}, "not-an-email")).To(BeEmpty())
+	})
+
+	It("accepts anything for an empty schema", func() {
+		Expect(Validate(map[string]interface{}{}, decode(`{"any": "thing"}`))).To(BeEmpty())
+	})
+
+	DescribeTable("invalid documents",
+		func(rawJSON string, expectedErrors ...ValidationError) {
+			Expect(Validate(schema, decode(rawJSON))).To(Equal(expectedErrors))
+		},
+		Entry("missing required property", `{}`,
+			ValidationError{Path: "(root)", Message: "size is required"}),
+		Entry("additional property", `{"size": "small", "color": "red"}`,
+			ValidationError{Path: "(root)", Message: "Additional property color is not allowed"}),
+		Entry("wrong type", `{"size": 1}`,
+			ValidationError{Path: "size", Message: "Invalid type. Expected: string, given: integer"}),
+		Entry("not in enum", `{"size": "medium"}`,
+			ValidationError{Path: "size", Message: `Must be one of the following: "small", "large"`}),
+		Entry("non-integer", `{"size": "small", "nodes": 1.5}`,
+			ValidationError{Path: "nodes", Message: "Invalid type. Expected: integer, given: number"}),
+		Entry("below minimum", `{"size": "small", "nodes": 0}`,
+			ValidationError{Path: "nodes", Message: "Must be greater than or equal to 1"}),
+		Entry("above maximum", `{"size": "small", "nodes": 6}`,
+			ValidationError{Path: "nodes", Message: "Must be less than or equal to 5"}),
+		Entry("exclusive minimum", `{"size": "small", "ratio": 0}`,
+			ValidationError{Path: "ratio", Message: "Must be greater than 0"}),
+		Entry("string constraints", `{"size": "small", "name": "A"}`,
+			ValidationError{Path: "name", Message: "String length must be greater than or equal to 3"},
+			ValidationError{Path: "name", Message: "Does not match pattern '^[a-z]+$'"}),
+		Entry("array constraints", `{"size": "small", "hosts": ["a", 1, "c"]}`,
+			ValidationError{Path: "hosts", Message: "Array must have at most 2 items"},
+			ValidationError{Path: "hosts.1", Message: "Invalid type. Expected: string, given: integer"}),
+		Entry("nested property", `{"size": "small", "backup": {"enabled": "yes"}}`,
+			ValidationError{Path: "backup.enabled", Message: "Invalid type. Expected: boolean, given: string"}),
+		Entry("anyOf", `{"size": "small", "port": "http"}`,
+			ValidationError{Path: "port", Message: "Must validate against at least one of the allowed schemas"}),
+		Entry("oneOf", `{"size": "small", "mode": "c"}`,
+			ValidationError{Path: "mode", Message: "Must validate against exactly one of the allowed schemas"}),
+	)
+})
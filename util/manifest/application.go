@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 )
 
 type Application struct {
@@ -31,11 +32,13 @@ type Application struct {
 	NoHostname      bool
 	NoRoute         bool
 	Path            string
-	RandomRoute     bool
-	Routes          []string
-	RoutePath       string
-	Services        []string
-	StackName       string
+	// Processes are only read by v3-push.
+	Processes   []manifestparser.Process
+	RandomRoute bool
+	Routes      []string
+	RoutePath   string
+	Services    []string
+	StackName   string
 
 	DeprecatedDomain     interface{}
 	DeprecatedDomains    interface{}
@@ -85,6 +88,7 @@ func (app Application) MarshalYAML() (interface{}, error) {
 		Name:                    app.Name,
 		NoRoute:                 app.NoRoute,
 		Path:                    app.Path,
+		Processes:               app.Processes,
 		Services:                app.Services,
 		StackName:               app.StackName,
 		Timeout:                 app.HealthCheckTimeout,
@@ -123,6 +127,7 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 	app.Name = m.Name
 	app.NoRoute = m.NoRoute
 	app.Path = m.Path
+	app.Processes = m.Processes
 	app.RandomRoute = m.RandomRoute
 	app.Services = m.Services
 	app.StackName = m.StackName
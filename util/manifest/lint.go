@@ -0,0 +1,563 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// validHealthCheckTypes are the health check types an application can have.
+var validHealthCheckTypes = []string{"http", "none", "port", "process"}
+
+var (
+	yamlErrorLineRegex = regexp.MustCompile(`line (\d+): `)
+)
+
+// Problem is a mistake found in a manifest. Line and Column are 1-based and
+// are 0 when the position of the mistake is not known.
+type Problem struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+// LintedApplication is an application of a linted manifest that could be
+// parsed. Index is its position in the applications of the manifest.
+type LintedApplication struct {
+	Application
+	Index int
+}
+
+// LintResult is the outcome of linting a manifest.
+type LintResult struct {
+	Applications []LintedApplication
+	Problems     []Problem
+
+	positions positionIndex
+}
+
+// AddProblem adds a problem with the field of the application at the provided
+// index. The problem is positioned at the field, or at the application when
+// the field cannot be found.
+func (result *LintResult) AddProblem(appIndex int, field string, message string) {
+	result.Problems = append(result.Problems, result.positions.applicationField(appIndex, field).problem(message))
+}
+
+// SortProblems sorts the problems by their position in the manifest.
+func (result *LintResult) SortProblems() {
+	sort.SliceStable(result.Problems, func(i, j int) bool {
+		if result.Problems[i].Line != result.Problems[j].Line {
+			return result.Problems[i].Line < result.Problems[j].Line
+		}
+		return result.Problems[i].Column < result.Problems[j].Column
+	})
+}
+
+// LintManifest reads the manifest at the provided path, interpolates it like
+// ReadAndInterpolateManifest and reports all of its problems instead of
+// stopping at the first one. An error is only returned when the manifest or
+// the vars files cannot be read.
+func LintManifest(pathToManifest string, sources VariableSources) (LintResult, error) {
+	rawManifest, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return LintResult{}, err
+	}
+
+	result := LintResult{positions: indexPositions(rawManifest)}
+
+	var document interface{}
+	err = yaml.Unmarshal(rawManifest, &document)
+	if err != nil {
+		result.Problems = append(result.Problems, yamlErrorProblems(err)...)
+		return result, nil
+	}
+
+	// Every variable is expected to be set, even without variable sources,
+	// because the values of variables that are not interpolated cannot be
+	// checked.
+	interpolated, err := interpolate(rawManifest, sources)
+	if names := missingVariables(err); len(names) > 0 {
+		for _, name := range names {
+			result.Problems = append(result.Problems, variablePosition(rawManifest, name).problem(fmt.Sprintf("variable ((%s)) is not set", name)))
+		}
+		result.SortProblems()
+		return result, nil
+	}
+	if err != nil {
+		return LintResult{}, err
+	}
+
+	document = nil
+	err = yaml.Unmarshal(interpolated, &document)
+	if err != nil {
+		result.Problems = append(result.Problems, yamlErrorProblems(err)...)
+		return result, nil
+	}
+
+	result.lintDocument(document, filepath.Dir(pathToManifest))
+	result.SortProblems()
+	return result, nil
+}
+
+func (result *LintResult) lintDocument(document interface{}, manifestDir string) {
+	fields, ok := document.(map[interface{}]interface{})
+	if !ok {
+		result.Problems = append(result.Problems, Problem{Message: "manifest must be a map with an applications field"})
+		return
+	}
+
+	globalFields := yamlFieldNames(reflect.TypeOf(rawManifest{}))
+	for _, field := range sortedFieldNames(fields) {
+		switch {
+		case field == "applications":
+		case field == "inherit":
+			result.addTopLevelProblem(field, InheritanceFieldError{}.Error())
+		case globalFields[field]:
+			result.addTopLevelProblem(field, GlobalFieldsError{Fields: []string{field}}.Error())
+		}
+	}
+
+	apps, ok := fields["applications"].([]interface{})
+	if !ok && fields["applications"] != nil {
+		result.addTopLevelProblem("applications", "applications must be a list")
+		return
+	}
+	if len(apps) == 0 {
+		result.addTopLevelProblem("applications", "manifest has no applications")
+		return
+	}
+
+	appIndexesByName := map[string]int{}
+	for i, rawApp := range apps {
+		app, ok := result.lintApplication(i, rawApp)
+		if !ok {
+			continue
+		}
+
+		if app.Name != "" {
+			if first, exists := appIndexesByName[app.Name]; exists {
+				result.AddProblem(i, "name", fmt.Sprintf("application name '%s' is also used by application %d", app.Name, first+1))
+			} else {
+				appIndexesByName[app.Name] = i
+			}
+		}
+
+		if app.Path != "" && !filepath.IsAbs(app.Path) {
+			app.Path = filepath.Join(manifestDir, app.Path)
+		}
+		result.Applications = append(result.Applications, LintedApplication{Application: app, Index: i})
+	}
+}
+
+// lintApplication checks the fields of the application at the provided index
+// one at a time, so that every invalid field is reported, and returns the
+// application parsed from the valid fields.
+func (result *LintResult) lintApplication(index int, rawApp interface{}) (Application, bool) {
+	fields, ok := rawApp.(map[interface{}]interface{})
+	if !ok {
+		result.AddProblem(index, "", "application must be a map of fields")
+		return Application{}, false
+	}
+
+	knownFields := yamlFieldNames(reflect.TypeOf(rawManifestApplication{}))
+	validFields := map[interface{}]interface{}{}
+	var processes []manifestparser.Process
+	for _, field := range sortedFieldNames(fields) {
+		value := fields[field]
+		if field == "processes" {
+			processes = result.lintProcesses(index, value)
+			continue
+		}
+		if !knownFields[field] {
+			result.AddProblem(index, field, fmt.Sprintf("unsupported field '%s'", field))
+			continue
+		}
+		if message := fieldProblem(field, value); message != "" {
+			result.AddProblem(index, field, message)
+			continue
+		}
+		validFields[field] = value
+	}
+
+	var app Application
+	raw, err := yaml.Marshal(validFields)
+	if err == nil {
+		err = yaml.Unmarshal(raw, &app)
+	}
+	if err != nil {
+		result.AddProblem(index, "", err.Error())
+		return Application{}, false
+	}
+	app.Processes = processes
+
+	if app.Instances.IsSet && app.Instances.Value < 0 {
+		result.AddProblem(index, "instances", "instances cannot be negative")
+	}
+	if app.HealthCheckTimeout < 0 {
+		result.AddProblem(index, "timeout", "timeout cannot be negative")
+	}
+	if app.HealthCheckType != "" && !isValidHealthCheckType(app.HealthCheckType) {
+		result.AddProblem(index, "health-check-type", fmt.Sprintf("health-check-type must be one of %s, got '%s'", strings.Join(validHealthCheckTypes, ", "), app.HealthCheckType))
+	}
+
+	return app, true
+}
+
+// lintProcesses parses the processes of the application at the provided
+// index the way v3-push does.
+func (result *LintResult) lintProcesses(index int, value interface{}) []manifestparser.Process {
+	raw, err := yaml.Marshal(value)
+	if err != nil {
+		result.AddProblem(index, "processes", fmt.Sprintf("processes: %s", err))
+		return nil
+	}
+
+	var processes []manifestparser.Process
+	err = yaml.Unmarshal(raw, &processes)
+	if err != nil {
+		result.AddProblem(index, "processes", fmt.Sprintf("processes: %s", trimYAMLErrorPrefix(err)))
+		return nil
+	}
+	return processes
+}
+
+func (result *LintResult) addTopLevelProblem(field string, message string) {
+	result.Problems = append(result.Problems, result.positions.topLevel[field].problem(message))
+}
+
+// fieldProblem returns why the value of the application field cannot be
+// parsed, or an empty string if it can.
+func fieldProblem(field string, value interface{}) string {
+	raw, err := yaml.Marshal(map[string]interface{}{field: value})
+	if err != nil {
+		return fmt.Sprintf("%s: %s", field, err)
+	}
+
+	var app rawManifestApplication
+	err = yaml.Unmarshal(raw, &app)
+	if err != nil {
+		return fmt.Sprintf("%s: %s", field, trimYAMLErrorPrefix(err))
+	}
+
+	var parsed Application
+	switch field {
+	case "disk_quota":
+		err = parsed.DiskQuota.ParseStringValue(app.DiskQuota)
+	case "memory":
+		err = parsed.Memory.ParseStringValue(app.Memory)
+	}
+	if err != nil {
+		return fmt.Sprintf("%s: %s", field, err)
+	}
+
+	return ""
+}
+
+func isValidHealthCheckType(healthCheckType string) bool {
+	for _, valid := range validHealthCheckTypes {
+		if healthCheckType == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// yamlFieldNames returns the YAML names of the fields of the provided struct
+// type.
+func yamlFieldNames(structType reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < structType.NumField(); i++ {
+		name := strings.Split(structType.Field(i).Tag.Get("yaml"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func sortedFieldNames(fields map[interface{}]interface{}) []string {
+	var names []string
+	for field := range fields {
+		names = append(names, fmt.Sprint(field))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// yamlErrorProblems converts a YAML parsing error into problems, using the
+// line numbers found in its messages.
+func yamlErrorProblems(err error) []Problem {
+	messages := []string{err.Error()}
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		messages = typeErr.Errors
+	}
+
+	var problems []Problem
+	for _, message := range messages {
+		problem := Problem{Message: strings.TrimPrefix(message, "yaml: ")}
+		if match := yamlErrorLineRegex.FindStringSubmatchIndex(problem.Message); match != nil {
+			problem.Line, _ = strconv.Atoi(problem.Message[match[2]:match[3]])
+			problem.Message = problem.Message[:match[0]] + problem.Message[match[1]:]
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+func trimYAMLErrorPrefix(err error) string {
+	problems := yamlErrorProblems(err)
+	var messages []string
+	for _, problem := range problems {
+		messages = append(messages, strings.TrimSpace(problem.Message))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// missingVariables returns the names of the variables listed in the missing
+// variables error of the template engine, or nil if the error is not one.
+func missingVariables(err error) []string {
+	multiErr, ok := err.(bosherr.MultiError)
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, err := range multiErr.Errors {
+		complexErr, ok := err.(bosherr.ComplexError)
+		if !ok || complexErr.Err.Error() != "Expected to find variables" {
+			continue
+		}
+		if missing, ok := complexErr.Cause.(bosherr.MultiError); ok {
+			for _, name := range missing.Errors {
+				names = append(names, name.Error())
+			}
+		}
+	}
+	return names
+}
+
+// variablePosition returns the position of the first use of the variable in
+// the manifest, ignoring comments.
+func variablePosition(rawManifest []byte, name string) position {
+	for i, line := range strings.Split(string(rawManifest), "\n") {
+		line = stripYAMLComment(line)
+		for _, placeholder := range []string{"((" + name + "))", "((!" + name + "))"} {
+			if column := strings.Index(line, placeholder); column >= 0 {
+				return position{line: i + 1, column: column + 1}
+			}
+		}
+	}
+	return position{}
+}
+
+// position is a 1-based line and column in a manifest, or the zero value
+// when unknown.
+type position struct {
+	line   int
+	column int
+}
+
+func (pos position) problem(message string) Problem {
+	return Problem{Line: pos.line, Column: pos.column, Message: message}
+}
+
+type applicationPositions struct {
+	position
+	fields map[string]position
+}
+
+// positionIndex holds the positions of the top level fields of a manifest
+// and of the fields of its applications.
+type positionIndex struct {
+	topLevel     map[string]position
+	applications []applicationPositions
+}
+
+func (index positionIndex) applicationField(appIndex int, field string) position {
+	if appIndex >= len(index.applications) {
+		return index.topLevel["applications"]
+	}
+
+	app := index.applications[appIndex]
+	if pos, ok := app.fields[field]; ok {
+		return pos
+	}
+	return app.position
+}
+
+// indexPositions finds the positions of the top level fields of the manifest
+// and of the fields of the items of its applications list. yaml.v2 does not
+// report the positions of the values it decodes, so the manifest is scanned
+// line by line. Block style mappings and flow style mappings on a single line
+// are understood; the positions of fields written in any other way are
+// unknown.
+func indexPositions(rawManifest []byte) positionIndex {
+	index := positionIndex{topLevel: map[string]position{}}
+
+	var (
+		inApplications bool
+		itemIndent     = -1
+		fieldIndent    = -1
+		app            *applicationPositions
+	)
+	addApplication := func() {
+		if app != nil {
+			index.applications = append(index.applications, *app)
+			app = nil
+		}
+	}
+
+	for i, line := range strings.Split(string(rawManifest), "\n") {
+		line = stripYAMLComment(line)
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "---" || trimmed == "..." {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		lineNumber := i + 1
+
+		if indent == 0 && !isSequenceItem(trimmed) {
+			addApplication()
+			key, ok := yamlMappingKey(trimmed)
+			if ok {
+				index.topLevel[key] = position{line: lineNumber, column: 1}
+			}
+			inApplications = ok && key == "applications"
+			itemIndent, fieldIndent = -1, -1
+			continue
+		}
+		if !inApplications {
+			continue
+		}
+
+		if isSequenceItem(trimmed) && (itemIndent == -1 || indent == itemIndent) {
+			addApplication()
+			itemIndent = indent
+			app = &applicationPositions{fields: map[string]position{}}
+
+			rest := line[indent+1:]
+			restIndent := indent + 1 + len(rest) - len(strings.TrimLeft(rest, " "))
+			rest = strings.TrimSpace(rest)
+			switch {
+			case rest == "":
+				fieldIndent = -1
+			case strings.HasPrefix(rest, "{"):
+				app.position = position{line: lineNumber, column: restIndent + 1}
+				for key, column := range flowMappingKeys(rest) {
+					app.fields[key] = position{line: lineNumber, column: restIndent + 1 + column}
+				}
+				fieldIndent = -1
+			default:
+				app.position = position{line: lineNumber, column: restIndent + 1}
+				fieldIndent = restIndent
+				if key, ok := yamlMappingKey(rest); ok {
+					app.fields[key] = app.position
+				}
+			}
+			continue
+		}
+
+		if app == nil {
+			continue
+		}
+		if fieldIndent == -1 && app.position == (position{}) {
+			fieldIndent = indent
+			app.position = position{line: lineNumber, column: indent + 1}
+		}
+		if indent == fieldIndent {
+			if key, ok := yamlMappingKey(trimmed); ok {
+				app.fields[key] = position{line: lineNumber, column: indent + 1}
+			}
+		}
+	}
+	addApplication()
+
+	return index
+}
+
+func isSequenceItem(trimmed string) bool {
+	return trimmed == "-" || strings.HasPrefix(trimmed, "- ")
+}
+
+// yamlMappingKey returns the key of a line that starts a mapping entry.
+func yamlMappingKey(trimmed string) (string, bool) {
+	separator := strings.Index(trimmed, ": ")
+	if separator == -1 {
+		if !strings.HasSuffix(trimmed, ":") {
+			return "", false
+		}
+		separator = len(trimmed) - 1
+	}
+
+	key := strings.TrimSpace(trimmed[:separator])
+	if unquoted, err := strconv.Unquote(key); err == nil {
+		key = unquoted
+	} else if len(key) > 1 && key[0] == '\'' && key[len(key)-1] == '\'' {
+		key = key[1 : len(key)-1]
+	}
+	return key, key != ""
+}
+
+// flowMappingKeys returns the 0-based columns of the keys of a flow style
+// mapping, relative to its opening brace.
+func flowMappingKeys(mapping string) map[string]int {
+	keys := map[string]int{}
+	depth := 0
+	var quote rune
+	keyStart := -1
+
+	for i, char := range mapping {
+		switch {
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '"' || char == '\'':
+			quote = char
+		case char == '{' || char == '[':
+			depth++
+			if depth == 1 {
+				keyStart = i + 1
+			}
+		case char == '}' || char == ']':
+			depth--
+		case char == ',' && depth == 1:
+			keyStart = i + 1
+		case char == ':' && depth == 1 && keyStart != -1:
+			rawKey := mapping[keyStart:i]
+			column := keyStart + len(rawKey) - len(strings.TrimLeft(rawKey, " "))
+			if key, ok := yamlMappingKey(strings.TrimSpace(rawKey) + ":"); ok {
+				keys[key] = column
+			}
+			keyStart = -1
+		}
+	}
+	return keys
+}
+
+// stripYAMLComment removes the comment at the end of a line, if any.
+func stripYAMLComment(line string) string {
+	var quote rune
+	for i, char := range line {
+		switch {
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '"' || char == '\'':
+			quote = char
+		case char == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,241 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LintManifest", func() {
+	var (
+		manifest       string
+		pathToManifest string
+		sources        VariableSources
+
+		result     LintResult
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		sources = VariableSources{}
+
+		tempFile, err := ioutil.TempFile("", "manifest-test-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFile.Close()).ToNot(HaveOccurred())
+		pathToManifest = tempFile.Name()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToManifest)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+		Expect(err).ToNot(HaveOccurred())
+
+		result, executeErr = LintManifest(pathToManifest, sources)
+	})
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications:
+- name: app-1
+  memory: 1G
+  disk_quota: 512M
+  health-check-type: http
+  path: some-path
+- name: app-2
+  docker:
+    image: some-image
+`
+		})
+
+		It("returns the applications without problems", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(result.Problems).To(BeEmpty())
+			Expect(result.Applications).To(HaveLen(2))
+
+			Expect(result.Applications[0].Index).To(Equal(0))
+			Expect(result.Applications[0].Name).To(Equal("app-1"))
+			Expect(result.Applications[0].Memory.Value).To(BeEquivalentTo(1024))
+			Expect(result.Applications[0].Path).To(Equal(filepath.Join(filepath.Dir(pathToManifest), "some-path")))
+			Expect(result.Applications[1].Index).To(Equal(1))
+			Expect(result.Applications[1].DockerImage).To(Equal("some-image"))
+		})
+	})
+
+	Context("when the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications:
+- name: app-1
+  memory: 1G
+ disk_quota: 1G
+`
+		})
+
+		It("returns the syntax error at its line", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(result.Problems).To(HaveLen(1))
+			Expect(result.Problems[0].Line).To(Equal(4))
+			Expect(result.Problems[0].Message).ToNot(ContainSubstring("line 4"))
+		})
+	})
+
+	Context("when the manifest has several problems", func() {
+		BeforeEach(func() {
+			manifest = `---
+inherit: base.yml
+memory: 1G
+applications:
+- name: app-1
+  memory: 1 gigabyte
+  disk_quota: 1G
+  health-check-type: tcp
+  instances: -1
+  unknown-field: foo
+- name: app-1
+  timeout: not-a-number
+`
+		})
+
+		It("returns every problem in the order of the manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(result.Problems).To(HaveLen(8))
+
+			Expect(result.Problems[0]).To(Equal(Problem{Line: 2, Column: 1, Message: "unsupported inheritance"}))
+			Expect(result.Problems[1]).To(Equal(Problem{Line: 3, Column: 1, Message: "unsupported global fields: memory"}))
+			Expect(result.Problems[2].Line).To(Equal(6))
+			Expect(result.Problems[2].Column).To(Equal(3))
+			Expect(result.Problems[2].Message).To(HavePrefix("memory: "))
+			Expect(result.Problems[3]).To(Equal(Problem{Line: 8, Column: 3, Message: "health-check-type must be one of http, none, port, process, got 'tcp'"}))
+			Expect(result.Problems[4]).To(Equal(Problem{Line: 9, Column: 3, Message: "instances cannot be negative"}))
+			Expect(result.Problems[5]).To(Equal(Problem{Line: 10, Column: 3, Message: "unsupported field 'unknown-field'"}))
+			Expect(result.Problems[6]).To(Equal(Problem{Line: 11, Column: 3, Message: "application name 'app-1' is also used by application 1"}))
+			Expect(result.Problems[7].Line).To(Equal(12))
+			Expect(result.Problems[7].Message).To(HavePrefix("timeout: cannot unmarshal"))
+		})
+
+		It("returns the applications parsed from their valid fields", func() {
+			Expect(result.Applications).To(HaveLen(2))
+			Expect(result.Applications[1].HealthCheckTimeout).To(BeZero())
+		})
+	})
+
+	Context("when a field has the wrong type", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications:
+- name: app-1
+  timeout: not-a-number
+`
+		})
+
+		It("returns the problem at the field", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(result.Problems).To(HaveLen(1))
+			Expect(result.Problems[0].Line).To(Equal(4))
+			Expect(result.Problems[0].Column).To(Equal(3))
+			Expect(result.Problems[0].Message).To(HavePrefix("timeout: cannot unmarshal"))
+		})
+	})
+
+	Context("when the manifest has no applications", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications: []
+`
+		})
+
+		It("returns a problem", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(result.Problems).To(ConsistOf(Problem{Line: 2, Column: 1, Message: "manifest has no applications"}))
+		})
+	})
+
+	Context("when the manifest has variables", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications:
+- name: ((name))
+  memory: ((memory))
+`
+		})
+
+		Context("when the variables are not provided", func() {
+			It("returns a problem for each variable", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result.Problems).To(Equal([]Problem{
+					{Line: 3, Column: 9, Message: "variable ((name)) is not set"},
+					{Line: 4, Column: 11, Message: "variable ((memory)) is not set"},
+				}))
+			})
+		})
+
+		Context("when the variables are provided", func() {
+			BeforeEach(func() {
+				sources.Vars = []template.VarKV{
+					{Name: "name", Value: "some-app"},
+					{Name: "memory", Value: "2 tons"},
+				}
+			})
+
+			It("lints the interpolated manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result.Problems).To(HaveLen(1))
+				Expect(result.Problems[0].Line).To(Equal(4))
+				Expect(result.Problems[0].Message).To(HavePrefix("memory: "))
+				Expect(result.Applications[0].Name).To(Equal("some-app"))
+			})
+		})
+	})
+
+	Context("when the manifest cannot be read", func() {
+		JustBeforeEach(func() {
+			result, executeErr = LintManifest(filepath.Join(pathToManifest, "does-not-exist"), sources)
+		})
+
+		BeforeEach(func() {
+			manifest = "---\n"
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+
+	Describe("AddProblem", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications:
+- name: app-1
+  path: some-path
+-
+  name: app-2
+  memory: 1G
+- {name: app-3, path: other-path}
+`
+		})
+
+		It("positions the problem at the field, or at the application", func() {
+			result.AddProblem(0, "path", "some-problem")
+			result.AddProblem(1, "memory", "some-other-problem")
+			result.AddProblem(1, "routes", "yet-another-problem")
+			result.AddProblem(2, "path", "a-flow-style-problem")
+
+			Expect(result.Problems).To(Equal([]Problem{
+				{Line: 4, Column: 3, Message: "some-problem"},
+				{Line: 7, Column: 3, Message: "some-other-problem"},
+				{Line: 6, Column: 3, Message: "yet-another-problem"},
+				{Line: 8, Column: 17, Message: "a-flow-style-problem"},
+			}))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package manifest
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -31,34 +32,37 @@ func (manifest *Manifest) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return nil
 }
 
+// VariableSources are the sources of the values of the ((variables)) in a
+// manifest.
+type VariableSources struct {
+	// Environment are variables read from the environment. They have the
+	// lowest precedence. A non-nil Environment enables interpolation even if
+	// it is empty.
+	Environment template.StaticVariables
+
+	// PathsToVarsFiles are YAML files of variables. Later files override
+	// earlier ones.
+	PathsToVarsFiles []string
+
+	// Vars are single variables, which override all other sources.
+	Vars []template.VarKV
+}
+
+func (sources VariableSources) empty() bool {
+	return sources.Environment == nil && len(sources.PathsToVarsFiles) == 0 && len(sources.Vars) == 0
+}
+
 // ReadAndInterpolateManifest reads the manifest at the provided paths,
-// interpolates variables if a vars file is provided, and retunrs a fully
-// merged set of applications.
-func ReadAndInterpolateManifest(pathToManifest string, pathToVarsFile string) ([]Application, error) {
+// interpolates variables if any variable source is provided, and returns a
+// fully merged set of applications.
+func ReadAndInterpolateManifest(pathToManifest string, sources VariableSources) ([]Application, error) {
 	rawManifest, err := ioutil.ReadFile(pathToManifest)
 	if err != nil {
 		return nil, err
 	}
 
-	tpl := template.NewTemplate(rawManifest)
-
-	if pathToVarsFile != "" {
-		var (
-			rawVarsFile []byte
-			staticVars  template.StaticVariables
-		)
-
-		rawVarsFile, err = ioutil.ReadFile(pathToVarsFile)
-		if err != nil {
-			return nil, err
-		}
-
-		err = yaml.Unmarshal(rawVarsFile, &staticVars)
-		if err != nil {
-			return nil, InvalidYAMLError{Err: err}
-		}
-
-		rawManifest, err = tpl.Evaluate(staticVars, nil, template.EvaluateOpts{})
+	if !sources.empty() {
+		rawManifest, err = interpolate(rawManifest, sources)
 		if err != nil {
 			return nil, err
 		}
@@ -80,10 +84,51 @@ func ReadAndInterpolateManifest(pathToManifest string, pathToVarsFile string) ([
 	return manifest.Applications, err
 }
 
+// interpolate replaces the ((variables)) of the manifest with the values found
+// in the sources. It returns an error listing the variables that have no
+// value.
+func interpolate(rawManifest []byte, sources VariableSources) ([]byte, error) {
+	vars := template.StaticVariables{}
+	for name, value := range sources.Environment {
+		vars[name] = value
+	}
+
+	for _, pathToVarsFile := range sources.PathsToVarsFiles {
+		rawVarsFile, err := ioutil.ReadFile(pathToVarsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var fileVars template.StaticVariables
+		err = yaml.Unmarshal(rawVarsFile, &fileVars)
+		if err != nil {
+			return nil, InvalidYAMLError{Err: err}
+		}
+
+		for name, value := range fileVars {
+			vars[name] = value
+		}
+	}
+
+	for _, kv := range sources.Vars {
+		vars[kv.Name] = kv.Value
+	}
+
+	tpl := template.NewTemplate(rawManifest)
+	return tpl.Evaluate(vars, nil, template.EvaluateOpts{ExpectAllKeys: true})
+}
+
 // WriteApplicationManifest writes the provided application to the given
 // filepath. If the filepath does not exist, it will create it.
 func WriteApplicationManifest(application Application, filePath string) error {
-	manifest := Manifest{Applications: []Application{application}}
+	return WriteApplicationsManifest([]Application{application}, filePath)
+}
+
+// WriteApplicationsManifest writes a manifest containing all of the provided
+// applications to the given filepath. If the filepath does not exist, it will
+// create it.
+func WriteApplicationsManifest(applications []Application, filePath string) error {
+	manifest := Manifest{Applications: applications}
 	manifestBytes, err := yaml.Marshal(manifest)
 	if err != nil {
 		return ManifestCreationError{Err: err}
@@ -96,3 +141,33 @@ func WriteApplicationManifest(application Application, filePath string) error {
 
 	return nil
 }
+
+// WriteVarsFile writes the provided variables to the given filepath in the
+// format read by --vars-file. Since the values are usually secrets, the file
+// is only readable by its owner, even when it already existed.
+func WriteVarsFile(vars map[string]string, filePath string) error {
+	varsBytes, err := yaml.Marshal(vars)
+	if err != nil {
+		return ManifestCreationError{Err: err}
+	}
+
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return ManifestCreationError{Err: err}
+	}
+	defer file.Close()
+
+	// The mode passed to OpenFile only applies to new files; an existing file
+	// is restricted before the secrets are written to it.
+	err = file.Chmod(0600)
+	if err != nil {
+		return ManifestCreationError{Err: err}
+	}
+
+	_, err = file.Write(varsBytes)
+	if err != nil {
+		return ManifestCreationError{Err: err}
+	}
+
+	return nil
+}
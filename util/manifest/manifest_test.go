@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/cli/types"
 	. "code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -23,12 +24,14 @@ var _ = Describe("Manifest", func() {
 		var (
 			pathToManifest string
 			pathToVarsFile string
+			sources        VariableSources
 			apps           []Application
 			executeErr     error
 		)
 
 		BeforeEach(func() {
 			pathToVarsFile = ""
+			sources = VariableSources{}
 			manifest = `---
 applications:
 - name: app-1
@@ -101,7 +104,10 @@ applications:
 		})
 
 		JustBeforeEach(func() {
-			apps, executeErr = ReadAndInterpolateManifest(pathToManifest, pathToVarsFile)
+			if pathToVarsFile != "" {
+				sources.PathsToVarsFiles = append([]string{pathToVarsFile}, sources.PathsToVarsFiles...)
+			}
+			apps, executeErr = ReadAndInterpolateManifest(pathToManifest, sources)
 		})
 
 		Context("when the manifest does not contain deprecated fields", func() {
@@ -222,7 +228,7 @@ applications:
 						err = ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
 						Expect(err).ToNot(HaveOccurred())
 
-						_, err = ReadAndInterpolateManifest(pathToManifest, pathToVarsFile)
+						_, err = ReadAndInterpolateManifest(pathToManifest, sources)
 						Expect(err).To(MatchError(GlobalFieldsError{Fields: []string{manifestProperty}}))
 					},
 
@@ -327,6 +333,124 @@ applications:
 			})
 		})
 
+		Context("when several variable sources are provided", func() {
+			var otherPathToVarsFile string
+
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: ((name))
+  instances: ((instances))
+  memory: ((memory))
+  env:
+    FROM_ENV: ((from_env))
+    FROM_FILE: ((from_file))
+`
+				err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				varFile, err := ioutil.TempFile("", "vars-test-")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(varFile.Close()).ToNot(HaveOccurred())
+				pathToVarsFile = varFile.Name()
+				err = ioutil.WriteFile(pathToVarsFile, []byte("name: common-name\ninstances: 1\nmemory: 64M\nfrom_file: common\n"), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				otherVarFile, err := ioutil.TempFile("", "vars-test-")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(otherVarFile.Close()).ToNot(HaveOccurred())
+				otherPathToVarsFile = otherVarFile.Name()
+				err = ioutil.WriteFile(otherPathToVarsFile, []byte("instances: 3\nmemory: 128M\nfrom_env: file\n"), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				sources = VariableSources{
+					Environment:      map[string]interface{}{"from_env": "env", "name": "env-name"},
+					PathsToVarsFiles: []string{otherPathToVarsFile},
+					Vars:             []template.VarKV{{Name: "memory", Value: "256M"}},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(otherPathToVarsFile)).ToNot(HaveOccurred())
+			})
+
+			It("lets later vars files override earlier ones and vars override all files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].Name).To(Equal("common-name"))
+				Expect(apps[0].Instances).To(Equal(types.NullInt{Value: 3, IsSet: true}))
+				Expect(apps[0].Memory).To(Equal(types.NullByteSizeInMb{Value: 256, IsSet: true}))
+				Expect(apps[0].EnvironmentVariables).To(Equal(map[string]string{
+					"FROM_ENV":  "file",
+					"FROM_FILE": "common",
+				}))
+			})
+		})
+
+		Context("when variables cannot be resolved", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: ((name))
+  env:
+    FIRST: ((missing_b))
+    SECOND: prefix-((missing_a))
+    THIRD: ((!missing_b))
+`
+				err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				sources = VariableSources{Vars: []template.VarKV{{Name: "name", Value: "app-1"}}}
+			})
+
+			It("returns an error listing every unresolved variable", func() {
+				Expect(executeErr).To(MatchError(ContainSubstring("Expected to find variables")))
+				Expect(executeErr.Error()).To(ContainSubstring("missing_a"))
+				Expect(executeErr.Error()).To(ContainSubstring("missing_b"))
+				Expect(executeErr.Error()).ToNot(ContainSubstring("name"))
+			})
+		})
+
+		Context("when the environment source is enabled but empty", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: ((name))
+`
+				err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				sources = VariableSources{Environment: map[string]interface{}{}}
+			})
+
+			It("still reports the unresolved variables", func() {
+				Expect(executeErr).To(MatchError(ContainSubstring("Expected to find variables")))
+				Expect(executeErr.Error()).To(ContainSubstring("name"))
+			})
+		})
+
+		Context("when a variable value looks like a variable", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: ((name))
+  env:
+    PASSWORD: ((password))
+`
+				err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				sources = VariableSources{Vars: []template.VarKV{
+					{Name: "name", Value: "app-1"},
+					{Name: "password", Value: "((not-a-variable))"},
+				}}
+			})
+
+			It("keeps the value as is", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].EnvironmentVariables).To(HaveKeyWithValue("PASSWORD", "((not-a-variable))"))
+			})
+		})
+
 		Context("when no vars file is provided", func() {
 			BeforeEach(func() {
 				manifest = `---
@@ -514,4 +638,64 @@ applications:
 			})
 		})
 	})
+
+	Describe("WriteApplicationsManifest", func() {
+		var (
+			tmpDir   string
+			filePath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "manifest-test-")
+			Expect(err).NotTo(HaveOccurred())
+			filePath = filepath.Join(tmpDir, "manifest.yml")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("writes all of the applications to the specified filepath", func() {
+			err := WriteApplicationsManifest([]Application{{Name: "app-1"}, {Name: "app-2", NoRoute: true}}, filePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifestBytes, err := ioutil.ReadFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+- name: app-2
+  no-route: true
+`))
+		})
+	})
+
+	Describe("WriteVarsFile", func() {
+		var (
+			tmpDir   string
+			filePath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "manifest-test-")
+			Expect(err).NotTo(HaveOccurred())
+			filePath = filepath.Join(tmpDir, "vars.yml")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("writes the variables in the vars file format", func() {
+			err := WriteVarsFile(map[string]string{"b-var": "some-value", "a-var": "182837403930483038"}, filePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			varsBytes, err := ioutil.ReadFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(varsBytes)).To(Equal(`a-var: "182837403930483038"
+b-var: some-value
+`))
+		})
+	})
 })
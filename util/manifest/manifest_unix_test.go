@@ -40,7 +40,7 @@ var _ = Describe("Manifest with paths", func() {
 		)
 
 		JustBeforeEach(func() {
-			apps, executeErr = ReadAndInterpolateManifest(pathToManifest, "")
+			apps, executeErr = ReadAndInterpolateManifest(pathToManifest, VariableSources{})
 		})
 
 		BeforeEach(func() {
@@ -68,3 +68,48 @@ applications:
 		})
 	})
 })
+
+var _ = Describe("WriteVarsFile permissions", func() {
+	var (
+		tmpDir   string
+		filePath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "manifest-test-")
+		Expect(err).NotTo(HaveOccurred())
+		filePath = filepath.Join(tmpDir, "vars.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("creates the vars file readable only by its owner", func() {
+		Expect(WriteVarsFile(map[string]string{"a-var": "some-value"}, filePath)).To(Succeed())
+
+		fileInfo, err := os.Stat(filePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileInfo.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	Context("when the vars file already exists", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(filePath, []byte("some-old-content-that-is-longer\n"), 0644)).To(Succeed())
+			Expect(os.Chmod(filePath, 0644)).To(Succeed())
+		})
+
+		It("restricts its permissions and replaces its content", func() {
+			Expect(WriteVarsFile(map[string]string{"a-var": "some-value"}, filePath)).To(Succeed())
+
+			fileInfo, err := os.Stat(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileInfo.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			varsBytes, err := ioutil.ReadFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(varsBytes)).To(Equal("a-var: some-value\n"))
+		})
+	})
+})
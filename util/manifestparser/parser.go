@@ -2,13 +2,29 @@ package manifestparser
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 
+	"code.cloudfoundry.org/cli/types"
 	yaml "gopkg.in/yaml.v2"
 )
 
 type Application struct {
-	Name string `yaml:"name"`
+	Name      string    `yaml:"name"`
+	Processes []Process `yaml:"processes,omitempty"`
+}
+
+// Process holds the settings of one process type of an application. Memory
+// and DiskQuota are sizes with a unit, such as 256M or 1G.
+type Process struct {
+	Type                    string `yaml:"type"`
+	Command                 string `yaml:"command,omitempty"`
+	Instances               *int   `yaml:"instances,omitempty"`
+	Memory                  string `yaml:"memory,omitempty"`
+	DiskQuota               string `yaml:"disk_quota,omitempty"`
+	HealthCheckType         string `yaml:"health-check-type,omitempty"`
+	HealthCheckHTTPEndpoint string `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckTimeout      int    `yaml:"timeout,omitempty"`
 }
 
 type Parser struct {
@@ -49,11 +65,59 @@ func (parser *Parser) Parse(manifestPath string) error {
 		if application.Name == "" {
 			return errors.New("Found an application with no name specified")
 		}
+
+		err = validateProcesses(application)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+func validateProcesses(application Application) error {
+	if errs := ProcessErrors(application); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ProcessErrors returns every problem found with the processes of the
+// application, in the order of its processes.
+func ProcessErrors(application Application) []error {
+	var errs []error
+	processTypes := map[string]bool{}
+	for _, process := range application.Processes {
+		if process.Type == "" {
+			errs = append(errs, fmt.Errorf("Found a process with no type specified in application %s", application.Name))
+		} else if processTypes[process.Type] {
+			errs = append(errs, fmt.Errorf("Process type %s is specified more than once in application %s", process.Type, application.Name))
+		}
+		processTypes[process.Type] = true
+
+		var size types.NullByteSizeInMb
+		if err := size.ParseStringValue(process.Memory); err != nil {
+			errs = append(errs, fmt.Errorf("Invalid memory for process %s of application %s: %s", process.Type, application.Name, err))
+		}
+		if err := size.ParseStringValue(process.DiskQuota); err != nil {
+			errs = append(errs, fmt.Errorf("Invalid disk_quota for process %s of application %s: %s", process.Type, application.Name, err))
+		}
+
+		switch process.HealthCheckType {
+		case "", "http", "port", "process":
+		default:
+			errs = append(errs, fmt.Errorf("Invalid health-check-type %s for process %s of application %s: must be http, port or process", process.HealthCheckType, process.Type, application.Name))
+		}
+		if process.HealthCheckHTTPEndpoint != "" && process.HealthCheckType != "http" {
+			errs = append(errs, fmt.Errorf("Health check type must be 'http' to set a health check HTTP endpoint for process %s of application %s", process.Type, application.Name))
+		}
+		if process.Instances != nil && *process.Instances < 0 {
+			errs = append(errs, fmt.Errorf("Instances for process %s of application %s cannot be negative", process.Type, application.Name))
+		}
+	}
+	return errs
+}
+
 func (parser Parser) AppNames() []string {
 	var names []string
 	for _, app := range parser.Applications {
@@ -62,6 +126,17 @@ func (parser Parser) AppNames() []string {
 	return names
 }
 
+// Processes returns the process settings of the application with the
+// provided name.
+func (parser Parser) Processes(appName string) []Process {
+	for _, app := range parser.Applications {
+		if app.Name == appName {
+			return app.Processes
+		}
+	}
+	return nil
+}
+
 func (parser Parser) RawManifest(_ string) ([]byte, error) {
 	return parser.rawManifest, nil
 }
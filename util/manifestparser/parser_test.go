@@ -74,6 +74,125 @@ var _ = Describe("Parser", func() {
 				Expect(executeErr).To(MatchError("must have at least one application"))
 			})
 		})
+
+		Context("when the applications have processes", func() {
+			var process map[string]interface{}
+
+			BeforeEach(func() {
+				process = map[string]interface{}{
+					"type":                       "worker",
+					"command":                    "some-command",
+					"instances":                  2,
+					"memory":                     "256M",
+					"disk_quota":                 "1G",
+					"health-check-type":          "http",
+					"health-check-http-endpoint": "/health",
+					"timeout":                    90,
+				}
+				manifest = map[string]interface{}{
+					"applications": []map[string]interface{}{
+						{
+							"name":      "app-1",
+							"processes": []map[string]interface{}{{"type": "web"}, process},
+						},
+					},
+				}
+			})
+
+			It("sets the processes of the applications", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				instances := 2
+				Expect(parser.Processes("app-1")).To(Equal([]Process{
+					{Type: "web"},
+					{
+						Type:                    "worker",
+						Command:                 "some-command",
+						Instances:               &instances,
+						Memory:                  "256M",
+						DiskQuota:               "1G",
+						HealthCheckType:         "http",
+						HealthCheckHTTPEndpoint: "/health",
+						HealthCheckTimeout:      90,
+					},
+				}))
+				Expect(parser.Processes("app-2")).To(BeEmpty())
+			})
+
+			Context("when a process has no type", func() {
+				BeforeEach(func() {
+					delete(process, "type")
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Found a process with no type specified in application app-1"))
+				})
+			})
+
+			Context("when a process type is specified twice", func() {
+				BeforeEach(func() {
+					process["type"] = "web"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Process type web is specified more than once in application app-1"))
+				})
+			})
+
+			Context("when the memory has no unit", func() {
+				BeforeEach(func() {
+					process["memory"] = "256"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(HavePrefix("Invalid memory for process worker of application app-1: ")))
+				})
+			})
+
+			Context("when the health check type is invalid", func() {
+				BeforeEach(func() {
+					process["health-check-type"] = "tcp"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Invalid health-check-type tcp for process worker of application app-1: must be http, port or process"))
+				})
+			})
+
+			Context("when an endpoint is set without the http health check type", func() {
+				BeforeEach(func() {
+					process["health-check-type"] = "port"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Health check type must be 'http' to set a health check HTTP endpoint for process worker of application app-1"))
+				})
+			})
+		})
+	})
+
+	Describe("ProcessErrors", func() {
+		It("returns every problem with the processes", func() {
+			instances := -1
+			errs := ProcessErrors(Application{
+				Name: "app-1",
+				Processes: []Process{
+					{Type: "web", Memory: "256"},
+					{Type: "web", HealthCheckType: "none", Instances: &instances},
+					{Type: "worker"},
+				},
+			})
+
+			Expect(errs).To(HaveLen(4))
+			Expect(errs[0]).To(MatchError(HavePrefix("Invalid memory for process web of application app-1: ")))
+			Expect(errs[1]).To(MatchError("Process type web is specified more than once in application app-1"))
+			Expect(errs[2]).To(MatchError("Invalid health-check-type none for process web of application app-1: must be http, port or process"))
+			Expect(errs[3]).To(MatchError("Instances for process web of application app-1 cannot be negative"))
+		})
+
+		It("returns nothing when the processes are valid", func() {
+			Expect(ProcessErrors(Application{Name: "app-1", Processes: []Process{{Type: "web", Memory: "256M"}}})).To(BeEmpty())
+		})
 	})
 
 	Describe("AppNames", func() {
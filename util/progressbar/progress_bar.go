@@ -15,7 +15,7 @@ type ProgressBar struct {
 
 func NewProgressBar() *ProgressBar {
 	return &ProgressBar{
-		ready: make(chan bool),
+		ready: make(chan bool, 1),
 	}
 }
 
@@ -39,6 +39,10 @@ func (p *ProgressBar) Ready() {
 }
 
 func (p *ProgressBar) Complete() {
+	if p.bar == nil {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 	p.bar.Finish()
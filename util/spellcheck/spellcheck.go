@@ -1,9 +1,15 @@
 package spellcheck
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/sajari/fuzzy"
 )
 
+// maxNameSuggestions is the most names a NameSuggester recommends.
+const maxNameSuggestions = 3
+
 type CommandSuggester struct {
 	model *fuzzy.Model
 }
@@ -21,3 +27,56 @@ func NewCommandSuggester(existingCmds []string) CommandSuggester {
 
 	return CommandSuggester{model: model}
 }
+
+// NameSuggester recommends names, such as flag or resource names, that are
+// spelled similarly to a name that does not exist. Names are compared case
+// insensitively and longer names may be further from the misspelling.
+type NameSuggester struct {
+	names []string
+}
+
+func NewNameSuggester(existingNames []string) NameSuggester {
+	return NameSuggester{names: existingNames}
+}
+
+// Recommend returns up to three existing names close to name, closest first.
+func (s NameSuggester) Recommend(name string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	lowerName := strings.ToLower(name)
+	maxDistance := 1
+	if len(name) >= 6 {
+		maxDistance = 2
+	}
+
+	var candidates []candidate
+	seen := map[string]bool{}
+	for _, existingName := range s.names {
+		if existingName == name || seen[existingName] {
+			continue
+		}
+		seen[existingName] = true
+
+		lowerExistingName := strings.ToLower(existingName)
+		distance := fuzzy.Levenshtein(&lowerName, &lowerExistingName)
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name: existingName, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i int, j int) bool {
+		if candidates[i].distance == candidates[j].distance {
+			return candidates[i].name < candidates[j].name
+		}
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	suggestions := []string{}
+	for i := 0; i < len(candidates) && i < maxNameSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+	return suggestions
+}
@@ -26,3 +26,37 @@ var _ = Describe("Spellcheck", func() {
 		})
 	})
 })
+
+var _ = Describe("NameSuggester", func() {
+	var nameSuggester NameSuggester
+
+	BeforeEach(func() {
+		nameSuggester = NewNameSuggester([]string{"my-app", "my-app-v2", "My-App-V3", "other-app", "db"})
+	})
+
+	It("returns names within one edit of short names", func() {
+		Expect(nameSuggester.Recommend("dc")).To(Equal([]string{"db"}))
+		Expect(nameSuggester.Recommend("abcd")).To(Equal([]string{}))
+	})
+
+	It("returns names within two edits of longer names, closest first", func() {
+		Expect(nameSuggester.Recommend("my-ap-v2")).To(Equal([]string{"my-app-v2", "My-App-V3"}))
+	})
+
+	It("compares names case insensitively", func() {
+		Expect(nameSuggester.Recommend("MY-APPP")).To(Equal([]string{"my-app"}))
+	})
+
+	It("does not recommend the name itself", func() {
+		Expect(nameSuggester.Recommend("my-app-v2")).To(Equal([]string{"My-App-V3"}))
+	})
+
+	It("returns at most three names", func() {
+		nameSuggester = NewNameSuggester([]string{"app-a", "app-b", "app-c", "app-d"})
+		Expect(nameSuggester.Recommend("app-e")).To(Equal([]string{"app-a", "app-b", "app-c"}))
+	})
+
+	It("returns an empty slice when nothing is close", func() {
+		Expect(nameSuggester.Recommend("completely-different")).To(Equal([]string{}))
+	})
+})